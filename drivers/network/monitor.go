@@ -68,7 +68,7 @@ func (mon *NetworkMonitor) runQuery(qstr string) ([][]rdf.URI, error) {
 	for _, row := range resp.Rows {
 		var nr []rdf.URI
 		for _, u := range row.Values {
-			nr = append(nr, rdf.URI{Namespace: u.Namespace, Value: u.Value})
+			nr = append(nr, rdf.URIFromProto(u))
 		}
 		res = append(res, nr)
 	}
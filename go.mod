@@ -9,6 +9,7 @@ require (
 	github.com/aws/aws-sdk-go v1.21.1
 	github.com/dchest/uniuri v0.0.0-20160212164326-8902c56451e9
 	github.com/dgraph-io/badger/v2 v2.0.0
+	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/golang/protobuf v1.3.2
 	github.com/google/gopacket v1.1.17
 	github.com/grpc-ecosystem/grpc-gateway v1.11.2
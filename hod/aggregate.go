@@ -0,0 +1,221 @@
+package hod
+
+import (
+	"strconv"
+	"strings"
+
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+)
+
+// GROUP BY and aggregates are computed over the rows of the cursors' relations,
+// before any rows are built for the response. Every row of a relation is a
+// solution of the query, so the rows from each UNION branch and each graph are
+// all added to the groups.
+
+type aggregation struct {
+	groupBy    []string
+	aggregates []*logpb.Aggregate
+	// the selected variables; each is either grouped on or the name of an aggregate
+	vars   []string
+	groups map[string]*aggregateGroup
+	// groups in the order they were first seen
+	order []*aggregateGroup
+}
+
+type aggregateGroup struct {
+	key    []*logpb.URI
+	states []*aggregateState
+}
+
+type aggregateState struct {
+	count  int64
+	seen   map[string]struct{}
+	value  *logpb.URI
+	values []string
+}
+
+func newAggregation(query *logpb.SelectQuery) (*aggregation, error) {
+	agg := &aggregation{
+		groupBy:    query.GroupBy,
+		aggregates: query.Aggregates,
+		vars:       query.Vars,
+		groups:     make(map[string]*aggregateGroup),
+	}
+	for _, varname := range query.Vars {
+		if !hasString(agg.groupBy, varname) && agg.aggregateIndex(varname) < 0 {
+			return nil, errors.Errorf("Variable %s must be in the GROUP BY or be an aggregate", varname)
+		}
+	}
+	for _, aggregate := range query.Aggregates {
+		if aggregate.Var == "*" && aggregate.Function != logpb.AggregateFunction_Count {
+			return nil, errors.Errorf("%s can not be computed over *", aggregate.Function)
+		}
+	}
+	// with no GROUP BY, all rows are in one group, which exists even if there are no rows
+	if len(agg.groupBy) == 0 {
+		agg.group(nil)
+	}
+	return agg, nil
+}
+
+func (agg *aggregation) aggregateIndex(name string) int {
+	for idx, aggregate := range agg.aggregates {
+		if aggregate.Name == name {
+			return idx
+		}
+	}
+	return -1
+}
+
+// returns the group with the given values of the GROUP BY variables, creating it if needed
+func (agg *aggregation) group(key []*logpb.URI) *aggregateGroup {
+	groupKey := rowKey(key)
+	if group, found := agg.groups[groupKey]; found {
+		return group
+	}
+	group := &aggregateGroup{key: key}
+	for range agg.aggregates {
+		group.states = append(group.states, &aggregateState{seen: make(map[string]struct{})})
+	}
+	agg.groups[groupKey] = group
+	agg.order = append(agg.order, group)
+	return group
+}
+
+// adds the rows of the cursor's relation to their groups
+func (agg *aggregation) addRows(c *Cursor) {
+	// returns the value of the variable in the row: nil if the variable is
+	// unbound, and false if the row should be skipped
+	lookup := func(row *relationRow, varname string) (*logpb.URI, bool) {
+		pos, found := c.variablePosition[varname]
+		if !found {
+			return nil, true
+		}
+		key := row.valueAt(pos)
+		if key.Empty() {
+			_, optional := c.optionalVars[varname]
+			return nil, optional
+		}
+		uri, found := c.hod.getURI(key)
+		if !found {
+			return nil, false
+		}
+		return convertURI(uri), true
+	}
+
+	for _, row := range c.rel.rows {
+		agg.add(func(varname string) (*logpb.URI, bool) {
+			return lookup(row, varname)
+		})
+	}
+}
+
+// adds the solutions of a query that joins several graphs to their groups
+func (agg *aggregation) addSolutions(solutions []solution) {
+	for _, sol := range solutions {
+		agg.add(func(varname string) (*logpb.URI, bool) {
+			return sol[varname], true
+		})
+	}
+}
+
+// adds a row, whose values are returned by lookup, to its group
+func (agg *aggregation) add(lookup func(varname string) (*logpb.URI, bool)) {
+	var key = make([]*logpb.URI, len(agg.groupBy))
+	for idx, varname := range agg.groupBy {
+		value, ok := lookup(varname)
+		if !ok {
+			return
+		}
+		if value == nil {
+			value = &logpb.URI{}
+		}
+		key[idx] = value
+	}
+	var values = make([]*logpb.URI, len(agg.aggregates))
+	for idx, aggregate := range agg.aggregates {
+		if aggregate.Var == "*" {
+			continue
+		}
+		value, ok := lookup(aggregate.Var)
+		if !ok {
+			return
+		}
+		values[idx] = value
+	}
+
+	group := agg.group(key)
+	for idx, aggregate := range agg.aggregates {
+		if aggregate.Var == "*" {
+			group.states[idx].count++
+		} else if values[idx] != nil {
+			group.states[idx].add(aggregate, values[idx])
+		}
+	}
+}
+
+func (state *aggregateState) add(aggregate *logpb.Aggregate, value *logpb.URI) {
+	if aggregate.Distinct {
+		s := value.Namespace + "#" + value.Value + "^^" + value.Datatype + "@" + value.Lang
+		if _, found := state.seen[s]; found {
+			return
+		}
+		state.seen[s] = struct{}{}
+	}
+	switch aggregate.Function {
+	case logpb.AggregateFunction_Count:
+		state.count++
+	case logpb.AggregateFunction_Min:
+		if state.value == nil || compareTerms(turtle.URIFromProto(value), turtle.URIFromProto(state.value)) < 0 {
+			state.value = value
+		}
+	case logpb.AggregateFunction_Max:
+		if state.value == nil || compareTerms(turtle.URIFromProto(value), turtle.URIFromProto(state.value)) > 0 {
+			state.value = value
+		}
+	case logpb.AggregateFunction_Sample:
+		if state.value == nil {
+			state.value = value
+		}
+	case logpb.AggregateFunction_GroupConcat:
+		state.values = append(state.values, turtle.URIFromProto(value).String())
+	}
+}
+
+func (state *aggregateState) result(aggregate *logpb.Aggregate) *logpb.URI {
+	switch aggregate.Function {
+	case logpb.AggregateFunction_Count:
+		return &logpb.URI{Value: strconv.FormatInt(state.count, 10), Datatype: turtle.XSD_NAMESPACE + "#integer"}
+	case logpb.AggregateFunction_GroupConcat:
+		return &logpb.URI{Value: strings.Join(state.values, aggregate.Separator)}
+	}
+	if state.value == nil {
+		// MIN, MAX and SAMPLE of a group with no values
+		return &logpb.URI{}
+	}
+	return state.value
+}
+
+// returns a row for each group with the values of the selected variables
+func (agg *aggregation) rows() []*logpb.Row {
+	var rows []*logpb.Row
+	for _, group := range agg.order {
+		row := new(logpb.Row)
+		for _, varname := range agg.vars {
+			if idx := agg.aggregateIndex(varname); idx >= 0 {
+				row.Values = append(row.Values, group.states[idx].result(agg.aggregates[idx]))
+				continue
+			}
+			for idx, groupVar := range agg.groupBy {
+				if groupVar == varname {
+					row.Values = append(row.Values, group.key[idx])
+					break
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -1,9 +1,12 @@
 package hod
 
 import (
+	"bytes"
 	"context"
-	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/dgraph-io/badger/v2"
@@ -13,13 +16,36 @@ import (
 	logpb "github.com/gtfierro/hoddb/proto"
 	turtle "github.com/gtfierro/hoddb/turtle"
 	"github.com/pkg/errors"
-	"github.com/zhangxinngang/murmur"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (hod *HodDB) LoadGraph(graph Graph) error {
+// adds the graph's triples, and everything inferred from them, as a new version
+// of the graph
+func (hod *HodDB) addGraph(graph Graph) error {
+	return hod.newVersion(graph.Name, func(entry *versionEntry) error {
+		entry.Added = len(graph.Data.Triples)
+		entry.Source = graph.source
+		return hod.loadGraph(graph)
+	})
+}
+
+func (hod *HodDB) loadGraph(graph Graph) error {
+	version, err := hod.writeVersion(graph.Name)
+	if err != nil {
+		return err
+	}
+
+	// only the triples in the graph's files are asserted; the rest are inferred
+	if err := hod.markAsserted(graph.Name, graph.Data.Triples); err != nil {
+		return errors.Wrap(err, "Could not record loaded triples")
+	}
 	graph.ExpandTriples()
 
-	entities := graph.CompileEntities()
+	entities, err := graph.CompileEntities()
+	if err != nil {
+		return err
+	}
 
 	log.Println("entities compiled", len(entities))
 
@@ -31,7 +57,7 @@ func (hod *HodDB) LoadGraph(graph Graph) error {
 			txn.Discard()
 			return errors.Wrap(err, "Error serializing entry")
 		}
-		if err := hod.setWithCommit(txn, ent.compiled.EntityKey, serializedEntry); err != nil {
+		if err := hod.setWithCommit(txn, ent.key.atVersion(version).Bytes(), serializedEntry); err != nil {
 			return errors.Wrap(err, "Error txn commit")
 		}
 	}
@@ -40,24 +66,39 @@ func (hod *HodDB) LoadGraph(graph Graph) error {
 		return errors.Wrap(err, "last commit")
 	}
 
-	getEntity := func(key EntityKey) *Entity {
-		ent, found := entities[key]
-		if !found {
+	// entities outside of the compiled ones may already be in the graph
+	getEntity := func(key EntityKey) (*Entity, error) {
+		if ent, found := entities[key]; found {
+			return ent, nil
+		}
+		ent, err := hod.GetEntity(key)
+		if err == badger.ErrKeyNotFound {
 			ent = newEntity(key)
-			entities[key] = ent
+		} else if err != nil {
+			return nil, err
+		} else {
+			ent.FromCompiled()
 		}
-		return ent
+		entities[key] = ent
+		return ent, nil
 	}
 
 	hod.namespaces.Store(graph.Name, graph.Data.Namespaces)
+	hod.Lock()
 	hod.graphs[graph.Name] = struct{}{}
+	hod.Unlock()
 
 	// insert extended edges
 	cursor, err := hod.Cursor(graph.Name)
 	if err != nil {
 		return errors.Wrap(err, "get cursor")
 	}
-	for key, ent := range entities {
+	compiled := make([]EntityKey, 0, len(entities))
+	for key := range entities {
+		compiled = append(compiled, key)
+	}
+	for _, key := range compiled {
+		ent := entities[key]
 		for _, pred := range ent.GetAllPredicates() {
 			e := edge{predicate: pred, pattern: logpb.Pattern_OnePlus}
 			newseen, _, err := cursor.followPathFromSubject(ent, e)
@@ -66,13 +107,13 @@ func (hod *HodDB) LoadGraph(graph Graph) error {
 			}
 			for newkey := range newseen {
 				ent.addOutEdge(pred, newkey, logpb.Pattern_OnePlus)
-				other := getEntity(newkey)
+				other, err := getEntity(newkey)
+				if err != nil {
+					return err
+				}
 				other.addInEdge(pred, ent.key, logpb.Pattern_OnePlus)
-				entities[newkey] = other
 			}
 		}
-		entities[key] = ent
-
 	}
 
 	txn = hod.db.NewTransaction(true)
@@ -84,7 +125,7 @@ func (hod *HodDB) LoadGraph(graph Graph) error {
 			txn.Discard()
 			return errors.Wrap(err, "Error serializing entry")
 		}
-		if err := hod.setWithCommit(txn, ent.compiled.EntityKey, serializedEntry); err != nil {
+		if err := hod.setWithCommit(txn, ent.key.atVersion(version).Bytes(), serializedEntry); err != nil {
 			return errors.Wrap(err, "Error txn commit")
 		}
 	}
@@ -93,31 +134,48 @@ func (hod *HodDB) LoadGraph(graph Graph) error {
 		return errors.Wrap(err, "last commit")
 	}
 
-	if err := hod.inferRules(graph.Name); err != nil {
+	return hod.reason(graph.Name, graph.Data.Triples)
+}
+
+// Load loads the bundle's file into its graph, and its ontologies into the
+// ontology graph. If the bundle was loaded before, the graph is only changed if
+// the file has changed since
+func (hod *HodDB) Load(bundle FileBundle) error {
+	if err := checkWritable(bundle.GraphName); err != nil {
 		return err
 	}
-	if err := hod.AddTriples(graph.Name, graph.Data); err != nil {
+	if err := hod.checkGraphHash(bundle.GraphName); err != nil {
 		return err
 	}
-
-	return nil
-}
-
-func (hod *HodDB) Load(bundle FileBundle) error {
-	if loaded, err := hod.isFileBundleLoaded(bundle); loaded && err == nil {
-		log.Infof("File bundle already loaded: %v", bundle)
-		return nil
+	if err := hod.loadOntologies(bundle.OntologyFiles); err != nil {
+		return errors.Wrapf(err, "could not load ontologies for graph %s", bundle.GraphName)
+	}
+	loaded, err := hod.loadedBundleHash(bundle)
+	if err != nil {
+		return errors.Wrap(err, "could not check if bundle is loaded")
+	}
+	if loaded != nil {
+		_, hash, err := bundle.getKeyValue()
+		if err != nil {
+			return errors.Wrapf(err, "could not read files for graph %s", bundle.GraphName)
+		}
+		if bytes.Equal(loaded, hash) {
+			log.Infof("File bundle already loaded: %v", bundle)
+			return nil
+		}
+		log.Infof("File bundle changed since it was loaded: %v", bundle)
+		return hod.reloadBundle(bundle)
 	}
 	graph, err := hod.loadFileBundle(bundle)
 	if err != nil {
 		return errors.Wrapf(err, "could not load file %s for graph %s", bundle.TTLFile, bundle.GraphName)
 	}
 
-	if err := hod.LoadGraph(graph); err != nil {
+	if err := hod.addGraph(graph); err != nil {
 		return errors.Wrap(err, "could not load graph")
 	}
 
-	if err := hod.markBundleLoaded(bundle); err != nil {
+	if err := hod.markBundleLoaded(bundle, graph.source); err != nil {
 		return errors.Wrap(err, "could not mark bundle as loaded")
 	}
 
@@ -144,96 +202,313 @@ func (hod *HodDB) setWithCommit(txn *badger.Txn, key, value []byte) error {
 	return nil
 }
 
+// GetEntity returns the newest state of the entity
 func (hod *HodDB) GetEntity(key EntityKey) (*Entity, error) {
-	var entity = &Entity{
-		compiled: new(logpb.Entity),
-		key:      key,
+	return hod.getEntityAt(key, latestVersion)
+}
+
+// Versions lists the versions of the requested graphs that match the time filter,
+// up to the limit for each graph. Each row has the graph, the version timestamp,
+// the number of triples added and removed by the write that created it, and the
+// hash of the files it was loaded from (if any)
+func (hod *HodDB) Versions(ctx context.Context, request *logpb.VersionQuery) (*logpb.Response, error) {
+	var resp = new(logpb.Response)
+	resp.Variables = []string{"graph", "version", "added", "removed", "source"}
+	graphs, err := hod.resolveGraphs(ctx, request.Graphs)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, err
 	}
-	err := hod.db.View(func(t *badger.Txn) error {
-		it, err := t.Get(key.Bytes())
+	for _, graph := range graphs {
+		entries, err := hod.listVersions(graph, request.Filter, request.Timestamp, int(request.Limit))
 		if err != nil {
-			return err
+			err = errors.Wrapf(err, "Could not list versions of graph %s", graph)
+			resp.Error = err.Error()
+			return resp, err
 		}
-		err = it.Value(func(b []byte) error {
-			return proto.Unmarshal(b, entity.compiled)
-		})
-		if err != nil {
-			return err
+		for _, entry := range entries {
+			resp.Rows = append(resp.Rows, &logpb.Row{
+				Values: []*logpb.URI{
+					{Value: entry.Graph},
+					{Value: strconv.FormatUint(entry.Version, 10)},
+					{Value: strconv.Itoa(entry.Added)},
+					{Value: strconv.Itoa(entry.Removed)},
+					{Value: hex.EncodeToString(entry.Source)},
+				},
+			})
 		}
-		return nil
-	})
-	return entity, err
+	}
+	resp.Count = int64(len(resp.Rows))
+	return resp, nil
 }
 
-func (hod *HodDB) hashURI(graph string, u turtle.URI) EntityKey {
-
-	hk := hashkeyentry{graph, u}
+// ParseVersionQuery parses a LIST VERSIONS query into the VersionQuery accepted by
+// the Versions RPC
+func (hod *HodDB) ParseVersionQuery(qstr string) (*logpb.VersionQuery, error) {
+	q, err := query.Parse(qstr)
+	if err != nil {
+		return nil, errors.Wrap(ErrParse, err.Error())
+	}
+	if !q.IsVersions() {
+		return nil, errors.Wrap(ErrParse, "not a LIST VERSIONS query")
+	}
 
-	hod.RLock()
-	if key, found := hod.hashes[hk]; found {
-		hod.RUnlock()
-		return key
+	vq := &logpb.VersionQuery{
+		Graphs:    q.Version.Names.Databases,
+		Timestamp: q.Version.Filter.Timestamp.UnixNano(),
+		Filter:    convertTimeFilter(q.Version.Filter.Filter),
+		Limit:     int64(q.Version.Limit),
 	}
-	hod.RUnlock()
+	return vq, nil
+}
 
-	var key EntityKey
+func (hod *HodDB) Parse(ctx context.Context, request *logpb.ParseRequest) (*logpb.SelectQuery, error) {
+	return hod.ParseQuery(request.Query, 0)
+}
+
+// ParseQuery parses a SELECT query. The query's time clause picks the version of
+// the graph it reads; a non-zero version overrides the clause's timestamp
+func (hod *HodDB) ParseQuery(qstr string, version int64) (*logpb.SelectQuery, error) {
+	q, err := query.Parse(qstr)
+	if err != nil {
+		return nil, errors.Wrap(ErrParse, err.Error())
+	}
 
-	//hashresult := murmur.Murmur3([]byte(u.Namespace + u.Value))
-	binary.BigEndian.PutUint32(key.Hash[:], murmur.Murmur3([]byte(u.Namespace+u.Value)))
-	binary.BigEndian.PutUint32(key.Graph[:], murmur.Murmur3([]byte(graph)))
+	sq := &logpb.SelectQuery{
+		Vars:      q.Select.Vars,
+		Graphs:    q.From.Databases,
+		Timestamp: q.Time.Timestamp.UnixNano(),
+	}
+	if version != 0 {
+		sq.Timestamp = version
+	}
+	sq.Filter = convertTimeFilter(q.Time.Filter)
+	for _, cond := range q.Modifier.Order {
+		sq.Order = append(sq.Order, &logpb.OrderCondition{Var: cond.Var, Descending: cond.Descending})
+	}
+	if q.Modifier.Limit > 0 {
+		sq.Limit = int64(q.Modifier.Limit)
+	}
+	sq.Offset = int64(q.Modifier.Offset)
+	sq.GroupBy = q.Modifier.GroupBy
+	// COUNT queries have always counted the distinct rows
+	sq.Distinct = q.Select.Distinct || q.Count
+	sq.Reduced = q.Select.Reduced
+	for _, aggregate := range q.Select.Aggregates {
+		sq.Aggregates = append(sq.Aggregates, &logpb.Aggregate{
+			Function:  convertAggregateFunction(aggregate.Function),
+			Var:       aggregate.Var,
+			Distinct:  aggregate.Distinct,
+			Separator: aggregate.Separator,
+			Name:      aggregate.Name,
+		})
+	}
 
-	if other_uri, found := hod.uris[key]; found {
-		panic(fmt.Sprintf("URI for %s conflicts with %s", u, other_uri))
+	// nested groups without a UNION are part of the where clause
+	where := sparql.GraphGroup{Terms: q.Where.Terms}
+	var alternatives []sparql.GraphGroup
+	if q.Where.GraphGroup != nil {
+		alternatives = q.Where.GraphGroup.Alternatives()
+		if len(alternatives) == 1 {
+			where.Terms = append(append([]sparql.Triple{}, where.Terms...), alternatives[0].Terms...)
+			where.Optionals = alternatives[0].Optionals
+			where.Filters = alternatives[0].Filters
+			where.Graphs = alternatives[0].Graphs
+			alternatives = nil
+		}
+	}
+	group, err := hod.convertGroup(where)
+	if err != nil {
+		return nil, err
+	}
+	sq.Where, sq.Optional, sq.Filters, sq.GraphPatterns = group.Terms, group.Optional, group.Filters, group.GraphPatterns
+	for _, alternative := range alternatives {
+		union, err := hod.convertGroup(alternative)
+		if err != nil {
+			return nil, err
+		}
+		sq.Unions = append(sq.Unions, union)
 	}
 
-	hod.Lock()
-	hod.hashes[hk] = key
-	hod.uris[key] = u
-	hod.Unlock()
-	return key
+	return sq, nil
 }
 
-func (hod *HodDB) Versions(ctx context.Context, request *logpb.VersionQuery) (*logpb.Response, error) {
-	var resp = new(logpb.Response)
-	hod.RLock()
-	for graph := range hod.graphs {
-		resp.Rows = append(resp.Rows, &logpb.Row{
-			Values: []*logpb.URI{{
-				Value: graph,
-			}},
-		})
+// ParseInsertQuery parses an INSERT query into the InsertQuery accepted by the
+// Insert RPC
+func (hod *HodDB) ParseInsertQuery(qstr string) (*logpb.InsertQuery, error) {
+	q, err := query.Parse(qstr)
+	if err != nil {
+		return nil, errors.Wrap(ErrParse, err.Error())
 	}
-	hod.RUnlock()
-	return resp, nil
+	if !q.IsInsert() {
+		return nil, errors.Wrap(ErrParse, "not an INSERT query")
+	}
+
+	where, err := templateWhere(q.Where)
+	if err != nil {
+		return nil, err
+	}
+	iq := &logpb.InsertQuery{
+		Graphs:   q.From.Databases,
+		Blocking: true,
+	}
+	if iq.Insert, err = hod.convertTriples(q.Insert.Terms); err != nil {
+		return nil, err
+	}
+	if iq.Where, err = hod.convertTriples(where); err != nil {
+		return nil, err
+	}
+	return iq, nil
 }
 
-func (hod *HodDB) Parse(ctx context.Context, request *logpb.ParseRequest) (*logpb.SelectQuery, error) {
-	return hod.ParseQuery(request.Query, 0)
+// returns the triple patterns of the WHERE clause of a query that fills in a
+// template, which does not evaluate OPTIONAL, FILTER, UNION or GRAPH. These are
+// an error rather than ignored, as the rest of the clause matches more than the
+// query does. Nested groups of triple patterns are part of the clause
+func templateWhere(where sparql.WhereClause) ([]sparql.Triple, error) {
+	terms := where.Terms
+	if where.GraphGroup == nil {
+		return terms, nil
+	}
+	alternatives := where.GraphGroup.Alternatives()
+	if len(alternatives) > 1 {
+		return nil, errors.Wrap(ErrParse, "UNION is not supported in the WHERE clause of a template")
+	}
+	group := alternatives[0]
+	switch {
+	case len(group.Optionals) > 0:
+		return nil, errors.Wrap(ErrParse, "OPTIONAL is not supported in the WHERE clause of a template")
+	case len(group.Filters) > 0:
+		return nil, errors.Wrap(ErrParse, "FILTER is not supported in the WHERE clause of a template")
+	case len(group.Graphs) > 0:
+		return nil, errors.Wrap(ErrParse, "GRAPH is not supported in the WHERE clause of a template")
+	}
+	return append(append([]sparql.Triple{}, terms...), group.Terms...), nil
 }
 
-func (hod *HodDB) ParseQuery(qstr string, version int64) (*logpb.SelectQuery, error) {
+// ParseDeleteQuery parses a DELETE query into the DeleteQuery accepted by the
+// Delete RPC
+func (hod *HodDB) ParseDeleteQuery(qstr string) (*logpb.DeleteQuery, error) {
 	q, err := query.Parse(qstr)
 	if err != nil {
+		return nil, errors.Wrap(ErrParse, err.Error())
+	}
+	if !q.IsDelete() {
+		return nil, errors.Wrap(ErrParse, "not a DELETE query")
+	}
+
+	where, err := templateWhere(q.Where)
+	if err != nil {
+		return nil, err
+	}
+	dq := &logpb.DeleteQuery{
+		Graphs: q.From.Databases,
+	}
+	if dq.Delete, err = hod.convertTriples(q.Delete.Terms); err != nil {
 		return nil, err
 	}
+	if dq.Where, err = hod.convertTriples(where); err != nil {
+		return nil, err
+	}
+	return dq, nil
+}
 
-	sq := &logpb.SelectQuery{
-		Vars:      q.Select.Vars,
-		Graphs:    q.From.Databases,
-		Timestamp: version,
-		Filter:    logpb.TimeFilter_Before,
+func convertAggregateFunction(function string) logpb.AggregateFunction {
+	switch function {
+	case "min":
+		return logpb.AggregateFunction_Min
+	case "max":
+		return logpb.AggregateFunction_Max
+	case "sample":
+		return logpb.AggregateFunction_Sample
+	case "group_concat":
+		return logpb.AggregateFunction_GroupConcat
 	}
+	return logpb.AggregateFunction_Count
+}
 
-	for _, triple := range q.Where.Terms {
+func convertTimeFilter(filter sparql.TimeFilter) logpb.TimeFilter {
+	switch filter {
+	case sparql.BEFORE:
+		return logpb.TimeFilter_Before
+	case sparql.AFTER:
+		return logpb.TimeFilter_After
+	default:
+		return logpb.TimeFilter_At
+	}
+}
+
+// converts a group of terms without alternatives, and its OPTIONAL groups, into
+// their protobuf representation
+func (hod *HodDB) convertGroup(group sparql.GraphGroup) (*logpb.TripleGroup, error) {
+	if len(group.Unions) > 0 {
+		return nil, errors.New("UNION inside OPTIONAL is not supported")
+	}
+	terms, err := hod.convertTriples(group.Terms)
+	if err != nil {
+		return nil, err
+	}
+	converted := &logpb.TripleGroup{Terms: terms}
+	for _, optional := range group.Optionals {
+		if len(optional.Graphs) > 0 {
+			return nil, errors.New("GRAPH inside OPTIONAL is not supported")
+		}
+		convertedOptional, err := hod.convertGroup(optional)
+		if err != nil {
+			return nil, err
+		}
+		converted.Optional = append(converted.Optional, convertedOptional)
+	}
+	for _, filter := range group.Filters {
+		converted.Filters = append(converted.Filters, hod.convertFilter(filter))
+	}
+	for _, graph := range group.Graphs {
+		if len(graph.Group.Graphs) > 0 {
+			return nil, errors.New("GRAPH inside GRAPH is not supported")
+		} else if len(graph.Group.Unions) > 0 {
+			return nil, errors.New("UNION inside GRAPH is not supported")
+		}
+		convertedGroup, err := hod.convertGroup(graph.Group)
+		if err != nil {
+			return nil, err
+		}
+		converted.GraphPatterns = append(converted.GraphPatterns, &logpb.GraphPattern{Graph: graph.Graph, Group: convertedGroup})
+	}
+	return converted, nil
+}
+
+// converts a parsed FILTER expression into its protobuf representation
+func (hod *HodDB) convertFilter(expr sparql.FilterExpr) *logpb.FilterExpr {
+	converted := &logpb.FilterExpr{Op: expr.Op}
+	if expr.Op == "" {
+		converted.Value = hod.expandURI(convertURI(expr.Value), "")
+	}
+	for _, arg := range expr.Args {
+		converted.Args = append(converted.Args, hod.convertFilter(arg))
+	}
+	return converted
+}
+
+// converts parsed query terms into their protobuf representation
+func (hod *HodDB) convertTriples(triples []sparql.Triple) ([]*logpb.Triple, error) {
+	return convertTerms(triples, func(uri *logpb.URI) *logpb.URI {
+		return hod.expandURI(uri, "")
+	})
+}
+
+// converts the triples, passing each of their URIs through expand
+func convertTerms(triples []sparql.Triple, expand func(*logpb.URI) *logpb.URI) ([]*logpb.Triple, error) {
+	var terms []*logpb.Triple
+	for _, triple := range triples {
 		term := &logpb.Triple{
-			Subject: hod.expandURI(convertURI(triple.Subject), ""),
-			Object:  hod.expandURI(convertURI(triple.Object), ""),
+			Subject: expand(convertURI(triple.Subject)),
+			Object:  expand(convertURI(triple.Object)),
 		}
 		for _, pred := range triple.Predicates {
 			// TODO: use pattern
-			uri := hod.expandURI(convertURI(pred.Predicate), "")
+			uri := expand(convertURI(pred.Predicate))
 			if uri == nil {
-				return nil, errors.New("graph not found")
+				return nil, errors.Wrap(ErrGraphNotFound, "No graph to expand the query's URIs")
 			}
 			switch pred.Pattern {
 			case sparql.PATTERN_SINGLE:
@@ -247,129 +522,646 @@ func (hod *HodDB) ParseQuery(qstr string, version int64) (*logpb.SelectQuery, er
 			}
 			term.Predicate = append(term.Predicate, uri)
 		}
-		sq.Where = append(sq.Where, term)
+		terms = append(terms, term)
 	}
-
-	return sq, nil
+	return terms, nil
 }
 
 func (hod *HodDB) expandURI(uri *logpb.URI, graphname string) *logpb.URI {
 	if !strings.HasPrefix(uri.Value, "?") {
-		if len(uri.Value) == 0 {
+		if len(uri.Value) == 0 && len(uri.Datatype) == 0 {
 			return uri
 		}
 
 		// get random graph if one is not provided
 		if graphname == "" {
+			hod.RLock()
 			for key := range hod.graphs {
 				graphname = key
 				break
 			}
+			hod.RUnlock()
 		}
 
 		_namespaces, ok := hod.namespaces.Load(graphname)
 		if !ok {
 			return nil
 		}
-		namespaces := _namespaces.(map[string]string)
-		if uri.Namespace != "" && (uri.Value[0] != '"' && uri.Value[len(uri.Value)-1] != '"') {
-			if full, found := namespaces[uri.Namespace]; found {
-				uri.Namespace = full
-			}
-		}
+		expandPrefix(uri, _namespaces.(map[string]string))
 	}
 	return uri
 }
 
-func (hod *HodDB) Count(ctx context.Context, query *logpb.SelectQuery) (resp *logpb.Response, err error) {
-	resp, err = hod.Select(ctx, query)
-	if resp != nil {
-		resp.Rows = resp.Rows[:0]
+// replaces the prefix of the IRI, or of the literal's datatype, with the
+// namespace it stands for
+func expandPrefix(uri *logpb.URI, namespaces map[string]string) {
+	if uri.Namespace != "" {
+		if full, found := namespaces[uri.Namespace]; found {
+			uri.Namespace = full
+		}
+	} else if uri.Datatype != "" {
+		datatype := turtle.ParseURI(uri.Datatype)
+		if full, found := namespaces[datatype.Namespace]; found {
+			datatype.Namespace = full
+		}
+		uri.Datatype = turtle.NewLiteral("", datatype.String(), "").Datatype
 	}
-	return
 }
+
+// Count returns the number of rows the query selects, without building the rows
+func (hod *HodDB) Count(ctx context.Context, query *logpb.SelectQuery) (resp *logpb.Response, err error) {
+	return hod.selectQuery(ctx, query, true, nil)
+}
+
 func (hod *HodDB) Select(ctx context.Context, query *logpb.SelectQuery) (resp *logpb.Response, err error) {
+	return hod.selectQuery(ctx, query, false, nil)
+}
 
-	var cursor *Cursor
+// runs the query. If count is true, only the number of rows is returned. If
+// stream is not nil, the rows are sent to it instead of being returned. Rows
+// are sent as they are read from the solutions of each graph, which are all
+// found first
+func (hod *HodDB) selectQuery(ctx context.Context, query *logpb.SelectQuery, count bool, stream *rowStream) (resp *logpb.Response, err error) {
 	resp = new(logpb.Response)
-	if len(query.Graphs) == 0 || (len(query.Graphs) == 1 && query.Graphs[0] == "*") {
-		var graphs []string
-		for graph := range hod.graphs {
-			graphs = append(graphs, graph)
+	// the query is not changed, as callers may run it again with another principal
+	graphs, err := hod.resolveGraphs(ctx, query.Graphs)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, err
+	}
+
+	var agg *aggregation
+	if len(query.GroupBy) > 0 || len(query.Aggregates) > 0 {
+		if agg, err = newAggregation(query); err != nil {
+			resp.Error = err.Error()
+			return resp, err
 		}
-		query.Graphs = graphs
-		//query.Graphs, err = hod.versionDB.listAllGraphs()
-		//if err != nil {
-		//	log.Error(err)
-		//	return
-		//}
 	}
 
-	for _, graph := range query.Graphs {
-		// TODO: check query.Filter
-		cursor, err = hod.Cursor(graph)
-		if err != nil {
-			log.Error(err)
-			return
+	// variables that are ordered on but not selected are removed after sorting
+	vars := query.Vars
+	for _, cond := range query.Order {
+		if hasString(vars, cond.Var) {
+			continue
+		} else if agg != nil {
+			err = errors.Errorf("Can only ORDER BY selected variables when grouping, not %s", cond.Var)
+			resp.Error = err.Error()
+			return resp, err
+		} else if count {
+			// the order of the rows does not change how many there are
+			continue
 		}
+		vars = append(vars[:len(vars):len(vars)], cond.Var)
+	}
+	// without an ORDER BY, stop producing rows once there are enough to fill the LIMIT
+	var enough = -1
+	if query.Limit > 0 && len(query.Order) == 0 && agg == nil && !count {
+		enough = int(query.Offset + query.Limit)
+	}
+	// rows can be streamed as they are produced unless they have to be sorted or grouped first
+	incremental := stream != nil && len(query.Order) == 0 && agg == nil && !count
+	// SELECT REDUCED may remove duplicates, and removes them like SELECT DISTINCT
+	distinct := query.Distinct || query.Reduced
+	// the keys of the distinct rows of all graphs. Entity keys are only
+	// comparable within a graph, so distinct rows of several graphs, or of
+	// GRAPH patterns, are counted by their values
+	var seen map[string]struct{}
+	countKeys := !distinct || (len(graphs) == 1 && !hasGraphPatterns(query))
+	if distinct {
+		seen = make(map[string]struct{})
+	}
+	var produced int
+	var counted int64
+	matcher := hod.newGraphMatcher(ctx, query, graphs, resp)
+	var graphsOnly = make(map[int]bool)
 
-		var _vars = make(map[string]struct{})
-		var vars []string
+graphs:
+	for _, graph := range graphs {
+		version, found, verr := hod.resolveVersion(graph, query.Filter, query.Timestamp)
+		if verr != nil {
+			err = errors.Wrapf(verr, "Could not find version of graph %s", graph)
+			resp.Error = err.Error()
+			log.Error(err)
+			return resp, err
+		} else if !found {
+			// the graph has no version matching the time clause
+			continue
+		}
+		if version != latestVersion && int64(version) > resp.Version {
+			resp.Version = int64(version)
+		}
+		resp.Variables = query.Vars
 
-		trackVar := func(varname string) {
-			if _, found := _vars[varname]; !found {
-				_vars[varname] = struct{}{}
-				vars = append(vars, varname)
+		// each alternative of a UNION is run on its own and the rows merged
+		branches := []*logpb.TripleGroup{{Terms: query.Where, Optional: query.Optional, Filters: query.Filters, GraphPatterns: query.GraphPatterns}}
+		if len(query.Unions) > 0 {
+			branches = branches[:0]
+			for _, union := range query.Unions {
+				branches = append(branches, &logpb.TripleGroup{
+					Terms:         append(append([]*logpb.Triple{}, query.Where...), union.Terms...),
+					Optional:      append(append([]*logpb.TripleGroup{}, query.Optional...), union.Optional...),
+					Filters:       append(append([]*logpb.FilterExpr{}, query.Filters...), union.Filters...),
+					GraphPatterns: append(append([]*logpb.GraphPattern{}, query.GraphPatterns...), union.GraphPatterns...),
+				})
 			}
 		}
 
-		for idx, triple := range query.Where {
-			if isVariable(triple.Subject) {
-				trackVar(triple.Subject.Value)
-			} else {
-				query.Where[idx].Subject = hod.expandURI(triple.Subject, graph)
+		for idx, branch := range branches {
+			if err = ctx.Err(); err != nil {
+				resp.Error = err.Error()
+				return resp, err
 			}
-			if isVariable(triple.Predicate[0]) {
-				trackVar(triple.Predicate[0].Value)
+			// the rows of a branch with GRAPH patterns are joined from the
+			// solutions of each graph it matches
+			var cursor *Cursor
+			var solutions []solution
+			if len(branch.GraphPatterns) == 0 {
+				cursor, err = hod.selectGroup(graph, version, branch, resp)
+			} else if len(branch.Terms) > 0 || len(branch.Optional) > 0 {
+				solutions, err = matcher.selectGroup(graph, version, branch)
+			} else if !graphsOnly[idx] {
+				// only GRAPH patterns, which match the same whichever graph is queried
+				graphsOnly[idx] = true
+				solutions, err = matcher.selectGroup(graph, version, branch)
 			} else {
-				query.Where[idx].Predicate[0] = hod.expandURI(triple.Predicate[0], graph)
+				continue
+			}
+			if err != nil {
+				return resp, err
+			}
+			iterRows := func(vars []string, f func(row *logpb.Row) bool) {
+				iterSolutions(solutions, vars, f)
+			}
+			if cursor != nil {
+				iterRows = cursor.iterRows
 			}
-			if isVariable(triple.Object) {
-				trackVar(triple.Object.Value)
+			if agg != nil {
+				if cursor != nil {
+					agg.addRows(cursor)
+				} else {
+					agg.addSolutions(solutions)
+				}
+				continue
+			} else if count && countKeys && cursor != nil {
+				counted += int64(cursor.countRows(vars, seen))
+				continue
+			}
+			iterRows(vars, func(row *logpb.Row) bool {
+				if distinct {
+					key := rowKey(row.Values)
+					if _, found := seen[key]; found {
+						return false
+					}
+					seen[key] = struct{}{}
+				}
+				if count {
+					counted++
+					return false
+				}
+				produced++
+				if !incremental {
+					resp.Rows = append(resp.Rows, row)
+				} else if produced > int(query.Offset) {
+					if err = stream.add(resp, row); err != nil {
+						return true
+					}
+				}
+				if err = ctx.Err(); err != nil {
+					return true
+				}
+				return enough >= 0 && produced >= enough
+			})
+			if err != nil {
+				resp.Error = err.Error()
+				return resp, err
+			}
+			if enough >= 0 && produced >= enough {
+				break graphs
+			}
+		}
+	}
+
+	if count && agg == nil {
+		counted -= query.Offset
+		if counted < 0 {
+			counted = 0
+		}
+		if query.Limit > 0 && counted > query.Limit {
+			counted = query.Limit
+		}
+		resp.Count = counted
+		return
+	}
+	if incremental {
+		// the OFFSET was skipped and the LIMIT stopped the query as the rows were sent
+		if resp.Count = int64(produced) - query.Offset; resp.Count < 0 {
+			resp.Count = 0
+		}
+		err = stream.flush(resp)
+		return
+	}
+
+	if agg != nil {
+		resp.Rows = agg.rows()
+	}
+	if len(query.Order) > 0 {
+		sortRows(resp.Rows, vars, query.Order)
+		if len(vars) > len(query.Vars) {
+			resp.Rows = projectRows(resp.Rows, len(query.Vars), distinct)
+		}
+	}
+	resp.Rows = sliceRows(resp.Rows, query.Offset, query.Limit)
+	resp.Count = int64(len(resp.Rows))
+	if count {
+		resp.Rows = resp.Rows[:0]
+	} else if stream != nil {
+		for _, row := range resp.Rows {
+			if err = stream.add(resp, row); err != nil {
+				return
+			}
+		}
+		resp.Rows = nil
+		err = stream.flush(resp)
+	}
+	return
+}
+
+func hasGraphPatterns(query *logpb.SelectQuery) bool {
+	for _, union := range query.Unions {
+		if len(union.GraphPatterns) > 0 {
+			return true
+		}
+	}
+	return len(query.GraphPatterns) > 0
+}
+
+func hasString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// sorts the rows, whose values are for the given variables, on the order conditions.
+// Empty (unbound) values sort before all others
+func sortRows(rows []*logpb.Row, vars []string, order []*logpb.OrderCondition) {
+	var positions = make([]int, len(order))
+	for idx, cond := range order {
+		for pos, varname := range vars {
+			if varname == cond.Var {
+				positions[idx] = pos
+			}
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		for idx, cond := range order {
+			a, b := rows[i].Values[positions[idx]], rows[j].Values[positions[idx]]
+			var cmp int
+			switch {
+			case a.Value == "" && b.Value == "":
+				cmp = 0
+			case a.Value == "":
+				cmp = -1
+			case b.Value == "":
+				cmp = 1
+			default:
+				cmp = compareTerms(turtle.URIFromProto(a), turtle.URIFromProto(b))
+			}
+			if cond.Descending {
+				cmp = -cmp
+			}
+			if cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+}
+
+// keeps the first n values of each row. If distinct is true, rows that become
+// duplicates are dropped
+func projectRows(rows []*logpb.Row, n int, distinct bool) []*logpb.Row {
+	var seen = make(map[string]struct{})
+	var projected = rows[:0]
+	for _, row := range rows {
+		row.Values = row.Values[:n]
+		if !distinct {
+			projected = append(projected, row)
+			continue
+		}
+		key := rowKey(row.Values)
+		if _, found := seen[key]; !found {
+			projected = append(projected, row)
+			seen[key] = struct{}{}
+		}
+	}
+	return projected
+}
+
+// applies the OFFSET and LIMIT to the rows; a limit of 0 keeps all remaining rows
+func sliceRows(rows []*logpb.Row, offset, limit int64) []*logpb.Row {
+	if offset >= int64(len(rows)) {
+		return rows[:0]
+	}
+	if offset > 0 {
+		rows = rows[offset:]
+	}
+	if limit > 0 && limit < int64(len(rows)) {
+		rows = rows[:limit]
+	}
+	return rows
+}
+
+// runs the group's terms, left-joins the results of each of its OPTIONAL groups
+// and applies its FILTERs, returning the cursor holding the resulting relation
+func (hod *HodDB) selectGroup(graph string, version uint64, group *logpb.TripleGroup, resp *logpb.Response) (*Cursor, error) {
+	cursor, err := hod.selectTerms(graph, version, group.Terms, resp)
+	if err != nil {
+		return nil, err
+	}
+	for _, optional := range group.Optional {
+		optcursor, err := hod.selectGroup(graph, version, optional, resp)
+		if err != nil {
+			return nil, err
+		}
+		var on []string
+		for varname := range optcursor.rel.vars {
+			if _, found := cursor.rel.vars[varname]; found {
+				on = append(on, varname)
 			} else {
-				query.Where[idx].Object = hod.expandURI(triple.Object, graph)
+				cursor.optionalVars[varname] = struct{}{}
 			}
 		}
-		dg := makeDependencyGraph(cursor, vars, query.Where)
-		qp, err := formQueryPlan(dg, nil)
+		cursor.rel.leftJoin(optcursor.rel, on)
+		for varname, pos := range cursor.rel.vars {
+			cursor.variablePosition[varname] = pos
+		}
+	}
+	if err := cursor.filterRows(group.Filters); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// plans and runs the conjunction of the terms against the version of the graph,
+// returning the cursor holding the results. Errors running individual
+// operations are recorded on the response but do not stop the query. The terms
+// are expanded with the graph's prefixes in a copy, so they can be run against
+// other graphs too
+func (hod *HodDB) selectTerms(graph string, version uint64, terms []*logpb.Triple, resp *logpb.Response) (*Cursor, error) {
+	cursor, err := hod.Cursor(graph)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	cursor.key = cursor.key.atVersion(version)
+	cursor.layerOntology()
+
+	var _vars = make(map[string]struct{})
+	var vars []string
+
+	trackVar := func(varname string) {
+		if _, found := _vars[varname]; !found {
+			_vars[varname] = struct{}{}
+			vars = append(vars, varname)
+		}
+	}
+
+	expanded := make([]*logpb.Triple, len(terms))
+	for idx := range terms {
+		expanded[idx] = proto.Clone(terms[idx]).(*logpb.Triple)
+	}
+	terms = expanded
+	for idx, triple := range terms {
+		if isVariable(triple.Subject) {
+			trackVar(triple.Subject.Value)
+		} else {
+			terms[idx].Subject = hod.expandURI(triple.Subject, graph)
+		}
+		if isVariable(triple.Predicate[0]) {
+			trackVar(triple.Predicate[0].Value)
+		} else {
+			terms[idx].Predicate[0] = hod.expandURI(triple.Predicate[0], graph)
+		}
+		if isVariable(triple.Object) {
+			trackVar(triple.Object.Value)
+		} else {
+			terms[idx].Object = hod.expandURI(triple.Object, graph)
+		}
+	}
+	dg := makeDependencyGraph(cursor, vars, terms)
+	qp, err := formQueryPlan(dg, nil)
+	if err != nil {
+		resp.Error = err.Error()
+		err = errors.Wrap(err, "Could not form query plan")
+		log.Error(err)
+		return nil, err
+	}
+	qp.variables = vars
+	cursor.addQueryPlan(qp)
+
+	for _, op := range qp.operations {
+		err := op.run(cursor)
 		if err != nil {
+			err = errors.Wrapf(err, "Could not run op %s", op)
 			resp.Error = err.Error()
-			err = errors.Wrap(err, "Could not form query plan")
 			log.Error(err)
+			continue
+			//return resp, err
+		}
+	}
+	return cursor, nil
+}
+
+// Insert evaluates the WHERE clause of the query against each of the given graphs
+// and adds the INSERT template, filled in with each of the resulting rows, to
+// that graph. Non-blocking inserts return once the triples have been generated
+// and add them to the graph in the background.
+func (hod *HodDB) Insert(ctx context.Context, query *logpb.InsertQuery) (resp *logpb.Response, err error) {
+	resp = new(logpb.Response)
+	graphs, err := hod.resolveGraphs(ctx, query.Graphs)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, err
+	}
+	for _, graph := range graphs {
+		dataset, err := hod.instantiateTemplate(ctx, graph, 0, query.Insert, query.Where)
+		if err != nil {
+			resp.Error = err.Error()
 			return resp, err
 		}
-		qp.variables = vars
-		cursor.addQueryPlan(qp)
-		cursor.selectVars = query.Vars
+		resp.Count += int64(len(dataset.Triples))
 
-		for _, op := range qp.operations {
-			err := op.run(cursor)
-			if err != nil {
-				err = errors.Wrapf(err, "Could not run op %s", op)
+		if query.Blocking {
+			if err := hod.AddTriples(graph, dataset); err != nil {
+				err = errors.Wrapf(err, "Could not insert into graph %s", graph)
 				resp.Error = err.Error()
-				log.Error(err)
-				continue
-				//return resp, err
+				return resp, err
 			}
+		} else {
+			go func(graph string, dataset turtle.DataSet) {
+				if err := hod.AddTriples(graph, dataset); err != nil {
+					log.Error(errors.Wrapf(err, "Could not insert into graph %s", graph))
+				}
+			}(graph, dataset)
 		}
-		resp.Variables = query.Vars
-		resp.Rows = append(resp.Rows, cursor.GetRowsWithVar(query.Vars)...)
-		resp.Version = query.Timestamp
-		resp.Count = int64(len(resp.Rows))
-		//cursor.dumpTil(len(vars))
 	}
-	return
+	return resp, nil
+}
 
+// Delete evaluates the WHERE clause of the query against each of the given graphs
+// and removes the DELETE template, filled in with each of the resulting rows,
+// from that graph
+func (hod *HodDB) Delete(ctx context.Context, query *logpb.DeleteQuery) (resp *logpb.Response, err error) {
+	resp = new(logpb.Response)
+	graphs, err := hod.resolveGraphs(ctx, query.Graphs)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, err
+	}
+	for _, graph := range graphs {
+		dataset, err := hod.instantiateTemplate(ctx, graph, 0, query.Delete, query.Where)
+		if err != nil {
+			resp.Error = err.Error()
+			return resp, err
+		}
+		resp.Count += int64(len(dataset.Triples))
+
+		if err := hod.DeleteTriples(graph, dataset); err != nil {
+			err = errors.Wrapf(err, "Could not delete from graph %s", graph)
+			resp.Error = err.Error()
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// returns the graphs named by a query, or all graphs if none (or '*') are named.
+// If the request was authenticated, naming a graph it may not access is an error
+// and only the graphs it may access are included in all graphs
+func (hod *HodDB) resolveGraphs(ctx context.Context, graphs []string) ([]string, error) {
+	p, restricted := principalFromContext(ctx)
+	if len(graphs) > 0 && !(len(graphs) == 1 && graphs[0] == "*") {
+		for _, graph := range graphs {
+			if restricted && !p.allowed(graph) {
+				return nil, status.Errorf(codes.PermissionDenied, "Not allowed to access graph %s", graph)
+			}
+		}
+		return graphs, nil
+	}
+	var all []string
+	hod.RLock()
+	for graph := range hod.graphs {
+		// the ontology graph is layered under the others
+		if graph == ontologyGraph {
+			continue
+		}
+		if !restricted || p.allowed(graph) {
+			all = append(all, graph)
+		}
+	}
+	hod.RUnlock()
+	// keep the order of rows from different graphs stable across queries
+	sort.Strings(all)
+	return all, nil
+}
+
+// evaluates the WHERE clause against the version of the graph (0 for the newest
+// committed version) and fills in the template with each of the resulting rows.
+// With no WHERE clause, the template is used as-is
+func (hod *HodDB) instantiateTemplate(ctx context.Context, graph string, version uint64, template, where []*logpb.Triple) (turtle.DataSet, error) {
+	var _vars = make(map[string]struct{})
+	var vars []string
+	trackVar := func(uri *logpb.URI) {
+		if !isVariable(uri) {
+			return
+		}
+		if _, found := _vars[uri.Value]; !found {
+			_vars[uri.Value] = struct{}{}
+			vars = append(vars, uri.Value)
+		}
+	}
+	for _, triple := range where {
+		trackVar(triple.Subject)
+		trackVar(triple.Predicate[0])
+		trackVar(triple.Object)
+	}
+
+	rows := []*logpb.Row{{}}
+	if len(where) > 0 {
+		// duplicate rows would only generate the same triples again
+		sq := &logpb.SelectQuery{
+			Vars:      vars,
+			Graphs:    []string{graph},
+			Where:     where,
+			Distinct:  true,
+			Filter:    logpb.TimeFilter_At,
+			Timestamp: int64(version),
+		}
+		selected, err := hod.Select(ctx, sq)
+		if err != nil {
+			return turtle.DataSet{}, errors.Wrapf(err, "Could not evaluate WHERE clause on graph %s", graph)
+		}
+		rows = selected.Rows
+	}
+	return hod.expandTemplate(graph, template, rows, vars)
+}
+
+// substitutes each row of bindings into the template to generate triples
+func (hod *HodDB) expandTemplate(graph string, template []*logpb.Triple, rows []*logpb.Row, vars []string) (turtle.DataSet, error) {
+	dataset := *turtle.NewDataSet()
+
+	positions := make(map[string]int)
+	for idx, varname := range vars {
+		positions[varname] = idx
+	}
+	// blank nodes in the template that WHERE does not bind are new nodes for each row
+	blankNodes := make(map[string]int)
+	resolve := func(uri *logpb.URI, row *logpb.Row, rowIdx int) (turtle.URI, error) {
+		if isVariable(uri) {
+			idx, found := positions[uri.Value]
+			if (!found || idx >= len(row.Values)) && sparql.IsBlankVar(uri.Value) {
+				if _, found := blankNodes[uri.Value]; !found {
+					blankNodes[uri.Value] = len(blankNodes)
+				}
+				return turtle.URI{Namespace: turtle.BlankNamespace, Value: fmt.Sprintf("t%dr%d", blankNodes[uri.Value], rowIdx)}, nil
+			}
+			if !found || idx >= len(row.Values) {
+				return turtle.URI{}, errors.Errorf("Variable %s in template is not bound by WHERE", uri.Value)
+			}
+			return turtle.URIFromProto(row.Values[idx]), nil
+		}
+		expanded := hod.expandURI(&logpb.URI{Namespace: uri.Namespace, Value: uri.Value, Datatype: uri.Datatype, Lang: uri.Lang}, graph)
+		if expanded == nil {
+			return turtle.URI{}, errors.Wrapf(ErrGraphNotFound, "Graph %s", graph)
+		}
+		return turtle.URIFromProto(expanded), nil
+	}
+
+	for _, term := range template {
+		if len(term.Predicate) != 1 {
+			return dataset, errors.Errorf("Template terms must have a single predicate, not a path (%s)", term)
+		}
+		for rowIdx, row := range rows {
+			var (
+				triple turtle.Triple
+				err    error
+			)
+			if triple.Subject, err = resolve(term.Subject, row, rowIdx); err != nil {
+				return dataset, err
+			}
+			if triple.Predicate, err = resolve(term.Predicate[0], row, rowIdx); err != nil {
+				return dataset, err
+			}
+			if triple.Object, err = resolve(term.Object, row, rowIdx); err != nil {
+				return dataset, err
+			}
+			dataset.AddTripleURIs(triple.Subject, triple.Predicate, triple.Object)
+		}
+	}
+	return dataset, nil
 }
 
 func (hod *HodDB) Dump(e *Entity) {
@@ -408,16 +1200,9 @@ func (hod *HodDB) Dump(e *Entity) {
 }
 
 func (hod *HodDB) s(u EntityKey) string {
-	uri := hod.uris[u]
+	uri, _ := hod.getURI(u)
 	if uri.Namespace != "" {
 		return uri.Namespace + "#" + uri.Value
 	}
 	return uri.Value
 }
-
-func (hod *HodDB) getURI(key EntityKey) (turtle.URI, bool) {
-	hod.RLock()
-	uri, found := hod.uris[key]
-	hod.RUnlock()
-	return uri, found
-}
@@ -0,0 +1,263 @@
+package hod
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	stderrors "errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Requests to the API carry a static API token or a JWT as a bearer token. Each
+// token is allowed to access a list of graphs; queries that name other graphs
+// are rejected, and queries over all graphs only see the allowed ones. Calls
+// made within the process (rather than through the API) are not restricted.
+// HTTP requests are authenticated before they reach the gateway, which passes
+// the principal on to the API under an ID that is only valid for the request,
+// so the token is only verified once.
+
+// gRPC metadata carrying the ID of the principal of an HTTP request
+const forwardedPrincipalHeader = "hod-principal"
+
+var errNoToken = status.Error(codes.Unauthenticated, "Missing bearer token")
+var errBadToken = status.Error(codes.Unauthenticated, "Invalid bearer token")
+
+// the graphs an authenticated request may access
+type principal struct {
+	all    bool
+	graphs map[string]struct{}
+}
+
+func newPrincipal(graphs []string) *principal {
+	p := &principal{graphs: make(map[string]struct{})}
+	for _, graph := range graphs {
+		if graph == "*" {
+			p.all = true
+		}
+		p.graphs[graph] = struct{}{}
+	}
+	return p
+}
+
+func (p *principal) allowed(graph string) bool {
+	_, found := p.graphs[graph]
+	return p.all || found
+}
+
+type principalKey struct{}
+
+func principalFromContext(ctx context.Context) (*principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*principal)
+	return p, ok
+}
+
+// key of the ID of the principal in the context of an HTTP request
+type forwardedKey struct{}
+
+type authenticator struct {
+	tokens []AuthToken
+	// ID -> *principal of the HTTP requests being served
+	forwarded sync.Map
+	// []byte, *rsa.PublicKey or *ecdsa.PublicKey; nil if JWTs are not accepted
+	jwtKey    interface{}
+	jwtParser *jwt.Parser
+}
+
+func newAuthenticator(cfg *Config) (*authenticator, error) {
+	a := &authenticator{tokens: cfg.Auth.Tokens}
+	if cfg.Auth.JWTKeyFile != "" {
+		var err error
+		if a.jwtKey, err = readJWTKey(cfg.Auth.JWTKeyFile); err != nil {
+			return nil, errors.Wrapf(err, "Could not read JWT key %s", cfg.Auth.JWTKeyFile)
+		}
+		a.jwtParser = jwt.NewParser(jwt.WithValidMethods(jwtMethods(a.jwtKey)))
+	}
+	if len(a.tokens) == 0 && a.jwtKey == nil {
+		return nil, errors.New("Auth is enabled but there are no tokens or JWT key")
+	}
+	return a, nil
+}
+
+// reads a PEM public key (or certificate), or else an HMAC secret
+func readJWTKey(file string) (interface{}, error) {
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(contents)
+	if block == nil {
+		secret := []byte(strings.TrimSpace(string(contents)))
+		if len(secret) == 0 {
+			return nil, errors.New("empty HMAC secret")
+		}
+		return secret, nil
+	}
+	var key interface{}
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+			key = cert.PublicKey
+		}
+	default:
+		return nil, errors.Errorf("unsupported PEM block %s", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	}
+	return nil, errors.Errorf("unsupported public key %T", key)
+}
+
+// returns the graphs the token may access
+func (a *authenticator) authenticate(token string) (*principal, error) {
+	if token == "" {
+		return nil, errNoToken
+	}
+	for _, static := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(static.Token), []byte(token)) == 1 {
+			return newPrincipal(static.Graphs), nil
+		}
+	}
+	if a.jwtKey != nil && strings.Count(token, ".") == 2 {
+		return a.verifyJWT(token)
+	}
+	return nil, errBadToken
+}
+
+// the claims of a JWT: the registered claims, and the graphs it may access
+type jwtClaims struct {
+	Graphs []string `json:"graphs"`
+	jwt.RegisteredClaims
+}
+
+// returns the only algorithms that JWTs verified with the key may be signed
+// with: HS256 for a secret, RS256 for an RSA key and ES256 for an ECDSA key
+func jwtMethods(key interface{}) []string {
+	switch key.(type) {
+	case []byte:
+		return []string{jwt.SigningMethodHS256.Alg()}
+	case *rsa.PublicKey:
+		return []string{jwt.SigningMethodRS256.Alg()}
+	case *ecdsa.PublicKey:
+		return []string{jwt.SigningMethodES256.Alg()}
+	}
+	return nil
+}
+
+// verifies the algorithm and signature of the JWT, and its expiry and start if
+// it has them
+func (a *authenticator) verifyJWT(token string) (*principal, error) {
+	var claims jwtClaims
+	_, err := a.jwtParser.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return a.jwtKey, nil
+	})
+	if stderrors.Is(err, jwt.ErrTokenExpired) || stderrors.Is(err, jwt.ErrTokenNotValidYet) {
+		return nil, status.Error(codes.Unauthenticated, "Expired bearer token")
+	} else if err != nil {
+		return nil, errBadToken
+	}
+	return newPrincipal(claims.Graphs), nil
+}
+
+// returns the token from an "Authorization: Bearer <token>" header value
+func bearerToken(header string) string {
+	const prefix = "bearer "
+	if len(header) > len(prefix) && strings.EqualFold(header[:len(prefix)], prefix) {
+		return strings.TrimSpace(header[len(prefix):])
+	}
+	return ""
+}
+
+// authenticates the request from its gRPC metadata, adding the principal to the context
+func (a *authenticator) authenticateContext(ctx context.Context) (context.Context, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = bearerToken(values[0])
+		}
+	}
+	p, err := a.authenticate(token)
+	if err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, principalKey{}, p), nil
+}
+
+// adds the principal of the HTTP request the gateway is calling the API for to
+// the context
+func (a *authenticator) forwardedContext(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	// the gateway also passes on metadata from the request's headers
+	ids := md.Get(forwardedPrincipalHeader)
+	if len(ids) != 1 {
+		return nil, errNoToken
+	}
+	p, found := a.forwarded.Load(ids[0])
+	if !found {
+		return nil, errBadToken
+	}
+	return context.WithValue(ctx, principalKey{}, p.(*principal)), nil
+}
+
+// returns the metadata passing the ID of the request's principal on to the API
+func forwardPrincipal(ctx context.Context, r *http.Request) metadata.MD {
+	if id, ok := r.Context().Value(forwardedKey{}).(string); ok {
+		return metadata.Pairs(forwardedPrincipalHeader, id)
+	}
+	return nil
+}
+
+// a server stream whose context carries the principal
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// rejects HTTP requests without a valid bearer token before they reach the
+// gateway, and keeps the principal of the others while they are served
+func (a *authenticator) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, err := a.authenticate(bearerToken(r.Header.Get("Authorization")))
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+			return
+		}
+		id := make([]byte, 16)
+		if _, err := rand.Read(id); err != nil {
+			http.Error(w, "Could not authenticate request", http.StatusInternalServerError)
+			return
+		}
+		key := hex.EncodeToString(id)
+		a.forwarded.Store(key, p)
+		defer a.forwarded.Delete(key)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), forwardedKey{}, key)))
+	})
+}
@@ -0,0 +1,330 @@
+package hod
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	logpb "github.com/gtfierro/hoddb/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// signs the claims as a JWT with the given algorithm and key
+func signTestJWT(t *testing.T, alg string, key interface{}, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var sig []byte
+	switch key := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(signed))
+		sig = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		require.NoError(t, err)
+		sig = make([]byte, 64)
+		rb, sb := r.Bytes(), s.Bytes()
+		copy(sig[32-len(rb):32], rb)
+		copy(sig[64-len(sb):], sb)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func writePublicKey(t *testing.T, file string, key interface{}) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+}
+
+func TestAuthenticate(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	secret := []byte("hunter2-but-longer")
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, "secret"), append(secret, '\n'), 0600))
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(err)
+	writePublicKey(t, filepath.Join(dir, "rsa.pem"), &rsaKey.PublicKey)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	writePublicKey(t, filepath.Join(dir, "ec.pem"), &ecKey.PublicKey)
+
+	cfg := &Config{}
+	cfg.Auth.Tokens = []AuthToken{{Token: "abc", Graphs: []string{"soda"}}, {Token: "admin", Graphs: []string{"*"}}}
+
+	// static tokens
+	a, err := newAuthenticator(cfg)
+	require.NoError(err)
+	p, err := a.authenticate("abc")
+	require.NoError(err)
+	require.True(p.allowed("soda"))
+	require.False(p.allowed("test"))
+	p, err = a.authenticate("admin")
+	require.NoError(err)
+	require.True(p.allowed("test"))
+	_, err = a.authenticate("abcd")
+	require.Equal(codes.Unauthenticated, status.Code(err))
+	_, err = a.authenticate("")
+	require.Equal(codes.Unauthenticated, status.Code(err))
+
+	future := time.Now().Add(time.Hour).Unix()
+	past := time.Now().Add(-time.Hour).Unix()
+	claims := map[string]interface{}{"graphs": []string{"test"}, "exp": future}
+
+	for _, test := range []struct {
+		keyfile string
+		alg     string
+		key     interface{}
+	}{
+		{"secret", "HS256", secret},
+		{"rsa.pem", "RS256", rsaKey},
+		{"ec.pem", "ES256", ecKey},
+	} {
+		cfg.Auth.JWTKeyFile = filepath.Join(dir, test.keyfile)
+		a, err := newAuthenticator(cfg)
+		require.NoError(err, test.alg)
+
+		p, err := a.authenticate(signTestJWT(t, test.alg, test.key, claims))
+		require.NoError(err, test.alg)
+		require.True(p.allowed("test"), test.alg)
+		require.False(p.allowed("soda"), test.alg)
+
+		// expired
+		_, err = a.authenticate(signTestJWT(t, test.alg, test.key, map[string]interface{}{"graphs": []string{"test"}, "exp": past}))
+		require.Equal(codes.Unauthenticated, status.Code(err), test.alg)
+
+		// tampered claims
+		token := signTestJWT(t, test.alg, test.key, claims)
+		parts := strings.Split(token, ".")
+		forged, _ := json.Marshal(map[string]interface{}{"graphs": []string{"*"}, "exp": future})
+		parts[1] = base64.RawURLEncoding.EncodeToString(forged)
+		_, err = a.authenticate(strings.Join(parts, "."))
+		require.Equal(codes.Unauthenticated, status.Code(err), test.alg)
+
+		// the algorithm must match the key
+		_, err = a.authenticate(signTestJWT(t, "none", nil, claims))
+		require.Equal(codes.Unauthenticated, status.Code(err), test.alg)
+	}
+
+	// other algorithms for the same type of key are not accepted either
+	cfg.Auth.JWTKeyFile = filepath.Join(dir, "secret")
+	a, err = newAuthenticator(cfg)
+	require.NoError(err)
+	hs512, err := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims(claims)).SignedString(secret)
+	require.NoError(err)
+	_, err = a.authenticate(hs512)
+	require.Equal(codes.Unauthenticated, status.Code(err))
+
+	// an HMAC token is not accepted by a public key, even when signed with its bytes
+	cfg.Auth.JWTKeyFile = filepath.Join(dir, "rsa.pem")
+	a, err = newAuthenticator(cfg)
+	require.NoError(err)
+	pemBytes, err := ioutil.ReadFile(cfg.Auth.JWTKeyFile)
+	require.NoError(err)
+	_, err = a.authenticate(signTestJWT(t, "HS256", pemBytes, claims))
+	require.Equal(codes.Unauthenticated, status.Code(err))
+
+	// auth needs some way to authenticate
+	_, err = newAuthenticator(&Config{})
+	require.Error(err)
+}
+
+func TestAuthorizeGraphs(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	port := freePort(t)
+	grpcPort := freePort(t)
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+grpc:
+    enable: true
+    address: 127.0.0.1
+    port: %s
+http:
+    enable: true
+    address: 127.0.0.1
+    port: %s
+auth:
+    enable: true
+    tokens:
+        - token: tenant-a
+          graphs: [test]
+        - token: Admin
+          graphs: ["*"]
+    `, dir, grpcPort, port)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.Equal([]AuthToken{{Token: "tenant-a", Graphs: []string{"test"}}, {Token: "Admin", Graphs: []string{"*"}}}, cfg.Auth.Tokens)
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	for _, graph := range []string{"test", "other"} {
+		bundle := FileBundle{
+			GraphName:     graph,
+			TTLFile:       "example.ttl",
+			OntologyFiles: []string{"BrickFrame.ttl"},
+		}
+		require.NoError(hod.Load(bundle), "load files")
+	}
+
+	go func() {
+		log.Error(hod.ServeGRPC())
+	}()
+
+	conn, err := grpc.Dial("127.0.0.1:"+grpcPort, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(5*time.Second))
+	require.NoError(err)
+	defer conn.Close()
+	client := logpb.NewHodDBClient(conn)
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	}
+
+	q, err := hod.ParseQuery("SELECT ?x FROM * WHERE { ?x rdf:type brick:Room }", 0)
+	require.NoError(err)
+
+	// no token, or a bad one
+	_, err = client.Select(context.Background(), q)
+	require.Equal(codes.Unauthenticated, status.Code(err))
+	_, err = client.Select(withToken("tenant-b"), q)
+	require.Equal(codes.Unauthenticated, status.Code(err))
+
+	// all graphs are only the allowed graphs
+	resp, err := client.Select(withToken("tenant-a"), q)
+	require.NoError(err)
+	require.Equal(1, len(resp.Rows))
+	resp, err = client.Count(withToken("tenant-a"), q)
+	require.NoError(err)
+	require.Equal(int64(1), resp.Count)
+	resp, err = client.Select(withToken("Admin"), q)
+	require.NoError(err)
+	require.Equal(2, len(resp.Rows))
+
+	// naming a graph that is not allowed is rejected
+	q.Graphs = []string{"test", "other"}
+	_, err = client.Select(withToken("tenant-a"), q)
+	require.Equal(codes.PermissionDenied, status.Code(err))
+	stream, err := client.SelectStream(withToken("tenant-a"), q)
+	require.NoError(err)
+	_, err = stream.Recv()
+	require.Equal(codes.PermissionDenied, status.Code(err))
+	_, err = client.Versions(withToken("tenant-a"), &logpb.VersionQuery{Graphs: []string{"other"}})
+	require.Equal(codes.PermissionDenied, status.Code(err))
+	iq, err := hod.ParseInsertQuery(`INSERT { bldg:room_9 rdf:type brick:Room } TO other`)
+	require.NoError(err)
+	_, err = client.Insert(withToken("tenant-a"), iq)
+	require.Equal(codes.PermissionDenied, status.Code(err))
+
+	// versions of all graphs are only those of the allowed graphs
+	resp, err = client.Versions(withToken("tenant-a"), &logpb.VersionQuery{})
+	require.NoError(err)
+	for _, row := range resp.Rows {
+		require.Equal("test", row.Values[0].Value)
+	}
+
+	// calls within the process are not restricted
+	resp, err = hod.Select(context.Background(), q)
+	require.NoError(err)
+	require.Equal(2, len(resp.Rows))
+
+	// a query for all graphs is left for all graphs once a restricted call ran it
+	all, err := hod.ParseQuery("SELECT ?x FROM * WHERE { ?x rdf:type brick:Room }", 0)
+	require.NoError(err)
+	restricted := context.WithValue(context.Background(), principalKey{}, newPrincipal([]string{"test"}))
+	resp, err = hod.Select(restricted, all)
+	require.NoError(err)
+	require.Equal(1, len(resp.Rows))
+	require.Empty(all.Graphs)
+	resp, err = hod.Select(context.Background(), all)
+	require.NoError(err)
+	require.Equal(2, len(resp.Rows))
+
+	// the HTTP API needs a token too
+	for i := 0; i < 50; i++ {
+		if c, err := net.Dial("tcp", "127.0.0.1:"+port); err == nil {
+			c.Close()
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	body, err := json.Marshal(q)
+	require.NoError(err)
+	httpResp, err := http.Post("http://127.0.0.1:"+port+"/v1/hoddb/select", "application/json", strings.NewReader(string(body)))
+	require.NoError(err)
+	httpResp.Body.Close()
+	require.Equal(http.StatusUnauthorized, httpResp.StatusCode)
+
+	for token, code := range map[string]int{"tenant-a": http.StatusForbidden, "Admin": http.StatusOK} {
+		req, err := http.NewRequest("POST", "http://127.0.0.1:"+port+"/v1/hoddb/select", strings.NewReader(string(body)))
+		require.NoError(err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		httpResp, err = http.DefaultClient.Do(req)
+		require.NoError(err)
+		httpResp.Body.Close()
+		require.Equal(code, httpResp.StatusCode, token)
+	}
+
+	// the gateway passes the principal the token was verified for on to the API
+	auth, err := newAuthenticator(cfg)
+	require.NoError(err)
+	handler, server, err := hod.gatewayHandler(auth)
+	require.NoError(err)
+	defer server.Stop()
+	serve := func(header, value string) int {
+		req := httptest.NewRequest("POST", "/v1/hoddb/select", strings.NewReader(string(body)))
+		req.Header.Set("Authorization", "Bearer Admin")
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+	require.Equal(http.StatusOK, serve("", ""))
+	// requests can not pass on a principal of their own
+	require.Equal(http.StatusUnauthorized, serve("Grpc-Metadata-"+forwardedPrincipalHeader, "forged"))
+	// and the principal is only kept while the request is served
+	auth.forwarded.Range(func(key, _ interface{}) bool {
+		t.Errorf("principal %v kept after the request", key)
+		return true
+	})
+}
@@ -0,0 +1,74 @@
+package hod
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	turtle "github.com/gtfierro/hoddb/turtle"
+)
+
+// Blank nodes are only identified by their labels within the file or request
+// that contains them: the same label in two files is two different nodes. Before
+// triples are added to a graph their blank nodes are skolemized, replacing each
+// with an IRI in turtle.SKOLEM_NAMESPACE made of an ID for the load and the node's
+// label. Since each graph has its own dictionary, the IRIs are scoped to the graph
+// and to the load. Queries return these IRIs, and can use them to refer to the
+// nodes.
+//
+// Files are identified by their path, so loading a file again gives its
+// labelled blank nodes the same IRIs even if the file changed, and reloading it
+// only changes the triples that changed. Only labelled nodes are stable: the
+// parser labels anonymous nodes ([] and collections) by their position in the
+// file, so adding or removing one changes the IRIs of the anonymous nodes after
+// it, and reloading replaces their triples. Documents are identified by their
+// contents, so loading an unchanged document again does not add new nodes.
+// Every other write is a load of its own.
+
+// counts the loads since the process started
+var loadCounter uint64
+
+// returns an ID that no other load has
+func newLoadID() string {
+	n := atomic.AddUint64(&loadCounter, 1)
+	return strconv.FormatInt(time.Now().UnixNano(), 36) + "." + strconv.FormatUint(n, 36)
+}
+
+// returns the ID of loading a document with the given contents
+func documentLoadID(contents []byte) string {
+	hash := sha256.Sum256(contents)
+	return hex.EncodeToString(hash[:8])
+}
+
+// returns the ID of loading the file, from its path rather than its contents,
+// so that its labelled blank nodes keep their IRIs when it changes
+func fileLoadID(filename string) string {
+	if abs, err := filepath.Abs(filename); err == nil {
+		filename = abs
+	}
+	return documentLoadID([]byte(filename))
+}
+
+// returns the dataset with its blank nodes replaced by skolem IRIs for the load
+func skolemize(dataset turtle.DataSet, load string) turtle.DataSet {
+	triples := make([]turtle.Triple, len(dataset.Triples))
+	for idx, triple := range dataset.Triples {
+		triples[idx] = turtle.Triple{
+			Subject:   skolemIRI(triple.Subject, load),
+			Predicate: skolemIRI(triple.Predicate, load),
+			Object:    skolemIRI(triple.Object, load),
+		}
+	}
+	dataset.Triples = triples
+	return dataset
+}
+
+func skolemIRI(uri turtle.URI, load string) turtle.URI {
+	if uri.Namespace != turtle.BlankNamespace {
+		return uri
+	}
+	return turtle.URI{Namespace: turtle.SKOLEM_NAMESPACE, Value: load + "." + uri.Value}
+}
@@ -0,0 +1,131 @@
+package hod
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlankNodes(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	defer hod.Close()
+
+	// both files use the label _:point for different nodes
+	var files []string
+	for _, tag := range []string{"A", "B"} {
+		file := filepath.Join(dir, tag+".ttl")
+		require.NoError(ioutil.WriteFile(file, []byte(`
+@prefix bldg: <http://buildsys.org/ontologies/building_example#> .
+@prefix bf: <https://brickschema.org/schema/1.1/BrickFrame#> .
+bldg:room_1 bf:hasPoint _:point .
+_:point bf:hasTag "`+tag+`" .
+`), 0600))
+		files = append(files, file)
+	}
+	require.NoError(hod.Load(FileBundle{GraphName: "test", TTLFile: "example.ttl", OntologyFiles: append([]string{"BrickFrame.ttl"}, files...)}))
+
+	// the nodes are returned as skolem IRIs
+	q, err := hod.ParseQuery("SELECT ?p ?t FROM test WHERE { bldg:room_1 bf:hasPoint ?p . ?p bf:hasTag ?t }", 0)
+	require.NoError(err)
+	resp, err := hod.Select(context.Background(), q)
+	require.NoError(err)
+	require.Len(resp.Rows, 2)
+	require.NotEqual(resp.Rows[0].Values[0].Value, resp.Rows[1].Values[0].Value)
+	for _, row := range resp.Rows {
+		require.Equal(turtle.SKOLEM_NAMESPACE, row.Values[0].Namespace)
+		iri := turtle.URIFromProto(row.Values[0]).String()
+		require.Equal([]string{row.Values[1].Value}, queryValues(t, hod, fmt.Sprintf("SELECT ?t FROM test WHERE { <%s> bf:hasTag ?t }", iri)))
+	}
+
+	// blank nodes in queries are variables that are not selected
+	require.Equal([]string{"A", "B"}, queryValues(t, hod, "SELECT ?t FROM test WHERE { bldg:room_1 bf:hasPoint [ bf:hasTag ?t ] }"))
+	require.Equal([]string{"A", "B"}, queryValues(t, hod, "SELECT ?t FROM test WHERE { bldg:room_1 bf:hasPoint _:p . _:p bf:hasTag ?t }"))
+	require.Equal([]string{"room_1"}, queryValues(t, hod, `SELECT * FROM test WHERE { ?r bf:hasPoint [ bf:hasTag "A" ; bf:isPointOf ?r ] }`))
+	require.Equal([]string{"A", "B"}, queryValues(t, hod, "SELECT ?t FROM test WHERE { ?p bf:hasTag ?t FILTER(isBlank(?p) && isIRI(?p)) }"))
+	require.Empty(queryValues(t, hod, "SELECT ?r FROM test WHERE { ?r rdf:type brick:Room FILTER(isBlank(?r)) }"))
+
+	// each row gets its own new nodes for the blank nodes of an INSERT template
+	iq, err := hod.ParseInsertQuery(`INSERT { ?r bf:hasPoint [ bf:hasTag ?t ] } TO test WHERE { ?r rdf:type brick:Room . ?r bf:hasPoint ?p . ?p bf:hasTag ?t }`)
+	require.NoError(err)
+	_, err = hod.Insert(context.Background(), iq)
+	require.NoError(err)
+	require.Len(queryValues(t, hod, "SELECT ?p FROM test WHERE { bldg:room_1 bf:hasPoint ?p . ?p bf:hasTag ?t }"), 4)
+
+	// a file keeps the IRIs of its blank nodes when it is changed and loaded
+	// again, so only the changed triples change
+	file := filepath.Join(dir, "points.ttl")
+	writePoints := func(tag string) {
+		require.NoError(ioutil.WriteFile(file, []byte(`
+@prefix bldg: <http://buildsys.org/ontologies/building_example#> .
+@prefix bf: <https://brickschema.org/schema/1.1/BrickFrame#> .
+bldg:room_1 bf:hasPoint _:p1, _:p2 .
+_:p1 bf:hasTag "one" .
+_:p2 bf:hasTag "`+tag+`" .
+`), 0600))
+	}
+	points := func() map[string]string {
+		q, err := hod.ParseQuery("SELECT ?p ?t FROM points WHERE { bldg:room_1 bf:hasPoint ?p . ?p bf:hasTag ?t }", 0)
+		require.NoError(err)
+		resp, err := hod.Select(context.Background(), q)
+		require.NoError(err)
+		tags := make(map[string]string)
+		for _, row := range resp.Rows {
+			tags[row.Values[1].Value] = turtle.URIFromProto(row.Values[0]).String()
+		}
+		return tags
+	}
+	bundle := FileBundle{GraphName: "points", TTLFile: file, OntologyFiles: []string{"BrickFrame.ttl"}}
+	writePoints("two")
+	require.NoError(hod.Load(bundle))
+	before := points()
+	writePoints("deux")
+	require.NoError(hod.Load(bundle))
+	after := points()
+	require.NotContains(after, "two")
+	require.Equal(before["one"], after["one"])
+	require.Equal(before["two"], after["deux"])
+
+	// only labelled nodes keep their IRIs: anonymous nodes are labelled by their
+	// position, so adding one may change the IRIs of the others, but reloading
+	// replaces their triples
+	file = filepath.Join(dir, "anonymous.ttl")
+	writeAnonymous := func(first string) {
+		require.NoError(ioutil.WriteFile(file, []byte(`
+@prefix bldg: <http://buildsys.org/ontologies/building_example#> .
+`+first+`
+bldg:area_1 bldg:hasSensor _:p1 .
+_:p1 bldg:label "one" .
+bldg:area_2 bldg:hasSensor [ bldg:label "two" ] .
+`), 0600))
+	}
+	labelled := "SELECT ?p FROM anonymous WHERE { bldg:area_1 bldg:hasSensor ?p }"
+	tags := "SELECT ?t FROM anonymous WHERE { ?r bldg:hasSensor ?p . ?p bldg:label ?t }"
+	bundle = FileBundle{GraphName: "anonymous", TTLFile: file, OntologyFiles: []string{"BrickFrame.ttl"}}
+	writeAnonymous("")
+	require.NoError(hod.Load(bundle))
+	point := queryValues(t, hod, labelled)
+	require.Len(point, 1)
+	require.Equal([]string{"one", "two"}, queryValues(t, hod, tags))
+	writeAnonymous(`bldg:area_0 bldg:hasSensor [ bldg:label "zero" ] .`)
+	require.NoError(hod.Load(bundle))
+	require.Equal(point, queryValues(t, hod, labelled))
+	require.Equal([]string{"one", "two", "zero"}, queryValues(t, hod, tags))
+	require.Equal([]string{"two"}, queryValues(t, hod, "SELECT ?t FROM anonymous WHERE { bldg:area_2 bldg:hasSensor ?p . ?p bldg:label ?t }"))
+}
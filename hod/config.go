@@ -5,8 +5,10 @@ import (
 	"os/user"
 	"path/filepath"
 	"strings"
+	"time"
 
 	//"github.com/op/go-logging"
+	"github.com/pkg/errors"
 	logrus "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
@@ -16,6 +18,15 @@ type Config struct {
 		Path       string
 		Buildings  map[string]string
 		Ontologies []string
+		// CONSTRUCT queries the reasoner applies to the graphs in their FROM
+		// clause (or all graphs), e.g. to materialize a site's conventions
+		Rules []string
+		// reload a building while the server runs when its files change
+		WatchFiles bool
+		// how often the files are checked for changes
+		WatchInterval time.Duration
+		// number of URIs whose IDs are kept in memory; 0 uses the default
+		DictionaryCacheSize int
 	}
 
 	Output struct {
@@ -26,12 +37,28 @@ type Config struct {
 		Enable  bool
 		Address string
 		Port    string
+		// origins allowed to make cross-origin requests; "*" allows any origin
+		CorsOrigins []string
+		TLS         TLSConfig
+		// largest response, in bytes, that a request over HTTP can return;
+		// 0 uses the default. Streamed responses are limited per batch of rows
+		MaxResponseSize int
 	}
 
 	Grpc struct {
 		Enable  bool
 		Address string
 		Port    string
+		TLS     TLSConfig
+	}
+
+	Auth struct {
+		Enable bool
+		// static API tokens and the graphs each may access
+		Tokens []AuthToken
+		// JWTs are verified with this key: an HMAC secret or a PEM RSA or ECDSA
+		// public key. The graphs a JWT may access are in its "graphs" claim
+		JWTKeyFile string
 	}
 
 	Profile struct {
@@ -43,21 +70,38 @@ type Config struct {
 	}
 }
 
+// TLSConfig holds the paths to the PEM files for serving TLS. If ClientCAFile is
+// set, clients must present a certificate signed by it (mutual TLS)
+type TLSConfig struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+}
+
+// AuthToken is a static API token. Graphs lists the graphs it may access; "*"
+// allows all graphs
+type AuthToken struct {
+	Token  string
+	Graphs []string
+}
+
 func init() {
 	prefix := os.Getenv("GOPATH")
 	// switch prefix to default GOPATH /home/{user}/go
 	if prefix == "" {
-		u, err := user.Current()
-		if err != nil {
-			log.Fatal(err)
+		if u, err := user.Current(); err == nil {
+			prefix = filepath.Join(u.HomeDir, "go")
+		} else {
+			log.Warning(errors.Wrap(err, "Could not find home directory for default ontologies"))
 		}
-		prefix = filepath.Join(u.HomeDir, "go")
 	}
 	// set defaults for config
 
 	// Database
 	viper.SetDefault("Database.Path", "_hod_")
 	viper.SetDefault("Database.Buildings", make(map[string]string))
+	viper.SetDefault("Database.WatchFiles", false)
+	viper.SetDefault("Database.WatchInterval", "10s")
 	viper.SetDefault("Database.Ontologies", []string{
 		prefix + "/src/github.com/gtfierro/hod/BrickFrame.ttl",
 		prefix + "/src/github.com/gtfierro/hod/Brick.ttl",
@@ -70,6 +114,15 @@ func init() {
 	viper.SetDefault("Grpc.Address", "localhost")
 	viper.SetDefault("Grpc.Port", "47808")
 
+	// HTTP Interface
+	viper.SetDefault("Http.Enable", true)
+	viper.SetDefault("Http.Address", "localhost")
+	viper.SetDefault("Http.Port", "47809")
+	viper.SetDefault("Http.CorsOrigins", []string{})
+
+	// Auth
+	viper.SetDefault("Auth.Enable", false)
+
 	// Profile
 	viper.SetDefault("Profile.EnableCpu", false)
 	viper.SetDefault("Profile.EnableMem", false)
@@ -95,14 +148,28 @@ func getCfg() *Config {
 	cfg.Database.Path = viper.GetString("Database.Path")
 	cfg.Database.Buildings = viper.GetStringMapString("Database.Buildings")
 	cfg.Database.Ontologies = viper.GetStringSlice("Database.Ontologies")
+	cfg.Database.Rules = viper.GetStringSlice("Database.Rules")
+	cfg.Database.WatchFiles = viper.GetBool("Database.WatchFiles")
+	cfg.Database.WatchInterval = viper.GetDuration("Database.WatchInterval")
+	cfg.Database.DictionaryCacheSize = viper.GetInt("Database.DictionaryCacheSize")
 
 	cfg.Http.Enable = viper.GetBool("Http.Enable")
 	cfg.Http.Address = viper.GetString("Http.Address")
 	cfg.Http.Port = viper.GetString("Http.Port")
+	cfg.Http.CorsOrigins = viper.GetStringSlice("Http.CorsOrigins")
+	cfg.Http.TLS = getTLSCfg("Http.TLS")
+	cfg.Http.MaxResponseSize = viper.GetInt("Http.MaxResponseSize")
 
 	cfg.Grpc.Enable = viper.GetBool("Grpc.Enable")
 	cfg.Grpc.Address = viper.GetString("Grpc.Address")
 	cfg.Grpc.Port = viper.GetString("Grpc.Port")
+	cfg.Grpc.TLS = getTLSCfg("Grpc.TLS")
+
+	cfg.Auth.Enable = viper.GetBool("Auth.Enable")
+	if err := viper.UnmarshalKey("Auth.Tokens", &cfg.Auth.Tokens); err != nil {
+		log.Error(errors.Wrap(err, "Could not read Auth.Tokens"))
+	}
+	cfg.Auth.JWTKeyFile = viper.GetString("Auth.JWTKeyFile")
 
 	cfg.Profile.EnableCpu = viper.GetBool("Profile.EnableCpu")
 	cfg.Profile.EnableMem = viper.GetBool("Profile.EnableMem")
@@ -112,6 +179,14 @@ func getCfg() *Config {
 	return cfg
 }
 
+func getTLSCfg(prefix string) TLSConfig {
+	return TLSConfig{
+		CertFile:     viper.GetString(prefix + ".CertFile"),
+		KeyFile:      viper.GetString(prefix + ".KeyFile"),
+		ClientCAFile: viper.GetString(prefix + ".ClientCAFile"),
+	}
+}
+
 func ReadConfig(file string) (*Config, error) {
 	if len(file) > 0 {
 		viper.SetConfigFile(file)
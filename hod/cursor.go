@@ -2,7 +2,6 @@ package hod
 
 import (
 	"encoding/binary"
-	"fmt"
 	"strings"
 	"sync"
 
@@ -10,7 +9,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	logpb "github.com/gtfierro/hoddb/proto"
 	turtle "github.com/gtfierro/hoddb/turtle"
-	"github.com/spaolacci/murmur3"
+	"github.com/pkg/errors"
 	"github.com/zhangxinngang/murmur"
 )
 
@@ -24,6 +23,13 @@ type Cursor struct {
 	rel              *relation
 	plan             *queryPlan
 	namespaces       map[string]string
+	// variables bound only by OPTIONAL groups, which may be empty in a row
+	optionalVars map[string]struct{}
+	// whether the ontology graph, with this hash, is layered under the graph,
+	// and the version of it that is read
+	layered         bool
+	ontology        [4]byte
+	ontologyVersion uint64
 	sync.RWMutex
 }
 
@@ -39,13 +45,15 @@ func (hod *HodDB) Cursor(graphname string) (*Cursor, error) {
 		hod:              hod,
 		variablePosition: make(map[string]int),
 		cache:            make(map[EntityKey]*Entity),
+		optionalVars:     make(map[string]struct{}),
 	}
 	_namespaces, ok := hod.namespaces.Load(graphname)
 	if !ok {
-		return nil, fmt.Errorf("Graph '%s' not found", graphname)
+		return nil, errors.Wrapf(ErrGraphNotFound, "Graph '%s'", graphname)
 	}
 	c.namespaces = _namespaces.(map[string]string)
 	copy(c.key.Graph[:], hashString(graphname))
+	c.key = c.key.atVersion(latestVersion)
 	//c.addQueryPlan(plan)
 	return c, nil
 }
@@ -67,7 +75,13 @@ func (c *Cursor) getEntity(key EntityKey) (*Entity, error) {
 	}
 	c.RUnlock()
 
-	entity, err := c.hod.GetEntity(key)
+	var entity *Entity
+	var err error
+	if c.layered {
+		entity, err = c.getLayeredEntity(key)
+	} else {
+		entity, err = c.hod.getEntityAt(key, c.key.version())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -112,67 +126,114 @@ func (c *Cursor) join(other *relation, on []string) {
 	c.rel.join(other, on, c)
 }
 
+// returns the key of the URI in the cursor's graph, or in the ontology graph
+// layered under it. URIs that are in neither get an ID that no entity has,
+// without adding them to the graph
 func (c *Cursor) ContextualizeURI(u *logpb.URI) EntityKey {
-	//var key EntityKey
-	key := c.hod.hashURI(c.graphname, turtle.URI{u.Namespace, u.Value})
-	//copy(key.Hash[:], c.hod.hashURI(u))
+	uri := turtle.URIFromProto(u)
+	key, found := c.hod.lookupURI(c.graphname, uri)
+	if !found && c.layered {
+		if shared, found := c.hod.lookupURI(ontologyGraph, uri); found {
+			return shared
+		}
+	}
+	if !found {
+		key.Hash = _e4
+	}
 	copy(key.Graph[:], c.key.Graph[:])
-	copy(key.Version[:], c.key.Version[:])
 	return key
 }
 
+// calls f on each entity in the graph as of the cursor's version, stopping
+// early if f returns true
 func (c *Cursor) Iterate(f func(EntityKey, *Entity) bool) error {
 	opt := badger.DefaultIteratorOptions
 	opt.PrefetchSize = 10
+	version := c.key.version()
 	err := c.hod.db.View(func(txn *badger.Txn) error {
 		it := txn.NewIterator(opt)
 		defer it.Close()
 		prefix := c.key.Graph[:]
-		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
-			item := it.Item()
-			key := EntityKeyFromBytes(item.Key())
 
+		// each entity is stored once per version that wrote it, oldest first;
+		// keep the newest value at or before the cursor's version
+		var current EntityKey
+		var value []byte
+		emit := func() (bool, error) {
+			if len(value) == 0 {
+				return false, nil
+			}
 			var entity = &Entity{
 				compiled: new(logpb.Entity),
-				key:      key,
+				key:      current,
 			}
-			err := item.Value(func(b []byte) error {
-				return proto.Unmarshal(b, entity.compiled)
-			})
-			if err != nil {
-				return err
+			if err := proto.Unmarshal(value, entity.compiled); err != nil {
+				return false, err
 			}
-			if f(entity.key, entity) {
-				break
+			return f(entity.key, entity), nil
+		}
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			if len(item.Key()) != 16 {
+				continue
+			}
+			key := EntityKeyFromBytes(item.Key())
+			if key.unversioned() != current {
+				if stop, err := emit(); err != nil || stop {
+					return err
+				}
+				current = key.unversioned()
+				value = nil
+			}
+			if key.version() > version {
+				continue
+			}
+			var err error
+			if value, err = item.ValueCopy(value[:0]); err != nil {
+				return err
 			}
 		}
-		return nil
+		_, err := emit()
+		return err
 	})
 	return err
 }
 
 func (c *Cursor) expandURI(uri *logpb.URI) *logpb.URI {
 	if !strings.HasPrefix(uri.Value, "?") {
-		if len(uri.Value) == 0 {
-			return uri
-		}
-		if uri.Namespace != "" && (uri.Value[0] != '"' && uri.Value[len(uri.Value)-1] != '"') {
-			if full, found := c.namespaces[uri.Namespace]; found {
-				uri.Namespace = full
-			}
-		}
+		expandPrefix(uri, c.namespaces)
 	}
 	return uri
 }
 
 func (c *Cursor) GetRowsWithVar(mandatory []string) (returnRows []*logpb.Row) {
-	var seen = make(map[uint32]struct{})
+	c.iterRows(mandatory, func(row *logpb.Row) bool {
+		returnRows = append(returnRows, row)
+		return false
+	})
+	return
+}
+
+// calls f on the row of values for the variables of each solution, stopping early
+// if f returns true. Rows are not deduplicated. Values are only looked up for the
+// rows that are produced
+func (c *Cursor) iterRows(mandatory []string, f func(row *logpb.Row) bool) {
 rows:
 	for _, row := range c.rel.rows {
 		var addRow = new(logpb.Row)
 		for _, varname := range mandatory {
-			key := row.valueAt(c.variablePosition[varname])
-			if key.Empty() {
+			pos, found := c.variablePosition[varname]
+			if !found {
+				// not bound by this part of the query (e.g. the other side of a UNION)
+				addRow.Values = append(addRow.Values, &logpb.URI{})
+				continue
+			}
+			key := row.valueAt(pos)
+			if _, optional := c.optionalVars[varname]; optional && key.Empty() {
+				addRow.Values = append(addRow.Values, &logpb.URI{})
+				continue
+			} else if key.Empty() {
 				continue rows
 			}
 			val, found := c.hod.getURI(key)
@@ -181,20 +242,51 @@ rows:
 			}
 			addRow.Values = append(addRow.Values, convertURI(val))
 		}
-		h := hashRow2(addRow)
-		if _, found := seen[h]; !found {
-			returnRows = append(returnRows, addRow)
-			seen[h] = struct{}{}
+		if f(addRow) {
+			return
 		}
 	}
-	return
 }
 
-func hashRow2(row *logpb.Row) uint32 {
-	h := murmur3.New32()
-	for _, val := range row.Values {
-		h.Write([]byte(val.Namespace))
-		h.Write([]byte(val.Value))
+// counts the rows of values for the variables. If seen is not nil, only the
+// distinct rows that are not already in seen are counted, and are added to it.
+// Unlike iterRows, no values are looked up, so rows are told apart by the keys of
+// their values
+func (c *Cursor) countRows(mandatory []string, seen map[string]struct{}) int {
+	var counted int
+	var rowkey []byte
+rows:
+	for _, row := range c.rel.rows {
+		rowkey = rowkey[:0]
+		for _, varname := range mandatory {
+			// variables not bound by this part of the query are left empty
+			var key EntityKey
+			if pos, found := c.variablePosition[varname]; found {
+				key = row.valueAt(pos)
+				if _, optional := c.optionalVars[varname]; key.Empty() && !optional {
+					continue rows
+				}
+			}
+			rowkey = append(rowkey, key.Bytes()...)
+		}
+		if seen == nil {
+			counted++
+		} else if _, found := seen[string(rowkey)]; !found {
+			seen[string(rowkey)] = struct{}{}
+			counted++
+		}
+	}
+	return counted
+}
+
+// returns a key that is the same for two rows only if all of their values are equal
+func rowKey(values []*logpb.URI) string {
+	var b []byte
+	var size [binary.MaxVarintLen64]byte
+	for _, val := range values {
+		encoded := encodeURI(turtle.URIFromProto(val))
+		b = append(b, size[:binary.PutUvarint(size[:], uint64(len(encoded)))]...)
+		b = append(b, encoded...)
 	}
-	return h.Sum32()
+	return string(b)
 }
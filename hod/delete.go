@@ -0,0 +1,515 @@
+package hod
+
+import (
+	"encoding/binary"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/proto"
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+)
+
+// prefix for the keys recording which triples were explicitly added to a graph,
+// as opposed to generated by the inference rules. Like entities, the records
+// are stored under the version of the graph that wrote them, so a failed write
+// can remove them, and an empty value marks a triple that was deleted at that
+// version
+var assertedpfx = []byte("assertedpfx")
+
+// subject, predicate, object
+type tripleKey [3]EntityKey
+
+// returns the prefix of the keys recording the triple at each version
+func (key tripleKey) Bytes() []byte {
+	var b []byte
+	b = append(b, assertedpfx...)
+	b = append(b, key[0].Bytes()...)
+	b = append(b, key[1].Bytes()...)
+	b = append(b, key[2].Bytes()...)
+	return b
+}
+
+// returns the key recording the triple at the given version
+func (key tripleKey) atVersion(version uint64) []byte {
+	var v = make([]byte, 8)
+	binary.BigEndian.PutUint64(v, version)
+	return append(key.Bytes(), v...)
+}
+
+func tripleKeyFromBytes(b []byte) tripleKey {
+	b = b[len(assertedpfx):]
+	return tripleKey{EntityKeyFromBytes(b[:16]), EntityKeyFromBytes(b[16:32]), EntityKeyFromBytes(b[32:48])}
+}
+
+// returns the version of the graph that wrote the record of a triple
+func assertedVersion(b []byte) uint64 {
+	return binary.BigEndian.Uint64(b[len(b)-8:])
+}
+
+// records the triples as explicitly added to the graph, as part of the graph's
+// pending version
+func (hod *HodDB) markAsserted(graphname string, triples []turtle.Triple) error {
+	version, err := hod.writeVersion(graphname)
+	if err != nil {
+		return err
+	}
+	wb := hod.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, triple := range triples {
+		var key tripleKey
+		for idx, uri := range []turtle.URI{triple.Subject, triple.Predicate, triple.Object} {
+			var err error
+			if key[idx], err = hod.hashURI(graphname, uri); err != nil {
+				return err
+			}
+		}
+		if err := wb.Set(key.atVersion(version), []byte{1}); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+// records the triples as no longer explicitly added to the graph, as part of
+// the graph's pending version. Earlier versions still have them
+func (hod *HodDB) unmarkAsserted(graphname string, keys []tripleKey) error {
+	version, err := hod.writeVersion(graphname)
+	if err != nil {
+		return err
+	}
+	wb := hod.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range keys {
+		if err := wb.Set(key.atVersion(version), []byte{}); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+// returns true if the triple was explicitly added to its graph as of the given
+// version
+func (hod *HodDB) isAsserted(key tripleKey, version uint64) (bool, error) {
+	var asserted bool
+	prefix := key.Bytes()
+	err := hod.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Reverse = true
+		opts.Prefix = prefix
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		it.Seek(key.atVersion(version))
+		if !it.ValidForPrefix(prefix) {
+			return nil
+		}
+		return it.Item().Value(func(b []byte) error {
+			asserted = len(b) > 0
+			return nil
+		})
+	})
+	return asserted, err
+}
+
+// returns all of the triples that were explicitly added to the graph as of the
+// given version
+func (hod *HodDB) getAsserted(graphname string, version uint64) (map[tripleKey]struct{}, error) {
+	asserted := make(map[tripleKey]struct{})
+	prefix := append(append([]byte{}, assertedpfx...), hashString(graphname)...)
+	err := hod.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		// the records of each triple are ordered by version, so the last one
+		// at or before the version is its state at that version
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			if assertedVersion(item.Key()) > version {
+				continue
+			}
+			triple := tripleKeyFromBytes(item.Key())
+			err := item.Value(func(b []byte) error {
+				if len(b) > 0 {
+					asserted[triple] = struct{}{}
+				} else {
+					delete(asserted, triple)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return asserted, err
+}
+
+// a set of entities from a graph that are modified together and then written back
+type entityBatch struct {
+	hod       *HodDB
+	graphname string
+	entities  map[EntityKey]*Entity
+}
+
+func newEntityBatch(hod *HodDB, graphname string) *entityBatch {
+	return &entityBatch{
+		hod:       hod,
+		graphname: graphname,
+		entities:  make(map[EntityKey]*Entity),
+	}
+}
+
+func (batch *entityBatch) get(key EntityKey) (*Entity, error) {
+	if ent, found := batch.entities[key]; found {
+		return ent, nil
+	}
+	ent, err := batch.hod.GetEntity(key)
+	if err == badger.ErrKeyNotFound {
+		ent = newEntity(key)
+	} else if err != nil {
+		return nil, err
+	} else {
+		ent.FromCompiled()
+	}
+	batch.entities[key] = ent
+	return ent, nil
+}
+
+// removes the single edge for the triple from its subject, object and predicate
+func (batch *entityBatch) removeTriple(triple tripleKey) error {
+	subject, err := batch.get(triple[0])
+	if err != nil {
+		return err
+	}
+	predicate, err := batch.get(triple[1])
+	if err != nil {
+		return err
+	}
+	object, err := batch.get(triple[2])
+	if err != nil {
+		return err
+	}
+	subject.removeOutEdge(triple[1], triple[2])
+	object.removeInEdge(triple[1], triple[0])
+	predicate.removeEndpoints(triple[0], triple[2])
+	return nil
+}
+
+// writes the modified entities back to the database as part of the graph's
+// pending version, removing those that no longer have any edges
+func (batch *entityBatch) commit() error {
+	version, err := batch.hod.writeVersion(batch.graphname)
+	if err != nil {
+		return err
+	}
+	wb := batch.hod.db.NewWriteBatch()
+	defer wb.Cancel()
+	for key, ent := range batch.entities {
+		if ent.isOrphan() {
+			// earlier versions still have the entity
+			if err := wb.Set(key.atVersion(version).Bytes(), []byte{}); err != nil {
+				return err
+			}
+			continue
+		}
+		ent.Compile()
+		serializedEntry, err := proto.Marshal(ent.compiled)
+		if err != nil {
+			return errors.Wrap(err, "Error serializing entry")
+		}
+		if err := wb.Set(key.atVersion(version).Bytes(), serializedEntry); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+// DeleteTriples removes the triples from the graph. Everything the reasoner
+// inferred from them is retracted along with them, and then re-derived if the
+// triples that remain still support it. Transitive edges for the affected
+// predicates are recomputed. The result is a new version of the graph
+func (hod *HodDB) DeleteTriples(graphname string, dataset turtle.DataSet) error {
+	if err := checkWritable(graphname); err != nil {
+		return err
+	}
+	return hod.newVersion(graphname, func(entry *versionEntry) (err error) {
+		entry.Removed, err = hod.deleteTriples(graphname, dataset)
+		return err
+	})
+}
+
+// returns the number of the given triples that were in the graph. Only the
+// consequences of the deleted triples are retracted and re-derived (the
+// delete/re-derive algorithm), so the work depends on what the triples affect
+// rather than on the size of the graph
+func (hod *HodDB) deleteTriples(graphname string, dataset turtle.DataSet) (int, error) {
+	cursor, err := hod.Cursor(graphname)
+	if err != nil {
+		return 0, err
+	}
+
+	var removed int
+	retract := make(map[tripleKey]turtle.Triple)
+	var unmark []tripleKey
+	for _, triple := range dataset.Triples {
+		key, found, err := hod.graphTripleKey(cursor, triple)
+		if err != nil {
+			return 0, errors.Wrap(err, "Could not read graph")
+		} else if !found {
+			continue
+		}
+		// inferred triples are retracted but come back if they are still supported
+		retract[key] = triple
+		if asserted, err := hod.isAsserted(key, latestVersion); err != nil {
+			return 0, errors.Wrap(err, "Could not read added triples")
+		} else if asserted {
+			removed++
+			unmark = append(unmark, key)
+		}
+	}
+	if err := hod.unmarkAsserted(graphname, unmark); err != nil {
+		return 0, errors.Wrap(err, "Could not update added triples")
+	}
+	var frontier []turtle.Triple
+	for _, triple := range retract {
+		frontier = append(frontier, triple)
+	}
+	return removed, hod.retract(graphname, retract, frontier)
+}
+
+// retracts the triples from the graph, along with everything inferred from the
+// frontier triples, and then re-derives what the triples remaining in the
+// graph still support. The frontier triples need not be in the graph itself:
+// they may be triples removed from the ontology graph layered under it
+func (hod *HodDB) retract(graphname string, retract map[tripleKey]turtle.Triple, frontier []turtle.Triple) error {
+	cursor, err := hod.Cursor(graphname)
+	if err != nil {
+		return err
+	}
+	store, err := hod.newTripleStore(graphname)
+	if err != nil {
+		return err
+	}
+
+	// everything inferred from the retracted triples, and from what was
+	// inferred from those, is retracted too unless it was explicitly added.
+	// Rules are evaluated against the whole graph, so everything a rule builds
+	// is retracted once one of the retracted triples may match its WHERE clause
+	rules := hod.graphRules(graphname)
+	applied := make([]bool, len(rules))
+	for len(frontier) > 0 {
+		var next []turtle.Triple
+		consider := func(triples []turtle.Triple) error {
+			for _, triple := range triples {
+				key, found, err := hod.graphTripleKey(cursor, triple)
+				if err != nil {
+					return err
+				} else if !found {
+					continue
+				}
+				if _, found := retract[key]; found {
+					continue
+				}
+				if asserted, err := hod.isAsserted(key, latestVersion); err != nil {
+					return err
+				} else if asserted {
+					continue
+				}
+				retract[key] = triple
+				next = append(next, triple)
+			}
+			return nil
+		}
+		for _, triple := range frontier {
+			if err := consider(store.infer(triple)); err != nil {
+				return errors.Wrap(err, "Could not read graph")
+			}
+		}
+		for idx, rule := range rules {
+			if applied[idx] || !rule.triggeredBy(frontier) {
+				continue
+			}
+			applied[idx] = true
+			built, err := store.applyRule(rule.rule)
+			if err != nil {
+				return err
+			}
+			if err := consider(built); err != nil {
+				return errors.Wrap(err, "Could not read graph")
+			}
+		}
+		if store.err != nil {
+			return errors.Wrap(store.err, "Could not read graph")
+		}
+		frontier = next
+	}
+
+	// predicate -> entities with an edge for that predicate before the retraction
+	touched := make(map[EntityKey]entityset)
+	batch := newEntityBatch(hod, graphname)
+	for triple := range retract {
+		if err := batch.removeTriple(triple); err != nil {
+			return errors.Wrap(err, "Could not remove triple")
+		}
+		if _, found := touched[triple[1]]; !found {
+			touched[triple[1]] = newEntitySet()
+		}
+		touched[triple[1]].add(triple[0])
+		touched[triple[1]].add(triple[2])
+	}
+	if err := batch.commit(); err != nil {
+		return errors.Wrap(err, "Could not commit deletion")
+	}
+
+	// re-derive the retracted triples that are still supported
+	if err := hod.rederive(graphname, retract, rules); err != nil {
+		return errors.Wrap(err, "Could not re-apply inference rules")
+	}
+	return hod.recomputeTransitiveEdges(graphname, touched)
+}
+
+// adds back the retracted triples that the triples remaining in the graph
+// still support, and what is inferred from them. Each RDFS and OWL rule has a
+// premise with the same subject as what it infers, or with the subject or
+// object of what it infers as its subject, or with the subject of what it
+// infers as its object. Reasoning from those edges of the retracted triples'
+// entities finds every triple they still support. Rules may have any premise,
+// so the rules that may have built a retracted triple are evaluated again
+func (hod *HodDB) rederive(graphname string, retracted map[tripleKey]turtle.Triple, rules []graphRule) error {
+	store, err := hod.newTripleStore(graphname)
+	if err != nil {
+		return err
+	}
+	var premises []turtle.Triple
+	subjects := make(map[turtle.URI]struct{})
+	objects := make(map[turtle.URI]struct{})
+	templates := make(map[turtle.URI]struct{})
+	for _, triple := range retracted {
+		subjects[triple.Subject] = struct{}{}
+		objects[triple.Object] = struct{}{}
+		templates[triple.Predicate] = struct{}{}
+	}
+	for subject := range subjects {
+		for _, edge := range store.edges(subject) {
+			premises = append(premises, turtle.Triple{Subject: subject, Predicate: edge[0], Object: edge[1]})
+		}
+		for _, edge := range store.inEdges(subject) {
+			premises = append(premises, turtle.Triple{Subject: edge[0], Predicate: edge[1], Object: subject})
+		}
+	}
+	for object := range objects {
+		if _, found := subjects[object]; found || object.IsLiteral() {
+			continue
+		}
+		for _, edge := range store.edges(object) {
+			premises = append(premises, turtle.Triple{Subject: object, Predicate: edge[0], Object: edge[1]})
+		}
+	}
+
+	var built []turtle.Triple
+	for _, rule := range rules {
+		if !rule.mayBuild(templates) {
+			continue
+		}
+		triples, err := store.applyRule(rule.rule)
+		if err != nil {
+			return err
+		}
+		for _, triple := range triples {
+			if !store.has(triple) {
+				built = append(built, triple)
+			}
+		}
+	}
+	if store.err != nil {
+		return errors.Wrap(store.err, "Could not read graph")
+	}
+	if len(built) > 0 {
+		dataset := turtle.DataSet{Namespaces: make(map[string]string), Triples: built}
+		if err := hod.addTriples(graphname, dataset); err != nil {
+			return err
+		}
+	}
+	return hod.reason(graphname, append(premises, built...))
+}
+
+// returns the key of the triple if the graph itself has it, rather than the
+// ontology graph layered under it
+func (hod *HodDB) graphTripleKey(cursor *Cursor, triple turtle.Triple) (tripleKey, bool, error) {
+	subject, sfound := hod.lookupURI(cursor.graphname, triple.Subject)
+	predicate, pfound := hod.lookupURI(cursor.graphname, triple.Predicate)
+	object, ofound := hod.lookupURI(cursor.graphname, triple.Object)
+	if !sfound || !pfound || !ofound {
+		return tripleKey{}, false, nil
+	}
+	ent, err := cursor.getEntity(subject)
+	if err == badger.ErrKeyNotFound {
+		return tripleKey{}, false, nil
+	} else if err != nil {
+		return tripleKey{}, false, err
+	}
+	for _, key := range ent.OutEdges(predicate) {
+		if key.is(object.Bytes()) {
+			return tripleKey{subject, predicate, object}, true, nil
+		}
+	}
+	return tripleKey{}, false, nil
+}
+
+// rebuilds the OnePlus edges for each predicate from the single edges that
+// currently exist. The given entities have their OnePlus edges for that
+// predicate dropped, even if they no longer have any single edges for it
+func (hod *HodDB) recomputeTransitiveEdges(graphname string, touched map[EntityKey]entityset) error {
+	batch := newEntityBatch(hod, graphname)
+	for pred, nodes := range touched {
+		predicate, err := batch.get(pred)
+		if err != nil {
+			return err
+		}
+
+		// single edges for this predicate
+		adjacency := make(map[EntityKey][]EntityKey)
+		for endpoints := range predicate.endpoints {
+			adjacency[endpoints[0]] = append(adjacency[endpoints[0]], endpoints[1])
+			nodes.add(endpoints[0])
+			nodes.add(endpoints[1])
+		}
+
+		for node := range nodes {
+			ent, err := batch.get(node)
+			if err != nil {
+				return err
+			}
+			ent.removePlusEdges(pred)
+		}
+
+		for subjectKey := range adjacency {
+			subject, err := batch.get(subjectKey)
+			if err != nil {
+				return err
+			}
+			reachable := newEntitySet()
+			stack := append([]EntityKey{}, adjacency[subjectKey]...)
+			for len(stack) > 0 {
+				next := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				if reachable.addIfNotHas(next) {
+					continue
+				}
+				stack = append(stack, adjacency[next]...)
+			}
+			for objectKey := range reachable {
+				object, err := batch.get(objectKey)
+				if err != nil {
+					return err
+				}
+				subject.addOutEdge(pred, objectKey, logpb.Pattern_OnePlus)
+				object.addInEdge(pred, subjectKey, logpb.Pattern_OnePlus)
+			}
+		}
+	}
+	return batch.commit()
+}
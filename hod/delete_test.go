@@ -0,0 +1,99 @@
+package hod
+
+import (
+	"context"
+	"fmt"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDeleteTriples(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+
+	rows, err := hod.run_query("test", "SELECT ?x ?y WHERE { ?x bf:feeds ?y }")
+	require.NoError(err)
+	require.Equal(2, len(rows))
+	rows, err = hod.run_query("test", "SELECT ?x ?y WHERE { ?x bf:isFedBy ?y }")
+	require.NoError(err)
+	require.Equal(2, len(rows))
+
+	bldg := "http://buildsys.org/ontologies/building_example#"
+	brickframe := "https://brickschema.org/schema/1.1/BrickFrame#"
+	err = hod.DeleteTriples("test", turtle.DataSet{
+		Triples: []turtle.Triple{
+			{
+				Subject:   turtle.ParseURI(bldg + "ahu_1"),
+				Predicate: turtle.ParseURI(brickframe + "feeds"),
+				Object:    turtle.ParseURI(bldg + "vav_1"),
+			},
+		},
+	})
+	require.NoError(err, "delete triples")
+
+	rows, err = hod.run_query("test", "SELECT ?x ?y WHERE { ?x bf:feeds ?y }")
+	require.NoError(err)
+	require.Equal(1, len(rows))
+
+	// the inverse edge was inferred from the deleted one and is retracted too
+	rows, err = hod.run_query("test", "SELECT ?x ?y WHERE { ?x bf:isFedBy ?y }")
+	require.NoError(err)
+	require.Equal(1, len(rows))
+
+	rows, err = hod.run_query("test", "SELECT ?x WHERE { bldg:ahu_1 bf:feeds+ ?x }")
+	require.NoError(err)
+	require.Equal(0, len(rows))
+
+	dq, err := hod.ParseDeleteQuery("DELETE { ?r rdf:type brick:Room } FROM test WHERE { ?r rdf:type brick:Room }")
+	require.NoError(err, "parse delete")
+	resp, err := hod.Delete(context.Background(), dq)
+	require.NoError(err, "delete")
+	require.Equal(int64(1), resp.Count, "deleted triples")
+
+	rows, err = hod.run_query("test", "SELECT ?r WHERE { ?r rdf:type brick:Room }")
+	require.NoError(err)
+	require.Equal(0, len(rows))
+
+	// a FILTER would narrow what is deleted, so it is rejected rather than ignored
+	_, err = hod.ParseDeleteQuery("DELETE { ?x bf:feeds ?y } FROM test WHERE { ?x bf:feeds ?y . FILTER(?y = bldg:vav_1) }")
+	require.Equal(ErrParse, errors.Cause(err))
+
+	// triples with literal objects are deleted from loaded files too, although
+	// their predicates have inverses
+	tags := filepath.Join(dir, "tags.ttl")
+	require.NoError(ioutil.WriteFile(tags, []byte(`
+@prefix bldg: <http://buildsys.org/ontologies/building_example#> .
+@prefix bf: <https://brickschema.org/schema/1.1/BrickFrame#> .
+bldg:point_1 bf:hasTag "Temp", "Sensor" .
+`), 0600))
+	require.NoError(hod.Load(FileBundle{GraphName: "tags", TTLFile: tags, OntologyFiles: []string{"BrickFrame.ttl"}}))
+	require.NoError(hod.DeleteTriples("tags", turtle.DataSet{Triples: []turtle.Triple{
+		{Subject: turtle.ParseURI(bldg + "point_1"), Predicate: turtle.ParseURI(brickframe + "hasTag"), Object: turtle.URI{Value: "Temp"}},
+	}}))
+	require.Equal([]string{"Sensor"}, queryValues(t, hod, "SELECT ?t FROM tags WHERE { bldg:point_1 bf:hasTag ?t }"))
+}
@@ -0,0 +1,372 @@
+package hod
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+
+	"github.com/dgraph-io/badger/v2"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+)
+
+// URIs are identified in entity keys by IDs that each graph assigns in order, so
+// two URIs never share a key. Each graph's dictionary maps its URIs to their IDs
+// and back, and is stored under these prefixes followed by the graph's hash. It
+// is read from badger as it is used, keeping the recently used entries in
+// memory, and the entries a write assigns are saved when the write finishes. No
+// URI has ID 0.
+var (
+	// + graph hash + URI -> ID
+	uriIDpfx = []byte("uriidpfx")
+	// + graph hash + ID -> URI
+	idURIpfx = []byte("iduripfx")
+	// + graph hash -> the next ID to assign
+	nextIDpfx = []byte("nextidpfx")
+)
+
+// number of dictionary entries kept in memory in each direction, unless the
+// config sets it
+const defaultDictionaryCacheSize = 100000
+
+// returns the number of dictionary entries to keep in memory in each direction
+func (cfg *Config) dictionaryCacheSize() int {
+	if cfg.Database.DictionaryCacheSize > 0 {
+		return cfg.Database.DictionaryCacheSize
+	}
+	return defaultDictionaryCacheSize
+}
+
+// the datatype and language tag of literals follow the value, if either is set
+func encodeURI(u turtle.URI) []byte {
+	if u.Datatype != "" || u.Lang != "" {
+		return []byte(u.Namespace + "\x00" + u.Value + "\x00" + u.Datatype + "\x00" + u.Lang)
+	}
+	return []byte(u.Namespace + "\x00" + u.Value)
+}
+
+func decodeURI(b []byte) turtle.URI {
+	parts := strings.SplitN(string(b), "\x00", 4)
+	switch len(parts) {
+	case 1:
+		return turtle.URI{Value: parts[0]}
+	case 4:
+		return turtle.URI{Namespace: parts[0], Value: parts[1], Datatype: parts[2], Lang: parts[3]}
+	}
+	return turtle.URI{Namespace: parts[0], Value: parts[1]}
+}
+
+func uriIDKey(graph [4]byte, u turtle.URI) []byte {
+	return append(append(append([]byte{}, uriIDpfx...), graph[:]...), encodeURI(u)...)
+}
+
+func idURIKey(key EntityKey) []byte {
+	return append(append(append([]byte{}, idURIpfx...), key.Graph[:]...), key.Hash[:]...)
+}
+
+func nextIDKey(graph [4]byte) []byte {
+	return append(append([]byte{}, nextIDpfx...), graph[:]...)
+}
+
+// returns the key for the URI in the graph, assigning the URI the graph's next ID
+// if it has not been seen before
+func (hod *HodDB) hashURI(graph string, u turtle.URI) (EntityKey, error) {
+	hod.RLock()
+	key, found, err := hod.readKey(graph, u)
+	hod.RUnlock()
+	if err != nil || found {
+		return key, err
+	}
+
+	// another write may have assigned the URI an ID, and saved it, since
+	hod.Lock()
+	defer hod.Unlock()
+	key, found, err = hod.readKey(graph, u)
+	if err != nil || found {
+		return key, err
+	}
+	id, err := hod.assignID(key.Graph)
+	if err != nil {
+		return key, errors.Wrapf(err, "Could not assign ID to %s in graph %s", u, graph)
+	}
+	binary.BigEndian.PutUint32(key.Hash[:], id)
+	hod.unsavedKeys[hashkeyentry{graph, u}] = key
+	hod.unsavedURIs[key] = u
+	return key, nil
+}
+
+// returns the key of the URI in the graph, if it has one
+func (hod *HodDB) lookupURI(graph string, u turtle.URI) (EntityKey, bool) {
+	hod.RLock()
+	defer hod.RUnlock()
+	key, found, err := hod.readKey(graph, u)
+	if err != nil {
+		log.Error(errors.Wrapf(err, "Could not look up %s in graph %s", u, graph))
+	}
+	return key, found
+}
+
+// returns the URI with the key
+func (hod *HodDB) getURI(key EntityKey) (turtle.URI, bool) {
+	hod.RLock()
+	defer hod.RUnlock()
+	uri, found, err := hod.readURI(key)
+	if err != nil {
+		log.Error(errors.Wrapf(err, "Could not look up key %v", key))
+	}
+	return uri, found
+}
+
+// returns the key of the URI in the graph, which has the graph's hash even if
+// the URI has no ID. The caller must hold hod's lock
+func (hod *HodDB) readKey(graph string, u turtle.URI) (EntityKey, bool, error) {
+	hk := hashkeyentry{graph, u}
+	if key, found := hod.unsavedKeys[hk]; found {
+		return key, true, nil
+	}
+	if key, found := hod.uriCache.get(hk); found {
+		return key.(EntityKey), true, nil
+	}
+
+	var key EntityKey
+	copy(key.Graph[:], hashString(graph))
+	var found bool
+	err := hod.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(uriIDKey(key.Graph, u))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		found = true
+		return item.Value(func(v []byte) error {
+			copy(key.Hash[:], v)
+			return nil
+		})
+	})
+	if found && err == nil {
+		hod.uriCache.add(hk, key)
+		hod.keyCache.add(key, u)
+	}
+	return key, found && err == nil, err
+}
+
+// returns the URI with the key. The caller must hold hod's lock
+func (hod *HodDB) readURI(key EntityKey) (turtle.URI, bool, error) {
+	key = key.unversioned()
+	if uri, found := hod.unsavedURIs[key]; found {
+		return uri, true, nil
+	}
+	if uri, found := hod.keyCache.get(key); found {
+		return uri.(turtle.URI), true, nil
+	}
+
+	var uri turtle.URI
+	var found bool
+	err := hod.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(idURIKey(key))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		found = true
+		return item.Value(func(v []byte) error {
+			uri = decodeURI(v)
+			return nil
+		})
+	})
+	if found && err == nil {
+		hod.keyCache.add(key, uri)
+	}
+	return uri, found && err == nil, err
+}
+
+// returns the graph's next ID. The caller must hold hod's lock
+func (hod *HodDB) assignID(graph [4]byte) (uint32, error) {
+	next, found := hod.nextIDs[graph]
+	if !found {
+		next = 1
+		err := hod.db.View(func(txn *badger.Txn) error {
+			item, err := txn.Get(nextIDKey(graph))
+			if err == badger.ErrKeyNotFound {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			return item.Value(func(v []byte) error {
+				next = binary.BigEndian.Uint32(v)
+				return nil
+			})
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	// skip the IDs that URIs kept when their database was migrated
+	for {
+		if next == 0 {
+			return 0, errors.New("Graph has no IDs left")
+		}
+		key := EntityKey{Graph: graph}
+		binary.BigEndian.PutUint32(key.Hash[:], next)
+		_, taken, err := hod.readURI(key)
+		if err != nil {
+			return 0, err
+		} else if !taken {
+			break
+		}
+		next++
+	}
+	hod.nextIDs[graph] = next + 1
+	return next, nil
+}
+
+// saves the dictionary entries that have been assigned since the last save. The
+// caller must hold the write lock
+func (hod *HodDB) flushDictionary() error {
+	wb := hod.db.NewWriteBatch()
+	defer wb.Cancel()
+
+	// the entries are looked up in hod.unsavedURIs until they are saved
+	hod.RLock()
+	graphs := make(map[[4]byte]uint32)
+	var err error
+	for key, uri := range hod.unsavedURIs {
+		if err = wb.Set(uriIDKey(key.Graph, uri), append([]byte{}, key.Hash[:]...)); err != nil {
+			break
+		}
+		if err = wb.Set(idURIKey(key), encodeURI(uri)); err != nil {
+			break
+		}
+		graphs[key.Graph] = hod.nextIDs[key.Graph]
+	}
+	hod.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "Could not save URI dictionary")
+	}
+	if len(graphs) == 0 {
+		return nil
+	}
+
+	for graph, next := range graphs {
+		var v = make([]byte, 4)
+		binary.BigEndian.PutUint32(v, next)
+		if err := wb.Set(nextIDKey(graph), v); err != nil {
+			return errors.Wrap(err, "Could not save URI dictionary")
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return errors.Wrap(err, "Could not save URI dictionary")
+	}
+
+	hod.Lock()
+	defer hod.Unlock()
+	for hk, key := range hod.unsavedKeys {
+		hod.uriCache.add(hk, key)
+		hod.keyCache.add(key, hk.Uri)
+	}
+	hod.unsavedKeys = make(map[hashkeyentry]EntityKey)
+	hod.unsavedURIs = make(map[EntityKey]turtle.URI)
+	return nil
+}
+
+// removes the graph's dictionary entries from memory
+func (hod *HodDB) forgetDictionary(name string) {
+	var graph [4]byte
+	copy(graph[:], hashString(name))
+	delete(hod.nextIDs, graph)
+	for hk, key := range hod.unsavedKeys {
+		if hk.Graph == name {
+			delete(hod.unsavedKeys, hk)
+			delete(hod.unsavedURIs, key)
+		}
+	}
+	hod.uriCache.removeIf(func(hk interface{}) bool {
+		return hk.(hashkeyentry).Graph == name
+	})
+	hod.keyCache.removeIf(func(key interface{}) bool {
+		return key.(EntityKey).Graph == graph
+	})
+}
+
+// Databases written before the dictionary stored the URIs as JSON, with the
+// key of each URI (its hash) under hashpfx and the URI of each key under
+// entitypfx. These become dictionary entries: each URI keeps its key, so its
+// hash is its ID, and new URIs are assigned the IDs that no URI has. URIs of
+// graphs with the same hash may have the same key, and can not be migrated.
+// Literals are parsed into their value, datatype and language tag.
+func (hod *HodDB) migrateHashes() error {
+	hashpfx := []byte("hashpfx")
+	entitypfx := []byte("entitypfx")
+	var legacy [][]byte
+	migrated := make(map[EntityKey]turtle.URI)
+	wb := hod.db.NewWriteBatch()
+	defer wb.Cancel()
+	err := hod.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(hashpfx); it.ValidForPrefix(hashpfx); it.Next() {
+			item := it.Item()
+			legacy = append(legacy, item.KeyCopy(nil))
+			var hashkey hashkeyentry
+			if err := json.Unmarshal(item.Key()[len(hashpfx):], &hashkey); err != nil {
+				return err
+			}
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			hashkey.Uri = legacyLiteral(hashkey.Uri)
+			key := EntityKeyFromBytes(value).unversioned()
+			if other, found := migrated[key]; found && other != hashkey.Uri {
+				return errors.Wrapf(ErrHashCollision, "URI %s has the same key as %s", hashkey.Uri, other)
+			}
+			migrated[key] = hashkey.Uri
+			if err := wb.Set(uriIDKey(key.Graph, hashkey.Uri), key.Hash[:]); err != nil {
+				return err
+			}
+			if err := wb.Set(idURIKey(key), encodeURI(hashkey.Uri)); err != nil {
+				return err
+			}
+		}
+		for it.Seek(entitypfx); it.ValidForPrefix(entitypfx); it.Next() {
+			legacy = append(legacy, it.Item().KeyCopy(nil))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(legacy) == 0 {
+		return nil
+	}
+	log.Infof("Migrating %d URI hashes to the URI dictionary", len(legacy))
+	if err := wb.Flush(); err != nil {
+		return err
+	}
+
+	// the old rows are only removed once the dictionary has them
+	wb = hod.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range legacy {
+		if err := wb.Delete(key); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+// returns the literal that a URI from before the dictionary stands for. These
+// literals kept their quotes and their datatype or language tag (other than
+// @en) in the value, and typed literals were split at the '#' of the datatype
+func legacyLiteral(u turtle.URI) turtle.URI {
+	switch {
+	case strings.HasPrefix(u.Namespace, "\""):
+		// the '>' ending the datatype was trimmed
+		return turtle.ParseURI(u.Namespace + "#" + u.Value + ">")
+	case u.Namespace == "" && strings.HasPrefix(u.Value, "\""):
+		return turtle.ParseURI(u.Value)
+	}
+	return u
+}
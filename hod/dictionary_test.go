@@ -0,0 +1,269 @@
+package hod
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// returns the URIs of the graph's dictionary by their IDs
+func dictionaryIDs(t *testing.T, hod *HodDB, graph string) map[uint32]turtle.URI {
+	ids := make(map[uint32]turtle.URI)
+	prefix := append(append([]byte{}, idURIpfx...), hashString(graph)...)
+	require.NoError(t, hod.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			id := binary.BigEndian.Uint32(it.Item().Key()[len(prefix):])
+			err := it.Item().Value(func(v []byte) error {
+				ids[id] = decodeURI(v)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	return ids
+}
+
+func TestURIDictionary(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NoError(hod.Load(FileBundle{GraphName: "test", TTLFile: "example.ttl", OntologyFiles: []string{"BrickFrame.ttl"}}))
+
+	// the graph's URIs have the IDs 1 to n, and are saved by the write
+	loaded := dictionaryIDs(t, hod, "test")
+	require.NotEmpty(loaded)
+	for id := uint32(1); id <= uint32(len(loaded)); id++ {
+		require.Contains(loaded, id)
+		key := EntityKey{}
+		copy(key.Graph[:], hashString("test"))
+		binary.BigEndian.PutUint32(key.Hash[:], id)
+		uri, found := hod.getURI(key)
+		require.True(found)
+		require.Equal(loaded[id], uri)
+	}
+
+	// these URIs had the same hash when keys were hashes of URIs
+	ns := "http://example.com/building"
+	rdfType := turtle.URI{Namespace: RDF_NAMESPACE, Value: "type"}
+	roomClass := turtle.URI{Namespace: "https://brickschema.org/schema/1.1/Brick", Value: "Room"}
+	for _, room := range []string{"room_91189", "room_110737"} {
+		uri := turtle.URI{Namespace: ns, Value: room}
+		require.NoError(hod.AddTriples("test", turtle.DataSet{Triples: []turtle.Triple{{Subject: uri, Predicate: rdfType, Object: roomClass}}}))
+	}
+	rooms := "SELECT ?r FROM test WHERE { ?r rdf:type brick:Room }"
+	require.Equal([]string{"room_1", "room_110737", "room_91189"}, queryValues(t, hod, rooms))
+	require.Equal([]string{"Room"}, queryValues(t, hod, "SELECT ?t FROM test WHERE { <http://example.com/building#room_110737> rdf:type ?t }"))
+	require.Empty(queryValues(t, hod, "SELECT ?t FROM test WHERE { <http://example.com/building#room_404> rdf:type ?t }"))
+	added := dictionaryIDs(t, hod, "test")
+	require.Equal(len(loaded)+2, len(added))
+
+	// reopening keeps the IDs
+	require.NoError(hod.Close())
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "reopen log")
+	require.Equal(added, dictionaryIDs(t, hod, "test"))
+	require.Equal([]string{"room_1", "room_110737", "room_91189"}, queryValues(t, hod, rooms))
+
+	// databases that stored the URIs of keys as JSON are migrated, and keep
+	// their keys
+	legacy := make(map[string][]byte)
+	for id, uri := range added {
+		var key EntityKey
+		copy(key.Graph[:], hashString("test"))
+		binary.BigEndian.PutUint32(key.Hash[:], id)
+		serializedkey, err := json.Marshal(hashkeyentry{"test", uri})
+		require.NoError(err)
+		legacy["hashpfx"+string(serializedkey)] = key.Bytes()
+		serializeduri, err := json.Marshal(uri)
+		require.NoError(err)
+		legacy["entitypfx"+string(key.Bytes())] = serializeduri
+	}
+	countKeys := func(prefix []byte) int {
+		var count int
+		require.NoError(hod.db.View(func(txn *badger.Txn) error {
+			it := txn.NewIterator(badger.DefaultIteratorOptions)
+			defer it.Close()
+			for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+				count++
+			}
+			return nil
+		}))
+		return count
+	}
+	for _, prefix := range [][]byte{uriIDpfx, idURIpfx, nextIDpfx} {
+		require.NoError(hod.db.DropPrefix(prefix))
+	}
+	require.Equal(0, countKeys(idURIpfx))
+	require.NoError(hod.db.Update(func(txn *badger.Txn) error {
+		for k, v := range legacy {
+			if err := txn.Set([]byte(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(hod.Close())
+
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "reopen log")
+	require.Equal(added, dictionaryIDs(t, hod, "test"))
+	require.Equal(0, countKeys([]byte("hashpfx")))
+	require.Equal(0, countKeys([]byte("entitypfx")))
+	require.Equal(len(added), countKeys(uriIDpfx))
+	require.Equal([]string{"room_1", "room_110737", "room_91189"}, queryValues(t, hod, rooms))
+
+	// new URIs get the IDs no URI has
+	uri := turtle.URI{Namespace: ns, Value: "room_3"}
+	require.NoError(hod.AddTriples("test", turtle.DataSet{Triples: []turtle.Triple{{Subject: uri, Predicate: rdfType, Object: roomClass}}}))
+	key, found := hod.lookupURI("test", uri)
+	require.True(found)
+	require.Equal(uint32(len(added)+1), binary.BigEndian.Uint32(key.Hash[:]))
+	require.NoError(hod.Close())
+
+	// the dictionary is read as it is used, keeping only as many entries in
+	// memory as the config allows
+	cfg.Database.DictionaryCacheSize = 10
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "reopen log")
+	defer hod.Close()
+	require.Equal(0, hod.uriCache.len())
+	require.Equal(0, hod.keyCache.len())
+	require.Equal([]string{"room_1", "room_110737", "room_3", "room_91189"}, queryValues(t, hod, rooms))
+	require.Equal([]string{"building_1", "floor_1", "hvaczone_1"}, queryValues(t, hod, "SELECT ?p FROM test WHERE { bldg:room_1 bf:isPartOf+ ?p }"))
+	require.True(hod.uriCache.len() <= 10)
+	require.Equal(10, hod.keyCache.len())
+}
+
+func TestMigrateLegacyLiterals(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfg, err := ReadConfigFromString(fmt.Sprintf(`database:
+    path: %s    `, dir))
+	require.NoError(err, "read config")
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	defer hod.Close()
+
+	// literals as they were stored before the dictionary, and as they are now
+	literals := map[turtle.URI]turtle.URI{
+		{Value: `"Raum eins"@de`}: turtle.NewLiteral("Raum eins", "", "de"),
+		{Namespace: `"72.5"^^<http://www.w3.org/2001/XMLSchema`, Value: "decimal"}: turtle.NewLiteral("72.5", turtle.XSD_NAMESPACE+"#decimal", ""),
+		{Namespace: `"room`, Value: `1"`}:                                          turtle.NewLiteral("room#1", "", ""),
+		{Namespace: "http://example.com/building", Value: "room_1"}:                {Namespace: "http://example.com/building", Value: "room_1"},
+	}
+	keys := make(map[turtle.URI]EntityKey)
+	require.NoError(hod.db.Update(func(txn *badger.Txn) error {
+		for legacy := range literals {
+			var key EntityKey
+			copy(key.Graph[:], hashString("test"))
+			binary.BigEndian.PutUint32(key.Hash[:], uint32(len(keys)+1))
+			keys[legacy] = key
+			serializedkey, err := json.Marshal(hashkeyentry{"test", legacy})
+			if err != nil {
+				return err
+			}
+			if err := txn.Set(append([]byte("hashpfx"), serializedkey...), key.Bytes()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(hod.migrateHashes())
+	for legacy, literal := range literals {
+		key, found := hod.lookupURI("test", literal)
+		require.True(found, literal.String())
+		require.Equal(keys[legacy], key)
+		uri, found := hod.getURI(key)
+		require.True(found)
+		require.Equal(literal, uri)
+	}
+}
+
+func TestMigrateHashCollision(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfg, err := ReadConfigFromString(fmt.Sprintf(`database:
+    path: %s    `, dir))
+	require.NoError(err, "read config")
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+
+	// URIs of graphs with the same hash can have the same key
+	var key EntityKey
+	copy(key.Graph[:], hashString("test"))
+	binary.BigEndian.PutUint32(key.Hash[:], 1)
+	require.NoError(hod.db.Update(func(txn *badger.Txn) error {
+		for _, graph := range []string{"test", "other"} {
+			uri := turtle.URI{Namespace: "http://example.com/building", Value: graph}
+			serializedkey, err := json.Marshal(hashkeyentry{graph, uri})
+			if err != nil {
+				return err
+			}
+			if err := txn.Set(append([]byte("hashpfx"), serializedkey...), key.Bytes()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	err = hod.migrateHashes()
+	require.Equal(ErrHashCollision, errors.Cause(err), fmt.Sprint(err))
+
+	// the database does not open, and is left closed each time
+	require.NoError(hod.Close())
+	for i := 0; i < 2; i++ {
+		_, err = MakeHodDB(cfg)
+		require.Equal(ErrHashCollision, errors.Cause(err), fmt.Sprint(err))
+	}
+}
+
+func TestLRUCache(t *testing.T) {
+	require := require.New(t)
+	cache := newLRUCache(2)
+	cache.add("a", 1)
+	cache.add("b", 2)
+	_, found := cache.get("a")
+	require.True(found)
+
+	// b is the least recently used
+	cache.add("c", 3)
+	require.Equal(2, cache.len())
+	_, found = cache.get("b")
+	require.False(found)
+	value, found := cache.get("a")
+	require.True(found)
+	require.Equal(1, value)
+
+	cache.add("c", 4)
+	value, _ = cache.get("c")
+	require.Equal(4, value)
+	cache.removeIf(func(key interface{}) bool { return key == "a" })
+	require.Equal(1, cache.len())
+}
@@ -41,6 +41,13 @@ func (key EntityKey) Bytes() (r []byte) {
 	return
 }
 
+// returns true if the bytes are the key of the same entity, at any version.
+// Queries can mix the keys of a graph with those of the ontology graph, so the
+// graph is compared as well as the ID
+func (key EntityKey) is(b []byte) bool {
+	return bytes.Equal(key.Graph[:], b[:4]) && bytes.Equal(key.Hash[:], b[4:8])
+}
+
 type Entity struct {
 	key       EntityKey
 	compiled  *logpb.Entity
@@ -84,6 +91,47 @@ func (ent *Entity) addEndpoints(subject, object EntityKey) {
 	ent.endpoints[[2]EntityKey{subject, object}] = struct{}{}
 }
 
+func (ent *Entity) removeInEdge(pred, subject EntityKey) {
+	if subjects, predfound := ent.inedge[pred]; predfound {
+		delete(subjects, subject)
+		if len(subjects) == 0 {
+			delete(ent.inedge, pred)
+		}
+	}
+}
+
+func (ent *Entity) removeOutEdge(pred, object EntityKey) {
+	if objects, predfound := ent.outedge[pred]; predfound {
+		delete(objects, object)
+		if len(objects) == 0 {
+			delete(ent.outedge, pred)
+		}
+	}
+}
+
+func (ent *Entity) removeEndpoints(subject, object EntityKey) {
+	delete(ent.endpoints, [2]EntityKey{subject, object})
+}
+
+// removes all of the transitive (OnePlus) edges for the given predicate
+func (ent *Entity) removePlusEdges(pred EntityKey) {
+	for subject, pattern := range ent.inedge[pred] {
+		if pattern == logpb.Pattern_OnePlus {
+			ent.removeInEdge(pred, subject)
+		}
+	}
+	for object, pattern := range ent.outedge[pred] {
+		if pattern == logpb.Pattern_OnePlus {
+			ent.removeOutEdge(pred, object)
+		}
+	}
+}
+
+// returns true if the entity no longer takes part in any triple
+func (ent *Entity) isOrphan() bool {
+	return len(ent.inedge) == 0 && len(ent.outedge) == 0 && len(ent.endpoints) == 0
+}
+
 func (ent *Entity) FromCompiled() {
 	ent.key = EntityKeyFromBytes(ent.compiled.EntityKey)
 	ent.inedge = make(map[EntityKey]map[EntityKey]logpb.Pattern)
@@ -136,7 +184,7 @@ func (ent *Entity) GetAllPredicates() (preds []EntityKey) {
 edgeloop:
 	for _, edge := range append(ent.compiled.In, ent.compiled.Out...) {
 		for _, p := range preds {
-			if bytes.Equal(p.Hash[:], edge.Predicate[4:8]) {
+			if p.is(edge.Predicate) {
 				continue edgeloop
 			}
 		}
@@ -191,7 +239,7 @@ func (ent *Entity) GetAllOutPlusEdges() (edges [][]EntityKey) {
 
 func (ent *Entity) InEdges(predicate EntityKey) (entities []EntityKey) {
 	for _, edge := range append(ent.compiled.In) {
-		if edge.Pattern == logpb.Pattern_Single && bytes.Equal(predicate.Hash[:], edge.Predicate[4:8]) {
+		if edge.Pattern == logpb.Pattern_Single && predicate.is(edge.Predicate) {
 			entities = append(entities, EntityKeyFromBytes(edge.Value))
 		}
 	}
@@ -199,7 +247,7 @@ func (ent *Entity) InEdges(predicate EntityKey) (entities []EntityKey) {
 }
 func (ent *Entity) OutEdges(predicate EntityKey) (entities []EntityKey) {
 	for _, edge := range append(ent.compiled.Out) {
-		if edge.Pattern == logpb.Pattern_Single && bytes.Equal(predicate.Hash[:], edge.Predicate[4:8]) {
+		if edge.Pattern == logpb.Pattern_Single && predicate.is(edge.Predicate) {
 			entities = append(entities, EntityKeyFromBytes(edge.Value))
 		}
 	}
@@ -207,8 +255,8 @@ func (ent *Entity) OutEdges(predicate EntityKey) (entities []EntityKey) {
 }
 func (ent *Entity) InPlusEdges(predicate EntityKey) (entities []EntityKey) {
 	for _, edge := range append(ent.compiled.In) {
-		//if edge.Pattern != logpb.Pattern_Single && bytes.Equal(predicate.Hash[:], edge.Predicate[4:8]) {
-		if bytes.Equal(predicate.Hash[:], edge.Predicate[4:8]) {
+		//if edge.Pattern != logpb.Pattern_Single && predicate.is(edge.Predicate) {
+		if predicate.is(edge.Predicate) {
 			entities = append(entities, EntityKeyFromBytes(edge.Value))
 		}
 	}
@@ -217,8 +265,8 @@ func (ent *Entity) InPlusEdges(predicate EntityKey) (entities []EntityKey) {
 func (ent *Entity) OutPlusEdges(predicate EntityKey) (entities []EntityKey) {
 	for _, edge := range append(ent.compiled.Out) {
 		//log.Warning(edge.Value, edge.Pattern)
-		//if edge.Pattern != logpb.Pattern_Single && bytes.Equal(predicate.Hash[:], edge.Predicate[4:8]) {
-		if bytes.Equal(predicate.Hash[:], edge.Predicate[4:8]) {
+		//if edge.Pattern != logpb.Pattern_Single && predicate.is(edge.Predicate) {
+		if predicate.is(edge.Predicate) {
 			entities = append(entities, EntityKeyFromBytes(edge.Value))
 		}
 	}
@@ -226,7 +274,7 @@ func (ent *Entity) OutPlusEdges(predicate EntityKey) (entities []EntityKey) {
 }
 func (ent *Entity) GetObjects(subject EntityKey) (objects []EntityKey) {
 	for _, endpoint := range ent.compiled.Endpoints {
-		if bytes.Equal(subject.Hash[:], endpoint.Src[4:8]) {
+		if subject.is(endpoint.Src) {
 			objects = append(objects, EntityKeyFromBytes(endpoint.Dst))
 		}
 	}
@@ -234,7 +282,7 @@ func (ent *Entity) GetObjects(subject EntityKey) (objects []EntityKey) {
 }
 func (ent *Entity) GetSubjects(object EntityKey) (subjects []EntityKey) {
 	for _, endpoint := range ent.compiled.Endpoints {
-		if bytes.Equal(object.Hash[:], endpoint.Dst[4:8]) {
+		if object.is(endpoint.Dst) {
 			subjects = append(subjects, EntityKeyFromBytes(endpoint.Src))
 		}
 	}
@@ -244,7 +292,7 @@ func (ent *Entity) GetAllObjects() (objects []EntityKey) {
 endpointloop:
 	for _, endpoint := range ent.compiled.Endpoints {
 		for _, o := range objects {
-			if bytes.Equal(o.Hash[:], endpoint.Dst[4:8]) {
+			if o.is(endpoint.Dst) {
 				continue endpointloop
 			}
 		}
@@ -256,7 +304,7 @@ func (ent *Entity) GetAllSubjects() (subjects []EntityKey) {
 endpointloop:
 	for _, endpoint := range ent.compiled.Endpoints {
 		for _, s := range subjects {
-			if bytes.Equal(s.Hash[:], endpoint.Src[4:8]) {
+			if s.is(endpoint.Src) {
 				continue endpointloop
 			}
 		}
@@ -268,7 +316,7 @@ func (ent *Entity) GetAllEndpoints() (endpoints [][]EntityKey) {
 endpointloop:
 	for _, endpoint := range ent.compiled.Endpoints {
 		for _, e := range endpoints {
-			if bytes.Equal(e[1].Hash[:], endpoint.Dst[4:8]) && bytes.Equal(e[0].Hash[:], endpoint.Src[4:8]) {
+			if e[1].is(endpoint.Dst) && e[0].is(endpoint.Src) {
 				continue endpointloop
 			}
 		}
@@ -281,6 +329,8 @@ func convertURI(t turtle.URI) *logpb.URI {
 	return &logpb.URI{
 		Namespace: t.Namespace,
 		Value:     t.Value,
+		Datatype:  t.Datatype,
+		Lang:      t.Lang,
 	}
 }
 
@@ -0,0 +1,42 @@
+package hod
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors returned by the API. They are wrapped with the details of what failed,
+// so compare them against errors.Cause(err)
+var (
+	// the graph does not exist
+	ErrGraphNotFound = errors.New("graph not found")
+	// a graph or URI has the same hash as another one, so they would share keys
+	ErrHashCollision = errors.New("hash collision")
+	// a file, document or query is not valid
+	ErrParse = errors.New("could not parse")
+	// the graph only changes when the files it was loaded from do
+	ErrReadOnlyGraph = errors.New("graph is read-only")
+)
+
+// returns the gRPC status error with the code for the error, so that the HTTP
+// gateway answers with the matching HTTP status too
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch errors.Cause(err) {
+	case ErrGraphNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case ErrHashCollision:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ErrParse:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case ErrReadOnlyGraph:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package hod
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTypedErrors(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+	defer hod.Close()
+
+	// missing and invalid files
+	err = hod.Load(FileBundle{GraphName: "test", TTLFile: "example.ttl", OntologyFiles: []string{filepath.Join(dir, "missing.ttl")}})
+	require.Error(err)
+	require.True(os.IsNotExist(errors.Cause(err)), err.Error())
+	invalid := filepath.Join(dir, "invalid.ttl")
+	require.NoError(ioutil.WriteFile(invalid, []byte("this is not turtle"), 0600))
+	err = hod.Load(FileBundle{GraphName: "test", TTLFile: invalid, OntologyFiles: []string{"BrickFrame.ttl"}})
+	require.Equal(ErrParse, errors.Cause(err), err.Error())
+	err = hod.Load(FileBundle{GraphName: "test", TTLFile: "example.ttl", OntologyFiles: []string{invalid}})
+	require.Equal(ErrParse, errors.Cause(err), err.Error())
+	require.False(hod.graphExists("test"))
+
+	require.NoError(hod.Load(FileBundle{GraphName: "test", TTLFile: "example.ttl", OntologyFiles: []string{"BrickFrame.ttl"}}))
+
+	// invalid queries
+	_, err = hod.ParseQuery("SELECT ?x FROM test WHERE {", 0)
+	require.Equal(ErrParse, errors.Cause(err))
+	_, err = hod.ParseInsertQuery("SELECT ?x FROM test WHERE { ?x rdf:type brick:Room }")
+	require.Equal(ErrParse, errors.Cause(err))
+
+	// graphs that do not exist
+	q, err := hod.ParseQuery("SELECT ?x FROM missing WHERE { ?x rdf:type brick:Room }", 0)
+	require.NoError(err)
+	_, err = hod.Select(context.Background(), q)
+	require.Equal(ErrGraphNotFound, errors.Cause(err))
+
+	// the errors have matching status codes over gRPC and HTTP
+	for err, code := range map[error]codes.Code{
+		errors.Wrap(ErrGraphNotFound, "Graph x"): codes.NotFound,
+		errors.Wrap(ErrParse, "bad"):             codes.InvalidArgument,
+		errors.Wrap(ErrHashCollision, "Graph x"): codes.FailedPrecondition,
+		errors.Wrap(ErrReadOnlyGraph, "Graph x"): codes.FailedPrecondition,
+		status.Error(codes.PermissionDenied, ""): codes.PermissionDenied,
+		errors.New("other"):                      codes.Unknown,
+	} {
+		require.Equal(code, status.Code(statusError(err)), err.Error())
+	}
+	require.NoError(statusError(nil))
+}
@@ -0,0 +1,269 @@
+package hod
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+)
+
+// FILTER constraints are evaluated on the rows of the cursor's relation, or on
+// the solutions of a query that joins several graphs. As in SPARQL, an
+// expression that cannot be evaluated for a row (e.g. it uses a variable that is
+// unbound in that row) is an error, and rows for which a constraint is an error
+// are dropped the same as rows for which it is false.
+
+var errUnbound = errors.New("unbound variable")
+var errNotBoolean = errors.New("value is not a boolean")
+
+type rowFilter struct {
+	// expands the prefixes of the constant terms
+	expand  func(uri *logpb.URI) *logpb.URI
+	regexps map[string]*regexp.Regexp
+}
+
+// the values of the variables of a row being filtered
+type filterRow interface {
+	value(varname string) (turtle.URI, bool)
+}
+
+// a row of the cursor's relation
+type cursorRow struct {
+	cursor *Cursor
+	row    *relationRow
+}
+
+func (r cursorRow) value(varname string) (turtle.URI, bool) {
+	pos, found := r.cursor.variablePosition[varname]
+	if !found {
+		return turtle.URI{}, false
+	}
+	key := r.row.valueAt(pos)
+	if key.Empty() {
+		return turtle.URI{}, false
+	}
+	return r.cursor.hod.getURI(key)
+}
+
+func newRowFilter(filters []*logpb.FilterExpr, expand func(uri *logpb.URI) *logpb.URI) (*rowFilter, error) {
+	f := &rowFilter{
+		expand:  expand,
+		regexps: make(map[string]*regexp.Regexp),
+	}
+	// patterns that are constants are checked once, up front
+	for _, filter := range filters {
+		if err := f.compileRegexps(filter); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// returns true if the row satisfies all of the filters
+func (f *rowFilter) keep(filters []*logpb.FilterExpr, row filterRow) bool {
+	for _, filter := range filters {
+		if ok, err := f.test(filter, row); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// drops the rows of the cursor's relation that do not satisfy all of the filters
+func (c *Cursor) filterRows(filters []*logpb.FilterExpr) error {
+	if len(filters) == 0 {
+		return nil
+	}
+	f, err := newRowFilter(filters, c.expandURI)
+	if err != nil {
+		return err
+	}
+	c.rel.filter(func(row *relationRow) bool {
+		return f.keep(filters, cursorRow{c, row})
+	})
+	return nil
+}
+
+func (f *rowFilter) compileRegexps(expr *logpb.FilterExpr) error {
+	if expr.Op == "regex" && isFilterConstant(expr.Args[1]) {
+		flags := ""
+		if len(expr.Args) > 2 {
+			if !isFilterConstant(expr.Args[2]) {
+				return nil
+			}
+			flags = expr.Args[2].Value.Value
+		}
+		if _, err := f.regexp(expr.Args[1].Value.Value, flags); err != nil {
+			return errors.Wrap(err, "Invalid regex in FILTER")
+		}
+	}
+	for _, arg := range expr.Args {
+		if err := f.compileRegexps(arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *rowFilter) regexp(pattern, flags string) (*regexp.Regexp, error) {
+	if flags != "" {
+		// SPARQL flags (i, m, s) have the same meaning in Go
+		pattern = "(?" + flags + ")" + pattern
+	}
+	if re, found := f.regexps[pattern]; found {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	f.regexps[pattern] = re
+	return re, nil
+}
+
+func isFilterConstant(expr *logpb.FilterExpr) bool {
+	return expr.Op == "" && expr.Value != nil && !strings.HasPrefix(expr.Value.Value, "?")
+}
+
+// evaluates the expression for the row as a term
+func (f *rowFilter) term(expr *logpb.FilterExpr, row filterRow) (turtle.URI, error) {
+	if expr.Op != "" {
+		ok, err := f.test(expr, row)
+		return turtle.URI{Value: strconv.FormatBool(ok)}, err
+	}
+	if expr.Value == nil {
+		return turtle.URI{}, errors.New("empty FILTER expression")
+	}
+	if strings.HasPrefix(expr.Value.Value, "?") {
+		uri, found := row.value(expr.Value.Value)
+		if !found {
+			return turtle.URI{}, errUnbound
+		}
+		return uri, nil
+	}
+	uri := f.expand(&logpb.URI{Namespace: expr.Value.Namespace, Value: expr.Value.Value, Datatype: expr.Value.Datatype, Lang: expr.Value.Lang})
+	return turtle.URIFromProto(uri), nil
+}
+
+// evaluates the expression for the row as a boolean
+func (f *rowFilter) test(expr *logpb.FilterExpr, row filterRow) (bool, error) {
+	switch expr.Op {
+	case "||", "&&":
+		// an error on one side is overruled by the other side deciding the result
+		left, lerr := f.test(expr.Args[0], row)
+		right, rerr := f.test(expr.Args[1], row)
+		decides := expr.Op == "||"
+		if (lerr == nil && left == decides) || (rerr == nil && right == decides) {
+			return decides, nil
+		}
+		if lerr != nil {
+			return false, lerr
+		}
+		if rerr != nil {
+			return false, rerr
+		}
+		return !decides, nil
+	case "!":
+		ok, err := f.test(expr.Args[0], row)
+		return !ok, err
+	case "bound":
+		_, err := f.term(expr.Args[0], row)
+		return err == nil, nil
+	case "isuri", "isliteral", "isblank":
+		uri, err := f.term(expr.Args[0], row)
+		if err != nil {
+			return false, err
+		}
+		switch expr.Op {
+		case "isuri":
+			return uri.IsIRI(), nil
+		case "isblank":
+			return uri.IsBlank(), nil
+		}
+		return uri.IsLiteral(), nil
+	}
+
+	args := make([]turtle.URI, len(expr.Args))
+	for idx, arg := range expr.Args {
+		var err error
+		if args[idx], err = f.term(arg, row); err != nil {
+			return false, err
+		}
+	}
+	switch expr.Op {
+	case "":
+		return f.effectiveBoolean(expr, row)
+	case "=":
+		return compareTerms(args[0], args[1]) == 0, nil
+	case "!=":
+		return compareTerms(args[0], args[1]) != 0, nil
+	case "<":
+		return compareTerms(args[0], args[1]) < 0, nil
+	case ">":
+		return compareTerms(args[0], args[1]) > 0, nil
+	case "<=":
+		return compareTerms(args[0], args[1]) <= 0, nil
+	case ">=":
+		return compareTerms(args[0], args[1]) >= 0, nil
+	case "strstarts":
+		return strings.HasPrefix(args[0].String(), args[1].String()), nil
+	case "contains":
+		return strings.Contains(args[0].String(), args[1].String()), nil
+	case "regex":
+		flags := ""
+		if len(args) > 2 {
+			flags = args[2].String()
+		}
+		re, err := f.regexp(args[1].String(), flags)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(args[0].String()), nil
+	}
+	return false, errors.Errorf("Unknown FILTER operator %s", expr.Op)
+}
+
+// the truth value of a term used as a constraint: booleans are themselves,
+// numbers are true unless zero and strings are true unless empty
+func (f *rowFilter) effectiveBoolean(expr *logpb.FilterExpr, row filterRow) (bool, error) {
+	uri, err := f.term(expr, row)
+	if err != nil {
+		return false, err
+	}
+	if !uri.IsLiteral() {
+		return false, errNotBoolean
+	}
+	if b, err := strconv.ParseBool(uri.Value); err == nil {
+		return b, nil
+	}
+	if n, ok := uri.Number(); ok {
+		return n != 0, nil
+	}
+	return uri.Value != "", nil
+}
+
+// orders two terms: numerically if both are numeric literals, by their string
+// form, datatype and language tag otherwise
+func compareTerms(a, b turtle.URI) int {
+	if x, ok := a.Number(); ok {
+		if y, ok := b.Number(); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	if cmp := strings.Compare(a.String(), b.String()); cmp != 0 {
+		return cmp
+	}
+	if cmp := strings.Compare(a.Datatype, b.Datatype); cmp != 0 {
+		return cmp
+	}
+	return strings.Compare(a.Lang, b.Lang)
+}
@@ -2,47 +2,252 @@ package hod
 
 import (
 	"context"
-	"fmt"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	logpb "github.com/gtfierro/hoddb/proto"
+	"github.com/pkg/errors"
 	"github.com/rs/cors"
-	logrus "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"net"
-	"net/http"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/test/bufconn"
 )
 
-func (hod *HodDB) ServeGRPC() error {
-	port := 47808
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
-	if err != nil {
-		logrus.Error(err)
-		return err
+// the largest request the servers accept, which bounds the size of the documents
+// uploaded by LoadGraph
+const maxRequestSize = 64 * 1024 * 1024
+
+// the largest response a request over HTTP can return, unless the config sets it
+const defaultMaxResponseSize = 20 * 1024 * 1024
+
+// returns the largest response a request over HTTP can return
+func (cfg *Config) maxResponseSize() int {
+	if cfg.Http.MaxResponseSize > 0 {
+		return cfg.Http.MaxResponseSize
+	}
+	return defaultMaxResponseSize
+}
+
+// ServeGRPC serves the API over gRPC and over HTTP (through the grpc-gateway) on
+// the listeners enabled in the config. It returns when either server stops, or
+// if one can not be started, and stops the servers it started before returning
+func (hod *HodDB) ServeGRPC() (err error) {
+	cfg := hod.cfg
+	if !cfg.Grpc.Enable && !cfg.Http.Enable {
+		return errors.New("Neither the gRPC nor the HTTP server is enabled")
 	}
-	grpcServer := grpc.NewServer()
-	logpb.RegisterHodDBServer(grpcServer, hod)
+	done := make(chan error, 2)
+	var stops []func()
+	defer func() {
+		for _, stop := range stops {
+			stop()
+		}
+	}()
 
+	// both servers check the bearer token of each request if auth is enabled
+	var auth *authenticator
+	if cfg.Auth.Enable {
+		var err error
+		if auth, err = newAuthenticator(cfg); err != nil {
+			return errors.Wrap(err, "Could not configure auth")
+		}
+	}
+	var authenticate authenticateFunc
+	if auth != nil {
+		authenticate = auth.authenticateContext
+	}
+	serverOpts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(maxRequestSize),
+		grpc.UnaryInterceptor(unaryInterceptor(authenticate)),
+		grpc.StreamInterceptor(streamInterceptor(authenticate)),
+	}
+
+	if cfg.Grpc.Enable {
+		opts := append([]grpc.ServerOption{}, serverOpts...)
+		if cfg.Grpc.TLS.enabled() {
+			tlsConfig, err := cfg.Grpc.TLS.serverConfig()
+			if err != nil {
+				return errors.Wrap(err, "Could not configure TLS for gRPC")
+			}
+			opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		}
+		address := net.JoinHostPort(cfg.Grpc.Address, cfg.Grpc.Port)
+		lis, err := net.Listen("tcp", address)
+		if err != nil {
+			return errors.Wrapf(err, "Could not listen on %s", address)
+		}
+		grpcServer := grpc.NewServer(opts...)
+		logpb.RegisterHodDBServer(grpcServer, hod)
+		// stopping the server closes the listener, even if it is not serving yet
+		stops = append(stops, func() {
+			grpcServer.Stop()
+			lis.Close()
+		})
+		go func() {
+			log.Infof("Serve gRPC on %s", address)
+			done <- grpcServer.Serve(lis)
+		}()
+	}
+
+	if cfg.Http.Enable {
+		handler, internalServer, err := hod.gatewayHandler(auth)
+		if err != nil {
+			return err
+		}
+		stops = append(stops, internalServer.Stop)
+		address := net.JoinHostPort(cfg.Http.Address, cfg.Http.Port)
+		lis, err := net.Listen("tcp", address)
+		if err != nil {
+			return errors.Wrapf(err, "Could not listen on %s", address)
+		}
+		server := &http.Server{Handler: handler}
+		stops = append(stops, func() {
+			server.Close()
+			lis.Close()
+		})
+		if cfg.Http.TLS.enabled() {
+			tlsConfig, err := cfg.Http.TLS.serverConfig()
+			if err != nil {
+				return errors.Wrap(err, "Could not configure TLS for HTTP")
+			}
+			lis = tls.NewListener(lis, tlsConfig)
+		}
+		go func(lis net.Listener) {
+			log.Infof("Serve HTTP on %s", address)
+			done <- server.Serve(lis)
+		}(lis)
+	}
+
+	return <-done
+}
+
+// returns the handler for the HTTP API. The grpc-gateway reaches the API over an
+// in-memory connection, so it does not depend on the gRPC listener being enabled
+// or on how it is secured. If auth is enabled, the handler authenticates the
+// requests and passes their principal on to the API. Responses larger than the
+// configured size fail; queries returning more rows than fit should use the
+// streaming endpoint. Stopping the returned server stops the API behind the
+// handler
+func (hod *HodDB) gatewayHandler(auth *authenticator) (http.Handler, *grpc.Server, error) {
+	var authenticate authenticateFunc
+	if auth != nil {
+		authenticate = auth.forwardedContext
+	}
+	internal := bufconn.Listen(1024 * 1024)
+	internalServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxRequestSize),
+		grpc.UnaryInterceptor(unaryInterceptor(authenticate)),
+		grpc.StreamInterceptor(streamInterceptor(authenticate)),
+	)
+	logpb.RegisterHodDBServer(internalServer, hod)
+	go func() {
+		if err := internalServer.Serve(internal); err != nil {
+			log.Error(errors.Wrap(err, "Internal gRPC server stopped"))
+		}
+	}()
+
+	mux := runtime.NewServeMux(runtime.WithMetadata(forwardPrincipal))
+	dialOpts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return internal.Dial()
+		}),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hod.cfg.maxResponseSize())),
+	}
+	if err := logpb.RegisterHodDBHandlerFromEndpoint(context.Background(), mux, "internal", dialOpts); err != nil {
+		internalServer.Stop()
+		return nil, nil, errors.Wrap(err, "Could not register HTTP gateway")
+	}
+
+	var handler http.Handler = mux
+	if auth != nil {
+		handler = auth.middleware(handler)
+	}
+
+	// cross-origin requests are only allowed from the configured origins. CORS
+	// preflight requests carry no credentials, so they are answered before auth
+	origins := hod.cfg.Http.CorsOrigins
+	if len(origins) == 0 {
+		return handler, internalServer, nil
+	}
 	corsc := cors.New(cors.Options{
-		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST"},
-		AllowCredentials: true,
+		AllowedOrigins: origins,
+		AllowedMethods: []string{"GET", "POST"},
+		// credentials are never sent to arbitrary origins
+		AllowCredentials: !hasString(origins, "*"),
 		Debug:            false,
 	})
-	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{
-		grpc.WithInsecure(),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(20 * 1024 * 1024)),
+	return corsc.Handler(handler), internalServer, nil
+}
+
+// adds the principal of a call to its context, or returns why it can not be
+// authenticated
+type authenticateFunc func(ctx context.Context) (context.Context, error)
+
+// returns the interceptor for the servers' unary calls. It authenticates the
+// request if authenticate is not nil, and turns the errors of the API into
+// status errors
+func unaryInterceptor(authenticate authenticateFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if authenticate != nil {
+			var err error
+			if ctx, err = authenticate(ctx); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := handler(ctx, req)
+		return resp, statusError(err)
 	}
-	if err := logpb.RegisterHodDBHandlerFromEndpoint(context.Background(), mux, ":47808", opts); err != nil {
-		log.Error(err)
-		panic(err)
+}
+
+// returns the interceptor for the servers' streaming calls, which does the same
+// as unaryInterceptor
+func streamInterceptor(authenticate authenticateFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if authenticate != nil {
+			ctx, err := authenticate(ss.Context())
+			if err != nil {
+				return err
+			}
+			ss = &authenticatedStream{ServerStream: ss, ctx: ctx}
+		}
+		return statusError(handler(srv, ss))
 	}
-	httpmux := http.NewServeMux()
-	httpmux.Handle("/", mux)
-	go func() {
-		log.Info("Serve on :47809")
-		log.Fatal(http.ListenAndServe(":47809", corsc.Handler(mux)))
-	}()
+}
+
+func (t TLSConfig) enabled() bool {
+	return t.CertFile != "" || t.KeyFile != "" || t.ClientCAFile != ""
+}
 
-	return grpcServer.Serve(lis)
+// loads the certificates for serving TLS, requiring client certificates if
+// there is a client CA
+func (t TLSConfig) serverConfig() (*tls.Config, error) {
+	if t.CertFile == "" || t.KeyFile == "" {
+		return nil, errors.New("TLS needs both a CertFile and a KeyFile")
+	}
+	cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not load certificate")
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if t.ClientCAFile != "" {
+		pem, err := ioutil.ReadFile(t.ClientCAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not read client CA")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("No certificates found in client CA %s", t.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
 }
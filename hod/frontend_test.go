@@ -0,0 +1,257 @@
+package hod
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	logpb "github.com/gtfierro/hoddb/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// writes a certificate and key signed by the parent (self-signed if nil) to dir
+func writeTestCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	certOut := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyOut := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".pem"), certOut, 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".key"), keyOut, 0600))
+	return cert, key
+}
+
+func freePort(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	return strconv.Itoa(lis.Addr().(*net.TCPAddr).Port)
+}
+
+func TestServeGRPC(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	ca, caKey := writeTestCert(t, dir, "ca", nil, nil)
+	writeTestCert(t, dir, "server", ca, caKey)
+	writeTestCert(t, dir, "client", ca, caKey)
+
+	grpcPort, httpPort := freePort(t), freePort(t)
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %[1]s
+grpc:
+    enable: true
+    address: 127.0.0.1
+    port: %[2]s
+    tls:
+        certFile: %[1]s/server.pem
+        keyFile: %[1]s/server.key
+http:
+    enable: true
+    address: 127.0.0.1
+    port: %[3]s
+    corsOrigins:
+        - http://allowed.example
+    tls:
+        certFile: %[1]s/server.pem
+        keyFile: %[1]s/server.key
+        clientCAFile: %[1]s/ca.pem
+    `, dir, grpcPort, httpPort)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.Equal([]string{"http://allowed.example"}, cfg.Http.CorsOrigins)
+	require.Equal(dir+"/ca.pem", cfg.Http.TLS.ClientCAFile)
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+
+	served := make(chan error, 1)
+	go func() {
+		served <- hod.ServeGRPC()
+	}()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	clientCert, err := tls.LoadX509KeyPair(filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key"))
+	require.NoError(err)
+
+	// gRPC over TLS
+	conn, err := grpc.Dial("127.0.0.1:"+grpcPort, grpc.WithBlock(), grpc.WithTimeout(5*time.Second),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: roots})))
+	require.NoError(err)
+	defer conn.Close()
+	client := logpb.NewHodDBClient(conn)
+	q, err := hod.ParseQuery("SELECT ?x FROM test WHERE { ?x rdf:type brick:Room }", 0)
+	require.NoError(err)
+	resp, err := client.Select(context.Background(), q)
+	require.NoError(err)
+	require.Equal(1, len(resp.Rows))
+
+	// HTTPS with a client certificate
+	for i := 0; i < 50; i++ {
+		if c, err := net.Dial("tcp", "127.0.0.1:"+httpPort); err == nil {
+			c.Close()
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	httpsURL := "https://127.0.0.1:" + httpPort
+	body, err := json.Marshal(q)
+	require.NoError(err)
+	mtlsClient := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: []tls.Certificate{clientCert}},
+	}}
+	req, err := http.NewRequest("POST", httpsURL+"/v1/hoddb/select", strings.NewReader(string(body)))
+	require.NoError(err)
+	req.Header.Set("Origin", "http://allowed.example")
+	httpResp, err := mtlsClient.Do(req)
+	require.NoError(err)
+	require.Equal(http.StatusOK, httpResp.StatusCode)
+	require.Equal("http://allowed.example", httpResp.Header.Get("Access-Control-Allow-Origin"))
+	httpResp.Body.Close()
+
+	// origins that are not allowed get no CORS headers
+	req, err = http.NewRequest("POST", httpsURL+"/v1/hoddb/select", strings.NewReader(string(body)))
+	require.NoError(err)
+	req.Header.Set("Origin", "http://other.example")
+	httpResp, err = mtlsClient.Do(req)
+	require.NoError(err)
+	require.Equal("", httpResp.Header.Get("Access-Control-Allow-Origin"))
+	httpResp.Body.Close()
+
+	// the streaming endpoint returns newline-delimited JSON
+	httpResp, err = mtlsClient.Post(httpsURL+"/v1/hoddb/select/stream", "application/json", strings.NewReader(string(body)))
+	require.NoError(err)
+	require.Equal(http.StatusOK, httpResp.StatusCode)
+	var lines int
+	scanner := bufio.NewScanner(httpResp.Body)
+	for scanner.Scan() {
+		var msg struct {
+			Result json.RawMessage `json:"result"`
+		}
+		require.NoError(json.Unmarshal(scanner.Bytes(), &msg))
+		var result logpb.Response
+		require.NoError(jsonpb.UnmarshalString(string(msg.Result), &result))
+		require.Equal(1, len(result.Rows))
+		lines++
+	}
+	httpResp.Body.Close()
+	require.Equal(1, lines)
+
+	// HTTPS without a client certificate is refused
+	noCertClient := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: roots},
+	}}
+	_, err = noCertClient.Post(httpsURL+"/v1/hoddb/select", "application/json", strings.NewReader(string(body)))
+	require.Error(err)
+
+	select {
+	case err := <-served:
+		require.NoError(err, "server stopped")
+	default:
+	}
+
+	// nothing to serve
+	hod.cfg.Grpc.Enable = false
+	hod.cfg.Http.Enable = false
+	require.Error(hod.ServeGRPC())
+
+	// if HTTP can not be served, the gRPC server is stopped and its port freed
+	busy, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	defer busy.Close()
+	port := freePort(t)
+	hod.cfg.Grpc.Enable = true
+	hod.cfg.Grpc.Port = port
+	hod.cfg.Grpc.TLS = TLSConfig{}
+	hod.cfg.Http.Enable = true
+	hod.cfg.Http.Port = strconv.Itoa(busy.Addr().(*net.TCPAddr).Port)
+	require.Error(hod.ServeGRPC())
+	lis, err := net.Listen("tcp", "localhost:"+port)
+	require.NoError(err)
+	lis.Close()
+}
+
+func TestGatewayResponseSize(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfg, err := ReadConfigFromString(fmt.Sprintf(`database:
+    path: %s    `, dir))
+	require.NoError(err, "read config")
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	defer hod.Close()
+	require.NoError(hod.Load(FileBundle{GraphName: "test", TTLFile: "example.ttl", OntologyFiles: []string{"BrickFrame.ttl"}}))
+
+	q, err := hod.ParseQuery("SELECT ?x ?c FROM test WHERE { ?x rdf:type ?c }", 0)
+	require.NoError(err)
+	body, err := json.Marshal(q)
+	require.NoError(err)
+	selectStatus := func() int {
+		handler, server, err := hod.gatewayHandler(nil)
+		require.NoError(err)
+		defer server.Stop()
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest("POST", "/v1/hoddb/select", strings.NewReader(string(body))))
+		return recorder.Code
+	}
+
+	// responses larger than the configured size fail
+	require.Equal(http.StatusOK, selectStatus())
+	hod.cfg.Http.MaxResponseSize = 64
+	require.NotEqual(http.StatusOK, selectStatus())
+}
@@ -0,0 +1,289 @@
+package hod
+
+import (
+	"context"
+	"strings"
+
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+)
+
+// GRAPH patterns let one query join the contents of several graphs. Entity keys
+// are only comparable within a graph, so the terms outside of the GRAPH patterns
+// and the terms of each GRAPH pattern are matched against their own graph as
+// usual, and their rows are turned into solutions holding the values of their
+// variables, which are joined on those values. The OPTIONAL groups and FILTERs
+// outside of the GRAPH patterns are applied to the joined solutions.
+
+// the values of the variables of a row; unbound variables are missing
+type solution map[string]*logpb.URI
+
+func (sol solution) value(varname string) (turtle.URI, bool) {
+	value, found := sol[varname]
+	if !found {
+		return turtle.URI{}, false
+	}
+	return turtle.URIFromProto(value), true
+}
+
+// returns a solution with the values of both, or false if they have different
+// values for a variable
+func (sol solution) merge(other solution) (solution, bool) {
+	merged := make(solution, len(sol)+len(other))
+	for varname, value := range sol {
+		merged[varname] = value
+	}
+	for varname, value := range other {
+		if bound, found := merged[varname]; found && !sameValue(bound, value) {
+			return nil, false
+		}
+		merged[varname] = value
+	}
+	return merged, true
+}
+
+func sameValue(a, b *logpb.URI) bool {
+	return a.Namespace == b.Namespace && a.Value == b.Value && a.Datatype == b.Datatype && a.Lang == b.Lang
+}
+
+// returns the solutions of the rows of the cursor's relation
+func (c *Cursor) solutions() []solution {
+	var solutions []solution
+rows:
+	for _, row := range c.rel.rows {
+		sol := make(solution)
+		for varname, pos := range c.variablePosition {
+			key := row.valueAt(pos)
+			if _, optional := c.optionalVars[varname]; optional && key.Empty() {
+				continue
+			} else if key.Empty() {
+				continue rows
+			}
+			uri, found := c.hod.getURI(key)
+			if !found {
+				continue rows
+			}
+			sol[varname] = convertURI(uri)
+		}
+		solutions = append(solutions, sol)
+	}
+	return solutions
+}
+
+// joins the solutions on the values of the variables they share. If optional is
+// true, the solutions of left that join with none of right are kept as they are
+func joinSolutions(left, right []solution, optional bool) []solution {
+	// the solutions of right are indexed on the shared variables that every
+	// solution binds; the others are compared as the solutions are merged
+	var on []string
+	if len(left) > 0 && len(right) > 0 {
+		for varname := range right[0] {
+			if boundInAll(left, varname) && boundInAll(right, varname) {
+				on = append(on, varname)
+			}
+		}
+	}
+	var values = make([]*logpb.URI, len(on))
+	key := func(sol solution) string {
+		for idx, varname := range on {
+			values[idx] = sol[varname]
+		}
+		return rowKey(values)
+	}
+	var index = make(map[string][]solution)
+	for _, sol := range right {
+		k := key(sol)
+		index[k] = append(index[k], sol)
+	}
+
+	var joined []solution
+	for _, sol := range left {
+		var matched bool
+		for _, other := range index[key(sol)] {
+			if merged, ok := sol.merge(other); ok {
+				joined = append(joined, merged)
+				matched = true
+			}
+		}
+		if optional && !matched {
+			joined = append(joined, sol)
+		}
+	}
+	return joined
+}
+
+func boundInAll(solutions []solution, varname string) bool {
+	for _, sol := range solutions {
+		if _, found := sol[varname]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// calls f on the row of values for the variables of each solution, stopping
+// early if f returns true. Unbound variables have empty values
+func iterSolutions(solutions []solution, vars []string, f func(row *logpb.Row) bool) {
+	for _, sol := range solutions {
+		row := new(logpb.Row)
+		for _, varname := range vars {
+			value := sol[varname]
+			if value == nil {
+				value = &logpb.URI{}
+			}
+			row.Values = append(row.Values, value)
+		}
+		if f(row) {
+			return
+		}
+	}
+}
+
+// matches the GRAPH patterns of a query. The solutions of a GRAPH pattern do
+// not depend on the graph the rest of the query is matched against, so each
+// pattern is only matched once
+type graphMatcher struct {
+	hod   *HodDB
+	ctx   context.Context
+	query *logpb.SelectQuery
+	resp  *logpb.Response
+	// the graphs the query is run on
+	queried []string
+	// the graphs of the query and the graphs named by its patterns
+	graphs []string
+	// the solutions of the patterns that have been matched
+	matched map[*logpb.GraphPattern][]solution
+}
+
+func (hod *HodDB) newGraphMatcher(ctx context.Context, query *logpb.SelectQuery, graphs []string, resp *logpb.Response) *graphMatcher {
+	m := &graphMatcher{
+		hod:     hod,
+		ctx:     ctx,
+		query:   query,
+		resp:    resp,
+		queried: graphs,
+		graphs:  graphs,
+		matched: make(map[*logpb.GraphPattern][]solution),
+	}
+	patterns := query.GraphPatterns
+	for _, union := range query.Unions {
+		patterns = append(patterns[:len(patterns):len(patterns)], union.GraphPatterns...)
+	}
+	for _, pattern := range patterns {
+		if !strings.HasPrefix(pattern.Graph, "?") {
+			m.graphs = append(m.graphs[:len(m.graphs):len(m.graphs)], pattern.Graph)
+		}
+	}
+	return m
+}
+
+// returns a function that expands the prefix of a constant with the namespaces
+// of the graph, or else of the first of the matcher's graphs that defines it
+func (m *graphMatcher) expandURI(graph string) func(uri *logpb.URI) *logpb.URI {
+	graphs := append([]string{graph}, m.graphs...)
+	return func(uri *logpb.URI) *logpb.URI {
+		for _, name := range graphs {
+			namespaces, found := m.hod.namespaces.Load(name)
+			if !found {
+				continue
+			}
+			expanded := &logpb.URI{Namespace: uri.Namespace, Value: uri.Value, Datatype: uri.Datatype, Lang: uri.Lang}
+			expandPrefix(expanded, namespaces.(map[string]string))
+			if expanded.Namespace != uri.Namespace || expanded.Datatype != uri.Datatype {
+				return expanded
+			}
+		}
+		return uri
+	}
+}
+
+// matches the group against the version of the graph and joins its solutions
+// with those of its GRAPH patterns. A group without terms of its own starts
+// from a single solution that binds no variables
+func (m *graphMatcher) selectGroup(graph string, version uint64, group *logpb.TripleGroup) ([]solution, error) {
+	solutions := []solution{{}}
+	if len(group.Terms) > 0 {
+		cursor, err := m.hod.selectTerms(graph, version, group.Terms, m.resp)
+		if err != nil {
+			return nil, err
+		}
+		solutions = cursor.solutions()
+	}
+	for _, pattern := range group.GraphPatterns {
+		matched, err := m.match(pattern)
+		if err != nil {
+			return nil, err
+		}
+		solutions = joinSolutions(solutions, matched, false)
+	}
+	for _, optional := range group.Optional {
+		cursor, err := m.hod.selectGroup(graph, version, optional, m.resp)
+		if err != nil {
+			return nil, err
+		}
+		solutions = joinSolutions(solutions, cursor.solutions(), true)
+	}
+	if len(group.Filters) == 0 {
+		return solutions, nil
+	}
+
+	f, err := newRowFilter(group.Filters, m.expandURI(graph))
+	if err != nil {
+		return nil, err
+	}
+	var kept = solutions[:0]
+	for _, sol := range solutions {
+		if f.keep(group.Filters, sol) {
+			kept = append(kept, sol)
+		}
+	}
+	return kept, nil
+}
+
+// returns the solutions of the pattern's terms in its graph, or in each graph of
+// the query if the pattern's graph is a variable, which is bound to the name of
+// the graph each solution is from
+func (m *graphMatcher) match(pattern *logpb.GraphPattern) ([]solution, error) {
+	if solutions, found := m.matched[pattern]; found {
+		return solutions, nil
+	}
+	graphs := m.queried
+	bind := strings.HasPrefix(pattern.Graph, "?")
+	if !bind {
+		var err error
+		if graphs, err = m.hod.resolveGraphs(m.ctx, []string{pattern.Graph}); err != nil {
+			return nil, err
+		}
+	}
+
+	var solutions []solution
+	for _, graph := range graphs {
+		version, found, err := m.hod.resolveVersion(graph, m.query.Filter, m.query.Timestamp)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not find version of graph %s", graph)
+		} else if !found {
+			// the graph has no version matching the time clause
+			continue
+		}
+		if version != latestVersion && int64(version) > m.resp.Version {
+			m.resp.Version = int64(version)
+		}
+		cursor, err := m.hod.selectGroup(graph, version, pattern.Group, m.resp)
+		if err != nil {
+			return nil, err
+		}
+		name := &logpb.URI{Value: graph}
+		for _, sol := range cursor.solutions() {
+			if bind {
+				if bound, found := sol[pattern.Graph]; found && !sameValue(bound, name) {
+					continue
+				}
+				sol[pattern.Graph] = name
+			}
+			solutions = append(solutions, sol)
+		}
+	}
+	m.matched[pattern] = solutions
+	return solutions, nil
+}
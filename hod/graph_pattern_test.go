@@ -0,0 +1,114 @@
+package hod
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// returns the rows of the query as the values of each row joined by spaces, sorted
+func queryRows(t *testing.T, hod *HodDB, qstr string) []string {
+	q, err := hod.ParseQuery(qstr, 0)
+	require.NoError(t, err, qstr)
+	resp, err := hod.Select(context.Background(), q)
+	require.NoError(t, err, qstr)
+	var rows []string
+	for _, row := range resp.Rows {
+		var values []string
+		for _, value := range row.Values {
+			values = append(values, value.Value)
+		}
+		rows = append(rows, strings.Join(values, " "))
+	}
+	sort.Strings(rows)
+	return rows
+}
+
+func TestGraphPatterns(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	defer hod.Close()
+
+	catalog := filepath.Join(dir, "catalog.ttl")
+	require.NoError(ioutil.WriteFile(catalog, []byte(`
+@prefix bldg: <http://buildsys.org/ontologies/building_example#> .
+@prefix cat: <http://example.com/catalog#> .
+bldg:ahu_1 cat:model cat:ahu_9000 .
+bldg:vav_1 cat:model cat:vav_20 .
+cat:ahu_9000 cat:maker "Acme" .
+cat:vav_20 cat:maker "Acme" .
+`), 0600))
+	require.NoError(hod.Load(FileBundle{GraphName: "catalog", TTLFile: catalog, OntologyFiles: []string{"BrickFrame.ttl"}}))
+	for _, graph := range []string{"building", "building2"} {
+		require.NoError(hod.Load(FileBundle{GraphName: graph, TTLFile: "example.ttl", OntologyFiles: []string{"BrickFrame.ttl"}}))
+	}
+
+	for _, test := range []struct {
+		query string
+		rows  []string
+	}{
+		// the equipment of the building joined with the catalog
+		{`SELECT ?x ?m FROM building WHERE { ?x rdf:type brick:AHU . GRAPH catalog { ?x cat:model ?m } }`, []string{"ahu_1 ahu_9000"}},
+		{`SELECT ?x ?mk FROM building WHERE { ?x bf:feeds ?z . GRAPH <catalog> { ?x cat:model ?m . ?m cat:maker ?mk } }`, []string{"ahu_1 Acme", "vav_1 Acme"}},
+		{`SELECT ?x ?mk FROM building WHERE { GRAPH catalog { ?m cat:maker ?mk } . GRAPH catalog { ?x cat:model ?m } . ?x rdf:type brick:VAV }`, []string{"vav_1 Acme"}},
+		{`SELECT ?x FROM building WHERE { ?x rdf:type brick:Room . GRAPH catalog { ?x cat:model ?m } }`, nil},
+		{`SELECT ?x ?mk FROM catalog WHERE { ?x cat:model ?m . ?m cat:maker ?mk }`, []string{"ahu_1 Acme", "vav_1 Acme"}},
+		{`SELECT ?x ?m FROM building WHERE { { ?x rdf:type brick:AHU } UNION { ?x rdf:type brick:VAV GRAPH catalog { ?x cat:model ?m } } }`, []string{"ahu_1 ", "vav_1 vav_20"}},
+		// the variable is bound to each graph of the query
+		{`SELECT ?g ?x WHERE { GRAPH ?g { ?x rdf:type brick:AHU } }`, []string{"building ahu_1", "building2 ahu_1"}},
+		{`SELECT ?g ?x FROM building catalog WHERE { GRAPH ?g { ?x rdf:type brick:AHU } }`, []string{"building ahu_1"}},
+		{`SELECT ?g ?m WHERE { GRAPH ?g { ?x cat:model ?m } FILTER(?g = "catalog" && ?m != cat:vav_20) }`, []string{"catalog ahu_9000"}},
+		// the rows of the building are joined with the catalog once per graph
+		{`SELECT ?x ?m FROM building building2 WHERE { ?x rdf:type brick:AHU . GRAPH catalog { ?x cat:model ?m } }`, []string{"ahu_1 ahu_9000", "ahu_1 ahu_9000"}},
+		{`SELECT ?x ?l ?m FROM building WHERE { ?x bf:feeds ?z . GRAPH catalog { ?x cat:model ?m } OPTIONAL { ?x rdfs:label ?l } }`, []string{"ahu_1  ahu_9000", "vav_1  vav_20"}},
+		{`SELECT ?x ?m FROM building WHERE { { ?x rdf:type brick:AHU } UNION { ?x rdf:type brick:Room } GRAPH catalog { ?x cat:model ?m } }`, []string{"ahu_1 ahu_9000"}},
+		{`SELECT ?mk (COUNT(?x) AS ?n) FROM building WHERE { ?x bf:feeds ?z . GRAPH catalog { ?x cat:model ?m . ?m cat:maker ?mk } } GROUP BY ?mk`, []string{"Acme 2"}},
+		{`SELECT DISTINCT ?mk FROM building building2 WHERE { ?x bf:feeds ?z . GRAPH catalog { ?x cat:model ?m . ?m cat:maker ?mk } }`, []string{"Acme"}},
+	} {
+		require.Equal(test.rows, queryRows(t, hod, test.query), test.query)
+
+		q, err := hod.ParseQuery(test.query, 0)
+		require.NoError(err)
+		parsed := proto.Clone(q)
+		resp, err := hod.Count(context.Background(), q)
+		require.NoError(err, test.query)
+		require.Equal(int64(len(test.rows)), resp.Count, test.query)
+		// the query is left unchanged, so it can be run again
+		require.True(proto.Equal(parsed, q), test.query)
+		resp, err = hod.Select(context.Background(), q)
+		require.NoError(err, test.query)
+		require.Len(resp.Rows, len(test.rows), test.query)
+	}
+
+	// all variables, including the graph's, are selected by *
+	q, err := hod.ParseQuery(`SELECT * FROM building WHERE { GRAPH ?g { ?x cat:maker ?mk } }`, 0)
+	require.NoError(err)
+	require.ElementsMatch([]string{"?g", "?x", "?mk"}, q.Vars)
+
+	// the graph must exist, and GRAPH patterns can not be nested in OPTIONAL
+	q, err = hod.ParseQuery(`SELECT ?x FROM building WHERE { ?x rdf:type brick:AHU . GRAPH missing { ?x cat:model ?m } }`, 0)
+	require.NoError(err)
+	_, err = hod.Select(context.Background(), q)
+	require.Equal(ErrGraphNotFound, errors.Cause(err))
+	_, err = hod.ParseQuery(`SELECT ?x FROM building WHERE { ?x rdf:type brick:AHU . OPTIONAL { ?x rdfs:label ?l GRAPH catalog { ?x cat:model ?m } } }`, 0)
+	require.EqualError(err, "GRAPH inside OPTIONAL is not supported")
+}
@@ -0,0 +1,393 @@
+package hod
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/proto"
+	logpb "github.com/gtfierro/hoddb/proto"
+	"github.com/pkg/errors"
+)
+
+// A graph's data is spread over many keys: its entities (which start with the
+// hash of the graph's name), its versions and asserted triples, its URI
+// dictionary, its namespace row, and the markers of the file bundles loaded
+// into it. Dropping or renaming a graph can touch more keys
+// than fit in one transaction, so the change is made visible by a single small
+// transaction that writes the graph's namespace row (which is what makes a graph
+// exist when the database is opened) and a tombstone for the keys that are left
+// to remove. If removing those keys is interrupted, it is finished the next time
+// the database is opened.
+
+// prefix for the tombstones of graphs whose keys are being removed
+var droppedpfx = []byte("droppedpfx")
+
+var namespacepfx = []byte("namespacepfx")
+
+func namespaceKey(name string) []byte {
+	return append(append([]byte{}, namespacepfx...), name...)
+}
+
+func droppedKey(name string) []byte {
+	return append(append([]byte{}, droppedpfx...), name...)
+}
+
+// returns the prefixes of the keys holding the graph's data, other than its
+// namespace row and file bundle markers
+func graphKeyPrefixes(name string) [][]byte {
+	graphhash := hashString(name)
+	return [][]byte{
+		graphhash,
+		append(append([]byte{}, versionpfx...), graphhash...),
+		append(append([]byte{}, assertedpfx...), graphhash...),
+		append(append([]byte{}, uriIDpfx...), graphhash...),
+		append(append([]byte{}, idURIpfx...), graphhash...),
+		append(append([]byte{}, nextIDpfx...), graphhash...),
+	}
+}
+
+// calls f with each key (and its value) holding the graph's data, other than
+// its namespace row and file bundle markers
+func iterGraphKeys(txn *badger.Txn, name string, values bool, f func(key, value []byte) error) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = values
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	for idx, prefix := range graphKeyPrefixes(name) {
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			// entity keys are the only keys that start with the graph's hash
+			if idx == 0 && len(item.Key()) != 16 {
+				continue
+			}
+			var value []byte
+			if values {
+				var err error
+				if value, err = item.ValueCopy(nil); err != nil {
+					return err
+				}
+			}
+			if err := f(item.KeyCopy(nil), value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// returns the keys marking the file bundles loaded into the graph. Marker keys
+// start with the graph's name, so markers that also start with the name of a
+// longer graph are taken to be that graph's
+func (hod *HodDB) bundleMarkers(txn *badger.Txn, name string) [][]byte {
+	var longer []string
+	hod.RLock()
+	for graph := range hod.graphs {
+		if len(graph) > len(name) && strings.HasPrefix(graph, name) {
+			longer = append(longer, "filebundle"+graph)
+		}
+	}
+	hod.RUnlock()
+
+	var markers [][]byte
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	prefix := []byte("filebundle" + name)
+markers:
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		key := it.Item().KeyCopy(nil)
+		for _, other := range longer {
+			if bytes.HasPrefix(key, []byte(other)) {
+				continue markers
+			}
+		}
+		markers = append(markers, key)
+	}
+	return markers
+}
+
+// checks that no other graph shares the hash of the name, since graphs with the
+// same hash would share their keys
+func (hod *HodDB) checkGraphHash(name string) error {
+	hod.RLock()
+	defer hod.RUnlock()
+	graphhash := hashString(name)
+	for graph := range hod.graphs {
+		if graph != name && bytes.Equal(hashString(graph), graphhash) {
+			return errors.Wrapf(ErrHashCollision, "Graph %s has the same hash as graph %s", name, graph)
+		}
+	}
+	return nil
+}
+
+// removes every key of the graph, and the in-memory state for it
+func (hod *HodDB) dropGraph(name string) error {
+	hod.writeLock.Lock()
+	defer hod.writeLock.Unlock()
+	if err := hod.checkGraphHash(name); err != nil {
+		return err
+	}
+
+	// the graph no longer exists once its namespace row is gone
+	err := hod.db.Update(func(txn *badger.Txn) error {
+		for _, marker := range hod.bundleMarkers(txn, name) {
+			if err := txn.Delete(marker); err != nil {
+				return err
+			}
+		}
+		if err := txn.Delete(namespaceKey(name)); err != nil {
+			return err
+		}
+		return txn.Set(droppedKey(name), []byte{})
+	})
+	if err != nil {
+		return errors.Wrap(err, "Could not remove graph")
+	}
+	hod.forgetGraph(name)
+	return hod.removeGraphKeys(name)
+}
+
+// removes the in-memory state for the graph
+func (hod *HodDB) forgetGraph(name string) {
+	hod.Lock()
+	defer hod.Unlock()
+	delete(hod.graphs, name)
+	hod.namespaces.Delete(name)
+	hod.forgetDictionary(name)
+}
+
+// deletes the keys holding the data of a dropped graph, and then its tombstone
+func (hod *HodDB) removeGraphKeys(name string) error {
+	var keys [][]byte
+	err := hod.db.View(func(txn *badger.Txn) error {
+		return iterGraphKeys(txn, name, false, func(key, _ []byte) error {
+			keys = append(keys, key)
+			return nil
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "Could not list keys of graph")
+	}
+	wb := hod.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range keys {
+		if err := wb.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := wb.Delete(droppedKey(name)); err != nil {
+		return err
+	}
+	return errors.Wrap(wb.Flush(), "Could not delete keys of graph")
+}
+
+// finishes removing the keys of graphs that were being dropped or renamed when
+// the database was last closed
+func (hod *HodDB) resumeDrops() error {
+	var names []string
+	err := hod.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(droppedpfx); it.ValidForPrefix(droppedpfx); it.Next() {
+			names = append(names, string(it.Item().Key()[len(droppedpfx):]))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		log.Infof("Removing the rest of dropped graph %s", name)
+		hod.forgetGraph(name)
+		if err := hod.removeGraphKeys(name); err != nil {
+			return errors.Wrapf(err, "Could not remove dropped graph %s", name)
+		}
+	}
+	return nil
+}
+
+// moves every key of the graph to the new name, and the in-memory state for it.
+// Rules naming the graph are changed to name it by the new name
+func (hod *HodDB) renameGraph(from, to string) error {
+	hod.writeLock.Lock()
+	defer hod.writeLock.Unlock()
+	if err := hod.checkGraphHash(from); err != nil {
+		return err
+	}
+	if err := hod.checkGraphHash(to); err != nil {
+		return err
+	}
+	r := graphRenamer{from: from, to: to, fromHash: hashString(from), toHash: hashString(to)}
+
+	// if the copy is interrupted, the partial copy is removed
+	if err := hod.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(droppedKey(to), []byte{})
+	}); err != nil {
+		return err
+	}
+
+	// copy the graph's data to keys for the new name
+	wb := hod.db.NewWriteBatch()
+	defer wb.Cancel()
+	err := hod.db.View(func(txn *badger.Txn) error {
+		return iterGraphKeys(txn, from, true, func(key, value []byte) error {
+			key, value, err := r.rewrite(key, value)
+			if err != nil {
+				return err
+			}
+			return wb.Set(key, value)
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "Could not copy graph")
+	}
+	if err := wb.Flush(); err != nil {
+		return errors.Wrap(err, "Could not copy graph")
+	}
+
+	// the graph has the new name once the namespace row moves
+	err = hod.db.Update(func(txn *badger.Txn) error {
+		item, err := txn.Get(namespaceKey(from))
+		if err != nil {
+			return err
+		}
+		namespaces, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		for _, marker := range hod.bundleMarkers(txn, from) {
+			item, err := txn.Get(marker)
+			if err != nil {
+				return err
+			}
+			hash, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if err := txn.Delete(marker); err != nil {
+				return err
+			}
+			renamed := append([]byte("filebundle"+to), marker[len("filebundle"+from):]...)
+			if err := txn.Set(renamed, hash); err != nil {
+				return err
+			}
+		}
+		if err := renameSavedRules(txn, from, to); err != nil {
+			return err
+		}
+		if err := txn.Delete(namespaceKey(from)); err != nil {
+			return err
+		}
+		if err := txn.Set(namespaceKey(to), namespaces); err != nil {
+			return err
+		}
+		if err := txn.Delete(droppedKey(to)); err != nil {
+			return err
+		}
+		return txn.Set(droppedKey(from), []byte{})
+	})
+	if err != nil {
+		return errors.Wrap(err, "Could not rename graph")
+	}
+
+	r.renameInMemory(hod)
+	return hod.removeGraphKeys(from)
+}
+
+type graphRenamer struct {
+	from, to         string
+	fromHash, toHash []byte
+}
+
+// returns the entity key bytes with the graph's hash replaced
+func (r graphRenamer) entityKey(b []byte) []byte {
+	if len(b) != 16 || !bytes.Equal(b[:4], r.fromHash) {
+		return b
+	}
+	return append(append([]byte{}, r.toHash...), b[4:]...)
+}
+
+// returns the key and value of one of the graph's keys for the new name
+func (r graphRenamer) rewrite(key, value []byte) ([]byte, []byte, error) {
+	hasPrefix := func(prefix []byte) bool {
+		return bytes.HasPrefix(key, prefix)
+	}
+	switch {
+	case hasPrefix(uriIDpfx), hasPrefix(idURIpfx), hasPrefix(nextIDpfx):
+		// the dictionary's keys have the graph's hash after their prefix
+		var prefix []byte
+		for _, prefix = range [][]byte{uriIDpfx, idURIpfx, nextIDpfx} {
+			if hasPrefix(prefix) {
+				break
+			}
+		}
+		renamed := append(append(append([]byte{}, prefix...), r.toHash...), key[len(prefix)+4:]...)
+		return renamed, value, nil
+	case len(key) == 16:
+		if len(value) == 0 {
+			// marks the entity as removed
+			return r.entityKey(key), value, nil
+		}
+		var entity logpb.Entity
+		if err := proto.Unmarshal(value, &entity); err != nil {
+			return nil, nil, err
+		}
+		entity.EntityKey = r.entityKey(entity.EntityKey)
+		for _, edges := range [][]*logpb.Entity_Edge{entity.In, entity.Out} {
+			for _, edge := range edges {
+				edge.Predicate = r.entityKey(edge.Predicate)
+				edge.Value = r.entityKey(edge.Value)
+			}
+		}
+		for _, endpoints := range entity.Endpoints {
+			endpoints.Src = r.entityKey(endpoints.Src)
+			endpoints.Dst = r.entityKey(endpoints.Dst)
+		}
+		value, err := proto.Marshal(&entity)
+		return r.entityKey(key), value, err
+	case hasPrefix(versionpfx):
+		var entry versionEntry
+		if err := json.Unmarshal(value, &entry); err != nil {
+			return nil, nil, err
+		}
+		entry.Graph = r.to
+		value, err := json.Marshal(entry)
+		renamed := append(append(append([]byte{}, versionpfx...), r.toHash...), key[len(versionpfx)+4:]...)
+		return renamed, value, err
+	case hasPrefix(assertedpfx):
+		triple := tripleKeyFromBytes(key)
+		for idx := range triple {
+			copy(triple[idx].Graph[:], r.toHash)
+		}
+		return triple.atVersion(assertedVersion(key)), value, nil
+	}
+	return nil, nil, errors.Errorf("Unknown key %q", key)
+}
+
+func (r graphRenamer) renameInMemory(hod *HodDB) {
+	hod.Lock()
+	defer hod.Unlock()
+	delete(hod.graphs, r.from)
+	hod.graphs[r.to] = struct{}{}
+	if namespaces, found := hod.namespaces.Load(r.from); found {
+		hod.namespaces.Store(r.to, namespaces)
+		hod.namespaces.Delete(r.from)
+	}
+	// the dictionary is read again under the new name
+	hod.forgetDictionary(r.from)
+	hod.forgetDictionary(r.to)
+	// the rules that named the graph apply to it under the new name
+	rules := make([]*logpb.ConstructQuery, 0, len(hod.rules))
+	for _, rule := range hod.rules {
+		if renamed := renameRuleGraph(rule, r.from, r.to); renamed != nil {
+			rule = renamed
+		}
+		rules = append(rules, rule)
+	}
+	hod.rules = rules
+}
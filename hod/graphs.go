@@ -0,0 +1,234 @@
+package hod
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	rdf "github.com/gtfierro/hoddb/turtle/rdfparser"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Graphs can be created, loaded, renamed and dropped while the database is
+// running. Each of these RPCs streams its progress, ending with a message that
+// has the counts of triples and entities in the graph.
+
+// NewGraph creates an empty graph, layered on the requested ontologies
+func (hod *HodDB) NewGraph(req *logpb.NewGraphRequest, srv logpb.HodDB_NewGraphServer) error {
+	if err := hod.checkGraphName(srv, req.Graph); err != nil {
+		return err
+	}
+	ontologies, err := hod.chooseOntologies(req.OntologyFiles)
+	if err != nil {
+		return err
+	}
+
+	hod.graphLock.Lock()
+	defer hod.graphLock.Unlock()
+	if hod.graphExists(req.Graph) {
+		return status.Errorf(codes.AlreadyExists, "Graph %s already exists", req.Graph)
+	}
+	return hod.createGraph(srv, req.Graph, *turtle.NewDataSet(), ontologies, nil)
+}
+
+// LoadGraph adds the triples in an RDF document to a graph, creating the graph
+// (layered on the requested ontologies) if it does not exist
+func (hod *HodDB) LoadGraph(req *logpb.LoadGraphRequest, srv logpb.HodDB_LoadGraphServer) error {
+	if err := hod.checkGraphName(srv, req.Graph); err != nil {
+		return err
+	}
+	ontologies, err := hod.chooseOntologies(req.OntologyFiles)
+	if err != nil {
+		return err
+	}
+	var format rdf.Format
+	switch req.Format {
+	case logpb.GraphFormat_Turtle:
+		format = rdf.Turtle
+	case logpb.GraphFormat_NTriples:
+		format = rdf.NTriples
+	case logpb.GraphFormat_RDFXML:
+		format = rdf.RDFXML
+	default:
+		return status.Errorf(codes.InvalidArgument, "Unknown format %s", req.Format)
+	}
+
+	dataset, err := turtle.ParseReader(bytes.NewReader(req.Document), format)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Could not parse document: %s", err)
+	}
+	dataset = skolemize(dataset, documentLoadID(req.Document))
+	if err := srv.Send(&logpb.GraphProgress{Graph: req.Graph, Stage: "parsed", Triples: int64(len(dataset.Triples))}); err != nil {
+		return err
+	}
+
+	hod.graphLock.Lock()
+	defer hod.graphLock.Unlock()
+	if !hod.graphExists(req.Graph) {
+		source := sha256.Sum256(req.Document)
+		return hod.createGraph(srv, req.Graph, dataset, ontologies, source[:])
+	}
+
+	if err := hod.AddTriples(req.Graph, dataset); err != nil {
+		return errors.Wrapf(err, "Could not load document into graph %s", req.Graph)
+	}
+	if err := hod.addNamespaces(req.Graph, dataset.Namespaces); err != nil {
+		return err
+	}
+	return hod.sendGraphDone(srv, req.Graph, "loaded")
+}
+
+// DropGraph removes a graph so it can no longer be queried or written to
+func (hod *HodDB) DropGraph(req *logpb.DropGraphRequest, srv logpb.HodDB_DropGraphServer) error {
+	if err := hod.checkGraphName(srv, req.Graph); err != nil {
+		return err
+	}
+	hod.graphLock.Lock()
+	defer hod.graphLock.Unlock()
+	if !hod.graphExists(req.Graph) {
+		return status.Errorf(codes.NotFound, "Graph %s not found", req.Graph)
+	}
+	triples, entities, err := hod.graphCounts(req.Graph)
+	if err != nil {
+		return err
+	}
+	if err := hod.dropGraph(req.Graph); err != nil {
+		return errors.Wrapf(err, "Could not drop graph %s", req.Graph)
+	}
+	// the counts are of what was removed
+	return srv.Send(&logpb.GraphProgress{Graph: req.Graph, Stage: "dropped", Triples: triples, Entities: entities, Done: true})
+}
+
+// RenameGraph gives a graph a new name, keeping its data and versions
+func (hod *HodDB) RenameGraph(req *logpb.RenameGraphRequest, srv logpb.HodDB_RenameGraphServer) error {
+	if err := hod.checkGraphName(srv, req.Graph); err != nil {
+		return err
+	}
+	if err := hod.checkGraphName(srv, req.NewName); err != nil {
+		return err
+	}
+	hod.graphLock.Lock()
+	defer hod.graphLock.Unlock()
+	if !hod.graphExists(req.Graph) {
+		return status.Errorf(codes.NotFound, "Graph %s not found", req.Graph)
+	}
+	if hod.graphExists(req.NewName) {
+		return status.Errorf(codes.AlreadyExists, "Graph %s already exists", req.NewName)
+	}
+	if err := hod.checkGraphHash(req.NewName); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := hod.renameGraph(req.Graph, req.NewName); err != nil {
+		return errors.Wrapf(err, "Could not rename graph %s", req.Graph)
+	}
+	return hod.sendGraphDone(srv, req.NewName, "renamed")
+}
+
+type graphServer interface {
+	Send(*logpb.GraphProgress) error
+	Context() context.Context
+}
+
+// checks that the graph name is valid and that the request may access the graph
+func (hod *HodDB) checkGraphName(srv graphServer, name string) error {
+	if name == "" || name == "*" {
+		return status.Errorf(codes.InvalidArgument, "Invalid graph name '%s'", name)
+	}
+	if err := checkWritable(name); err != nil {
+		return err
+	}
+	_, err := hod.resolveGraphs(srv.Context(), []string{name})
+	return err
+}
+
+// returns the ontology files to load for a new graph: the requested ones, which
+// must be in the config, or else all of the configured ontologies
+func (hod *HodDB) chooseOntologies(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return hod.cfg.Database.Ontologies, nil
+	}
+	for _, file := range requested {
+		if !hasString(hod.cfg.Database.Ontologies, file) {
+			return nil, status.Errorf(codes.InvalidArgument, "Ontology %s is not in the config", file)
+		}
+	}
+	return requested, nil
+}
+
+func (hod *HodDB) graphExists(name string) bool {
+	hod.RLock()
+	defer hod.RUnlock()
+	_, found := hod.graphs[name]
+	return found
+}
+
+// loads the ontologies into the ontology graph and the dataset as a new graph
+func (hod *HodDB) createGraph(srv graphServer, name string, dataset turtle.DataSet, ontologies []string, source []byte) error {
+	if err := hod.checkGraphHash(name); err != nil {
+		return err
+	}
+	if err := hod.loadOntologies(ontologies); err != nil {
+		return err
+	}
+	graph := Graph{
+		Name:   name,
+		Data:   dataset,
+		hod:    hod,
+		source: source,
+	}
+	graph.getInferenceRules()
+	if err := srv.Send(&logpb.GraphProgress{Graph: name, Stage: "ontologies", Triples: int64(len(graph.Data.Triples))}); err != nil {
+		return err
+	}
+	if err := hod.addGraph(graph); err != nil {
+		return errors.Wrapf(err, "Could not create graph %s", name)
+	}
+	return hod.sendGraphDone(srv, name, "created")
+}
+
+func (hod *HodDB) sendGraphDone(srv graphServer, name, stage string) error {
+	triples, entities, err := hod.graphCounts(name)
+	if err != nil {
+		return err
+	}
+	return srv.Send(&logpb.GraphProgress{Graph: name, Stage: stage, Triples: triples, Entities: entities, Done: true})
+}
+
+// adds the prefixes that the graph does not define yet
+func (hod *HodDB) addNamespaces(name string, namespaces map[string]string) error {
+	merged := make(map[string]string)
+	if existing, found := hod.namespaces.Load(name); found {
+		for prefix, full := range existing.(map[string]string) {
+			merged[prefix] = full
+		}
+	}
+	for prefix, full := range namespaces {
+		if _, found := merged[prefix]; !found {
+			merged[prefix] = full
+		}
+	}
+	hod.namespaces.Store(name, merged)
+	return errors.Wrapf(hod.saveNamespaces(name), "Could not save namespaces of graph %s", name)
+}
+
+// returns the number of triples added to the graph (not counting inferred
+// triples) and the number of entities in it
+func (hod *HodDB) graphCounts(name string) (triples, entities int64, err error) {
+	asserted, err := hod.getAsserted(name, latestVersion)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "Could not count triples")
+	}
+	cursor, err := hod.Cursor(name)
+	if err != nil {
+		return 0, 0, err
+	}
+	err = cursor.Iterate(func(EntityKey, *Entity) bool {
+		entities++
+		return false
+	})
+	return int64(len(asserted)), entities, errors.Wrap(err, "Could not count entities")
+}
@@ -0,0 +1,293 @@
+package hod
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// collects the progress messages sent by the graph RPCs
+type progressRecorder struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []*logpb.GraphProgress
+}
+
+func (r *progressRecorder) Send(msg *logpb.GraphProgress) error {
+	r.messages = append(r.messages, msg)
+	return nil
+}
+
+func (r *progressRecorder) Context() context.Context {
+	return r.ctx
+}
+
+func (r *progressRecorder) last() *logpb.GraphProgress {
+	return r.messages[len(r.messages)-1]
+}
+
+func TestGraphRPCs(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    ontologies:
+        - BrickFrame.ttl
+    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	countRooms := func(graph string) int {
+		q, err := hod.ParseQuery(fmt.Sprintf("SELECT ?x FROM %s WHERE { ?x rdf:type brick:Room }", graph), 0)
+		require.NoError(err)
+		resp, err := hod.Select(context.Background(), q)
+		require.NoError(err)
+		return len(resp.Rows)
+	}
+
+	// a new graph is empty, but its queries see the ontology
+	rec := &progressRecorder{ctx: context.Background()}
+	require.NoError(hod.NewGraph(&logpb.NewGraphRequest{Graph: "empty"}, rec))
+	require.True(rec.last().Done)
+	require.Equal("empty", rec.last().Graph)
+	require.Equal(int64(0), rec.last().Triples)
+	require.Equal(int64(0), rec.last().Entities)
+	require.Equal([]string{"hasPart"}, queryValues(t, hod, "SELECT ?x FROM empty WHERE { ?x owl:inverseOf bf:isPartOf }"))
+
+	err = hod.NewGraph(&logpb.NewGraphRequest{Graph: "empty"}, &progressRecorder{ctx: context.Background()})
+	require.Equal(codes.AlreadyExists, status.Code(err))
+	err = hod.NewGraph(&logpb.NewGraphRequest{Graph: "other", OntologyFiles: []string{"/etc/passwd"}}, &progressRecorder{ctx: context.Background()})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	err = hod.NewGraph(&logpb.NewGraphRequest{Graph: "*"}, &progressRecorder{ctx: context.Background()})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// graphs whose names have the same hash are not created, as they would
+	// share their keys
+	require.Equal(hashString("graph_9634"), hashString("graph_11083"))
+	require.NoError(hod.NewGraph(&logpb.NewGraphRequest{Graph: "graph_9634"}, &progressRecorder{ctx: context.Background()}))
+	err = hod.NewGraph(&logpb.NewGraphRequest{Graph: "graph_11083"}, &progressRecorder{ctx: context.Background()})
+	require.Equal(ErrHashCollision, errors.Cause(err), fmt.Sprint(err))
+	err = hod.LoadGraph(&logpb.LoadGraphRequest{Graph: "graph_11083", Document: []byte("<a> <b> <c> .")}, &progressRecorder{ctx: context.Background()})
+	require.Equal(ErrHashCollision, errors.Cause(err), fmt.Sprint(err))
+	err = hod.Load(FileBundle{GraphName: "graph_11083", TTLFile: "example.ttl", OntologyFiles: []string{"BrickFrame.ttl"}})
+	require.Equal(ErrHashCollision, errors.Cause(err), fmt.Sprint(err))
+	require.False(hod.graphExists("graph_11083"))
+	loaded, err := hod.isFileBundleLoaded(FileBundle{GraphName: "graph_11083", TTLFile: "example.ttl", OntologyFiles: []string{"BrickFrame.ttl"}})
+	require.NoError(err)
+	require.False(loaded)
+	require.NoError(hod.DropGraph(&logpb.DropGraphRequest{Graph: "graph_9634"}, &progressRecorder{ctx: context.Background()}))
+
+	// loading a document creates the graph
+	document, err := ioutil.ReadFile("example.ttl")
+	require.NoError(err)
+	rec = &progressRecorder{ctx: context.Background()}
+	require.NoError(hod.LoadGraph(&logpb.LoadGraphRequest{Graph: "bldg", Document: document}, rec))
+	require.Equal("parsed", rec.messages[0].Stage)
+	require.True(rec.messages[0].Triples > 0)
+	require.Equal("created", rec.last().Stage)
+	require.True(rec.last().Done)
+	require.True(rec.last().Entities > 0)
+	require.Equal(1, countRooms("bldg"))
+
+	// and adds to it once it exists
+	ntriples := []byte(`<http://buildsys.org/ontologies/building_example#room_5> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <https://brickschema.org/schema/1.1/Brick#Room> .
+<http://buildsys.org/ontologies/building_example#room_5> <https://brickschema.org/schema/1.1/BrickFrame#isPartOf> <http://buildsys.org/ontologies/building_example#floor_1> .
+`)
+	before := rec.last()
+	rec = &progressRecorder{ctx: context.Background()}
+	require.NoError(hod.LoadGraph(&logpb.LoadGraphRequest{Graph: "bldg", Document: ntriples, Format: logpb.GraphFormat_NTriples}, rec))
+	require.Equal(int64(2), rec.messages[0].Triples)
+	require.Equal("loaded", rec.last().Stage)
+	require.Equal(before.Triples+2, rec.last().Triples)
+	require.Equal(before.Entities+1, rec.last().Entities)
+	require.Equal(2, countRooms("bldg"))
+
+	err = hod.LoadGraph(&logpb.LoadGraphRequest{Graph: "bldg", Document: []byte("this is not turtle")}, &progressRecorder{ctx: context.Background()})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// dropping the graph removes it
+	rec = &progressRecorder{ctx: context.Background()}
+	require.NoError(hod.DropGraph(&logpb.DropGraphRequest{Graph: "bldg"}, rec))
+	require.True(rec.last().Done)
+	require.Equal(before.Entities+1, rec.last().Entities)
+	q, err := hod.ParseQuery("SELECT ?x FROM * WHERE { ?x rdf:type brick:Room }", 0)
+	require.NoError(err)
+	resp, err := hod.Select(context.Background(), q)
+	require.NoError(err)
+	require.Equal(0, len(resp.Rows))
+	err = hod.DropGraph(&logpb.DropGraphRequest{Graph: "bldg"}, &progressRecorder{ctx: context.Background()})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// and it starts from scratch when loaded again
+	rec = &progressRecorder{ctx: context.Background()}
+	require.NoError(hod.LoadGraph(&logpb.LoadGraphRequest{Graph: "bldg", Document: document}, rec))
+	require.Equal("created", rec.last().Stage)
+	require.Equal(before.Entities, rec.last().Entities)
+	require.Equal(1, countRooms("bldg"))
+
+	// requests may only change the graphs they are allowed to access
+	restricted := context.WithValue(context.Background(), principalKey{}, newPrincipal([]string{"mine"}))
+	err = hod.DropGraph(&logpb.DropGraphRequest{Graph: "bldg"}, &progressRecorder{ctx: restricted})
+	require.Equal(codes.PermissionDenied, status.Code(err))
+	require.NoError(hod.NewGraph(&logpb.NewGraphRequest{Graph: "mine"}, &progressRecorder{ctx: restricted}))
+}
+
+func TestDropRenameGraph(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    ontologies:
+        - BrickFrame.ttl
+    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	// graph "test" has the name of graph "test2" as a prefix, so their bundle
+	// markers share a prefix
+	bundle := func(graph string) FileBundle {
+		return FileBundle{
+			GraphName:     graph,
+			TTLFile:       "example.ttl",
+			OntologyFiles: []string{"BrickFrame.ttl"},
+		}
+	}
+	for _, graph := range []string{"test", "test2"} {
+		require.NoError(hod.Load(bundle(graph)), "load files")
+	}
+
+	// counts the keys holding the graph's data, including its namespace row
+	// and bundle markers
+	countKeys := func(graph string) int {
+		var count int
+		require.NoError(hod.db.View(func(txn *badger.Txn) error {
+			count += len(hod.bundleMarkers(txn, graph))
+			if _, err := txn.Get(namespaceKey(graph)); err == nil {
+				count++
+			}
+			return iterGraphKeys(txn, graph, false, func(_, _ []byte) error {
+				count++
+				return nil
+			})
+		}))
+		return count
+	}
+	rooms := func(graph string) []string {
+		q, err := hod.ParseQuery(fmt.Sprintf("SELECT ?x FROM %s WHERE { ?x rdf:type brick:Room }", graph), 0)
+		require.NoError(err)
+		resp, err := hod.Select(context.Background(), q)
+		require.NoError(err)
+		var rooms []string
+		for _, row := range resp.Rows {
+			rooms = append(rooms, row.Values[0].Value)
+		}
+		return rooms
+	}
+	require.True(countKeys("test") > 0)
+	test2Keys := countKeys("test2")
+	expected := rooms("test")
+	require.Equal(1, len(expected))
+
+	// dropping a graph removes all of its keys, but not those of other graphs
+	require.NoError(hod.dropGraph("test"))
+	require.Equal(0, countKeys("test"))
+	require.Equal(test2Keys, countKeys("test2"))
+	require.Equal(expected, rooms("test2"))
+	room := turtle.URI{Namespace: "http://buildsys.org/ontologies/building_example", Value: "room_1"}
+	_, found := hod.lookupURI("test", room)
+	require.False(found)
+	_, found = hod.lookupURI("test2", room)
+	require.True(found)
+	loaded, err := hod.isFileBundleLoaded(bundle("test"))
+	require.NoError(err)
+	require.False(loaded)
+	loaded, err = hod.isFileBundleLoaded(bundle("test2"))
+	require.NoError(err)
+	require.True(loaded)
+
+	// so the bundle is loaded again
+	require.NoError(hod.Load(bundle("test")))
+	require.Equal(expected, rooms("test"))
+
+	// renaming a graph moves all of its keys
+	testKeys := countKeys("test")
+	rec := &progressRecorder{ctx: context.Background()}
+	require.NoError(hod.RenameGraph(&logpb.RenameGraphRequest{Graph: "test", NewName: "renamed"}, rec))
+	require.True(rec.last().Done)
+	require.Equal("renamed", rec.last().Graph)
+	require.Equal(0, countKeys("test"))
+	require.Equal(testKeys, countKeys("renamed"))
+	require.False(hod.graphExists("test"))
+	require.Equal(expected, rooms("renamed"))
+	loaded, err = hod.isFileBundleLoaded(bundle("renamed"))
+	require.NoError(err)
+	require.True(loaded)
+
+	err = hod.RenameGraph(&logpb.RenameGraphRequest{Graph: "test", NewName: "other"}, &progressRecorder{ctx: context.Background()})
+	require.Equal(codes.NotFound, status.Code(err))
+	err = hod.RenameGraph(&logpb.RenameGraphRequest{Graph: "renamed", NewName: "test2"}, &progressRecorder{ctx: context.Background()})
+	require.Equal(codes.AlreadyExists, status.Code(err))
+
+	// the renamed graph can be written to, and survives reopening the database
+	iq, err := hod.ParseInsertQuery(`INSERT { bldg:room_9 rdf:type brick:Room } TO renamed`)
+	require.NoError(err)
+	_, err = hod.Insert(context.Background(), iq)
+	require.NoError(err)
+	require.Equal(2, len(rooms("renamed")))
+	require.NoError(hod.Close())
+
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "reopen log")
+	require.Equal(2, len(rooms("renamed")))
+	require.Equal(expected, rooms("test2"))
+	require.False(hod.graphExists("test"))
+
+	// a drop that was interrupted after the graph was removed is finished when
+	// the database is opened
+	require.NoError(hod.db.Update(func(txn *badger.Txn) error {
+		for _, marker := range hod.bundleMarkers(txn, "test2") {
+			if err := txn.Delete(marker); err != nil {
+				return err
+			}
+		}
+		if err := txn.Delete(namespaceKey("test2")); err != nil {
+			return err
+		}
+		return txn.Set(droppedKey("test2"), []byte{})
+	}))
+	require.NoError(hod.Close())
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "reopen log")
+	defer hod.Close()
+	require.False(hod.graphExists("test2"))
+	require.Equal(0, countKeys("test2"))
+	require.Equal(2, len(rooms("renamed")))
+}
@@ -3,7 +3,6 @@ package hod
 import (
 	"bytes"
 	"context"
-	"fmt"
 
 	"github.com/golang/protobuf/proto"
 	pb "github.com/gtfierro/hoddb/proto"
@@ -11,14 +10,17 @@ import (
 	"github.com/pkg/errors"
 )
 
-type inferenceRule2 func() []rdf.Triple
-
 func (hod *HodDB) run_query(graphname string, qstr string) ([]*pb.Row, error) {
 	sq, err := hod.ParseQuery(qstr, 0)
 	if err != nil {
 		return nil, err
 	}
+	// read the newest committed state of the graph
+	sq.Filter = pb.TimeFilter_At
+	sq.Timestamp = 0
 	sq.Graphs = []string{graphname}
+	// duplicate rows would only generate the same triples again
+	sq.Distinct = true
 	resp, err := hod.Select(context.Background(), sq)
 	if err != nil {
 		return nil, err
@@ -26,105 +28,6 @@ func (hod *HodDB) run_query(graphname string, qstr string) ([]*pb.Row, error) {
 	return resp.Rows, nil
 }
 
-func tripleFromRow(row *pb.Row, s, p, o int) rdf.Triple {
-	var t rdf.Triple
-	if s >= 0 {
-		t.Subject = rdf.URI{Namespace: row.Values[s].Namespace, Value: row.Values[s].Value}
-	}
-	if p >= 0 {
-		t.Predicate = rdf.URI{Namespace: row.Values[p].Namespace, Value: row.Values[p].Value}
-	}
-	if o >= 0 {
-		t.Object = rdf.URI{Namespace: row.Values[o].Namespace, Value: row.Values[o].Value}
-	}
-	return t
-}
-
-//add rules to ourself
-func (hod *HodDB) inferRules(graphname string) error {
-	// add inverse rules
-	// get all pairs of inverse edges
-	q := `SELECT ?s ?o WHERE { ?s owl:inverseOf ?o }`
-	rows, err := hod.run_query(graphname, q)
-	if err != nil {
-		return err
-	}
-	for _, row := range rows {
-		pred := rdf.URI{Namespace: row.Values[0].Namespace, Value: row.Values[0].Value}
-		invpred := rdf.URI{Namespace: row.Values[1].Namespace, Value: row.Values[1].Value}
-
-		inv_func := func() []rdf.Triple {
-			var ret []rdf.Triple
-			q1 := fmt.Sprintf("SELECT ?s ?o WHERE { ?s <%s> ?o }", pred)
-			resp, err := hod.run_query(graphname, q1)
-			if err != nil {
-				log.Error("running inv rule", err)
-				return nil
-			}
-			for _, row := range resp {
-				triple := tripleFromRow(row, 1, -1, 0)
-				triple.Predicate = invpred
-				ret = append(ret, triple)
-			}
-
-			return ret
-		}
-		hod.rules = append(hod.rules, inv_func)
-
-		inv_func2 := func() []rdf.Triple {
-			var ret []rdf.Triple
-			q1 := fmt.Sprintf("SELECT ?s ?o WHERE { ?s <%s> ?o }", invpred)
-			resp, err := hod.run_query(graphname, q1)
-			if err != nil {
-				log.Error("running inv rule", err)
-				return nil
-			}
-			for _, row := range resp {
-				triple := tripleFromRow(row, 1, -1, 0)
-				triple.Predicate = pred
-				ret = append(ret, triple)
-			}
-
-			return ret
-		}
-		hod.rules = append(hod.rules, inv_func2)
-
-		same_as := func() []rdf.Triple {
-			var ret []rdf.Triple
-			q1 := fmt.Sprintf(`SELECT ?src ?dst WHERE {
-				?src owl:sameAs ?dst .
-			}`)
-			resp, err := hod.run_query(graphname, q1)
-			if err != nil {
-				log.Error("running inv rule", err)
-				return nil
-			}
-			for _, row := range resp {
-				src := rdf.URI{Namespace: row.Values[0].Namespace, Value: row.Values[0].Value}
-				dst := rdf.URI{Namespace: row.Values[1].Namespace, Value: row.Values[1].Value}
-				q2 := fmt.Sprintf(`SELECT ?p ?o WHERE {
-					<%s> ?p ?o .
-				}`, src)
-				properties, err := hod.run_query(graphname, q2)
-				if err != nil {
-					log.Error("running sameas rule", err)
-					return nil
-				}
-				for _, prop := range properties {
-					triple := tripleFromRow(prop, -1, 0, 1)
-					triple.Subject = dst
-					ret = append(ret, triple)
-				}
-
-			}
-
-			return ret
-		}
-		hod.rules = append(hod.rules, same_as)
-	}
-	return nil
-}
-
 var __select_all_query = `SELECT ?s ?p ?o WHERE { ?s ?p ?o }`
 
 // this is an alternative API for HodDB for incremental maintenance of views
@@ -163,7 +66,9 @@ func (hod *HodDB) addTriples(graphname string, ds rdf.DataSet) error {
 		hod:  hod,
 		Data: ds,
 	}
+	hod.Lock()
 	hod.graphs[graph.Name] = struct{}{}
+	hod.Unlock()
 	_ns, found := hod.namespaces.Load(graph.Name)
 	if found {
 		ns := _ns.(map[string]string)
@@ -174,7 +79,14 @@ func (hod *HodDB) addTriples(graphname string, ds rdf.DataSet) error {
 	} else {
 		hod.namespaces.Store(graph.Name, graph.Data.Namespaces)
 	}
-	entities := graph.CompileEntities()
+	version, err := hod.writeVersion(graphname)
+	if err != nil {
+		return err
+	}
+	entities, err := graph.CompileEntities()
+	if err != nil {
+		return err
+	}
 
 	//log.Println("entities compiled", len(entities))
 
@@ -186,7 +98,7 @@ func (hod *HodDB) addTriples(graphname string, ds rdf.DataSet) error {
 			txn.Discard()
 			return errors.Wrap(err, "Error serializing entry")
 		}
-		if err := hod.setWithCommit(txn, ent.compiled.EntityKey, serializedEntry); err != nil {
+		if err := hod.setWithCommit(txn, ent.key.atVersion(version).Bytes(), serializedEntry); err != nil {
 			return errors.Wrap(err, "Error txn commit")
 		}
 	}
@@ -198,62 +110,36 @@ func (hod *HodDB) addTriples(graphname string, ds rdf.DataSet) error {
 	return nil
 }
 
+// AddTriples adds the triples to the graph and applies the reasoner until nothing
+// new is inferred. The result is a new version of the graph
 func (hod *HodDB) AddTriples(graphname string, dataset rdf.DataSet) error {
-	if err := hod.addTriples(graphname, dataset); err != nil {
+	if err := checkWritable(graphname); err != nil {
 		return err
 	}
-
-	stable_triples := make(map[rdf.Triple]int)
-
-	// add triples to the initial set
-	for _, triple := range dataset.Triples {
-		stable_triples[triple] = 0
-	}
-
-	var changed = true
-	// run this until no more pending triples
-	for changed {
-		changed = false
-		// add stable triples to database
-		dataset.Triples = dataset.Triples[:0]
-		for triple := range stable_triples {
-			dataset.Triples = append(dataset.Triples, triple)
+	dataset = skolemize(dataset, newLoadID())
+	return hod.newVersion(graphname, func(entry *versionEntry) error {
+		entry.Added = len(dataset.Triples)
+		if err := hod.markAsserted(graphname, dataset.Triples); err != nil {
+			return errors.Wrap(err, "Could not record added triples")
 		}
-		if err := hod.addTriples(graphname, dataset); err != nil {
-			return err
-		}
-
-		// apply rules
-		for _, rule := range hod.rules {
-			generated := rule()
-			if generated != nil {
-				for _, pending_triple := range generated {
-					if _, found := stable_triples[pending_triple]; !found {
-						changed = true
-						stable_triples[pending_triple] = 0
-					}
-				}
-			}
-		}
-	}
-
-	dataset.Triples = dataset.Triples[:0]
-	for triple := range stable_triples {
-		dataset.Triples = append(dataset.Triples, triple)
-	}
+		return hod.inferAndAddTriples(graphname, dataset)
+	})
+}
 
+// adds triples and everything the reasoner infers from them. Only AddTriples
+// records the triples as asserted; the inferred triples are not.
+func (hod *HodDB) inferAndAddTriples(graphname string, dataset rdf.DataSet) error {
 	if err := hod.addTriples(graphname, dataset); err != nil {
 		return err
 	}
-
-	return nil
+	return hod.reason(graphname, dataset.Triples)
 }
 
 func (hod *HodDB) AddTriplesWithChanged(graphname string, dataset rdf.DataSet) (bool, error) {
 
 	d1, err := hod.all_triples()
 	if err != nil {
-		panic(err)
+		return false, err
 	}
 	before_hash := d1.Hash()
 
@@ -263,14 +149,15 @@ func (hod *HodDB) AddTriplesWithChanged(graphname string, dataset rdf.DataSet) (
 
 	d1, err = hod.all_triples()
 	if err != nil {
-		panic(err)
+		return false, err
 	}
 	after_hash := d1.Hash()
 
 	return !bytes.Equal(before_hash, after_hash), nil
 }
 
-func (hod *HodDB) NewGraph(name string) error {
+// CreateGraph creates a graph layered on the configured ontologies
+func (hod *HodDB) CreateGraph(name string) error {
 	bundle := FileBundle{
 		GraphName:     name,
 		OntologyFiles: hod.cfg.Database.Ontologies,
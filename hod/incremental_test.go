@@ -40,11 +40,6 @@ database:
 	load_file("BrickFrame.ttl")
 	load_file("Brick.ttl")
 
-	// infer rules
-	err = hod.inferRules("test2")
-	require.NoError(err, "Infer rule")
-	require.Equal(36, len(hod.rules), "number of hod rules")
-
 	load_file("example.ttl")
 
 	q1 := "SELECT ?x WHERE { ?x rdf:type brick:Room }"
@@ -3,6 +3,7 @@ package hod
 import (
 	"github.com/dgraph-io/badger/v2"
 	"github.com/dgraph-io/badger/v2/options"
+	logpb "github.com/gtfierro/hoddb/proto"
 	turtle "github.com/gtfierro/hoddb/turtle"
 	"github.com/pkg/errors"
 	"github.com/pkg/profile"
@@ -39,88 +40,50 @@ type HodDB struct {
 	//versionDB *versionmanager
 	cfg *Config
 
-	hashes map[hashkeyentry]EntityKey
-	uris   map[EntityKey]turtle.URI
+	// recently used entries of the URI dictionary: hashkeyentry -> EntityKey
+	// and EntityKey -> turtle.URI
+	uriCache *lruCache
+	keyCache *lruCache
+	// entries assigned by the write in progress, which are not saved yet
+	unsavedKeys map[hashkeyentry]EntityKey
+	unsavedURIs map[EntityKey]turtle.URI
+	// graph hash -> the next ID to assign to a URI in the graph
+	nextIDs map[[4]byte]uint32
 	sync.RWMutex
 
-	rules []inferenceRule2
+	// serializes writes, each of which creates a new version of a graph
+	writeLock sync.Mutex
+	// serializes creating and dropping graphs
+	graphLock sync.Mutex
+	// serializes loading the ontology graph
+	ontologyLock sync.Mutex
+	// graph name -> version being written by the write in progress
+	pending map[string]uint64
 
 	// map graph name to namespaces (map[string]map[string]string)
 	namespaces sync.Map
 	graphs     map[string]struct{}
+	// the rules applied by the reasoner, along with its RDFS and OWL rules
+	rules []*logpb.ConstructQuery
+
+	// closed to stop watching the files of the buildings
+	stopWatch chan struct{}
 }
 
 func (db *HodDB) Close() error {
+	if db.stopWatch != nil {
+		close(db.stopWatch)
+	}
 	return db.db.Close()
 }
 
 // loads internal data structures from badger:
-// db.hashes, db.uris, db.namespaces, db.graphs
+// db.namespaces, db.graphs
 func (db *HodDB) loadInternal() error {
-	// read in the hash, entity keys
-	err1 := db.db.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-		prefix := []byte("hashpfx")
-		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
-			item := it.Item()
-			k := item.Key()
-			err := item.Value(func(v []byte) error {
-				//fmt.Printf("key=%s, value=%s\n", k, v)
-				serializedhashkey := k[len(prefix):]
-				var hashkey hashkeyentry
-				if err := json.Unmarshal(serializedhashkey, &hashkey); err != nil {
-					return err
-				}
-
-				entitykey := EntityKeyFromBytes(v)
-				db.hashes[hashkey] = entitykey
-
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-		}
-		return nil
-	})
-	if err1 != nil {
-		return errors.Wrap(err1, "could not load hashes from db")
-	}
-
-	err2 := db.db.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-		prefix := []byte("entitypfx")
-		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
-			item := it.Item()
-			k := item.Key()
-			err := item.Value(func(v []byte) error {
-				//fmt.Printf("key=%s, value=%s\n", k, v)
-				entitykey := EntityKeyFromBytes(k[len(prefix):])
-				var uri turtle.URI
-				if err := json.Unmarshal(v, &uri); err != nil {
-					return err
-				}
-
-				db.uris[entitykey] = uri
-
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-		}
-		return nil
-	})
-	if err2 != nil {
-		return errors.Wrap(err2, "could not load hashes from db")
-	}
-
-	err3 := db.db.View(func(txn *badger.Txn) error {
+	err := db.db.View(func(txn *badger.Txn) error {
 		it := txn.NewIterator(badger.DefaultIteratorOptions)
 		defer it.Close()
-		prefix := []byte("namespacepfx")
+		prefix := namespacepfx
 		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
 			item := it.Item()
 			k := item.Key()
@@ -144,88 +107,30 @@ func (db *HodDB) loadInternal() error {
 		}
 		return nil
 	})
-	if err3 != nil {
-		return errors.Wrap(err3, "could not load hashes from db")
+	if err != nil {
+		return errors.Wrap(err, "could not load namespaces from db")
 	}
-	return nil
-}
 
-// save internal structures HodDB needs:
-// db.hashes, db.uris, db.namespaces, db.graphs
-func (db *HodDB) saveInternal() error {
-	// write the hashes, uris to the store
-	hashpfx := []byte("hashpfx")
-	db.Lock()
-	defer db.Unlock()
-
-	// Backup db.hashes
-	wb := db.db.NewWriteBatch()
-	defer wb.Cancel()
-	for hashkey, entitykey := range db.hashes {
-		serializedkey, err := json.Marshal(hashkey)
-		if err != nil {
-			return err
-		}
-		var b []byte
-		b = append(b, hashpfx...)
-		b = append(b, serializedkey...)
-		if err := wb.Set(b, entitykey.Bytes()); err != nil {
-			return err
-		}
-	}
-	if err := wb.Flush(); err != nil {
-		return err
+	if err := db.migrateHashes(); err != nil {
+		return errors.Wrap(err, "could not migrate hashes")
 	}
+	return nil
+}
 
-	// Backup db.uris
-	entitypfx := []byte("entitypfx")
-	wb2 := db.db.NewWriteBatch()
-	defer wb2.Cancel()
-
-	for entitykey, uri := range db.uris {
-		serializeduri, err := json.Marshal(uri)
-		if err != nil {
-			return err
-		}
-		var b []byte
-		b = append(b, entitypfx...)
-		b = append(b, entitykey.Bytes()...)
-		if err := wb2.Set(b, serializeduri); err != nil {
-			return err
-		}
+// saves the graph's namespaces, which is also what makes the graph exist when
+// the database is opened
+func (db *HodDB) saveNamespaces(name string) error {
+	namespaces, found := db.namespaces.Load(name)
+	if !found {
+		return nil
 	}
-	if err := wb2.Flush(); err != nil {
-		return err
+	serialized, err := json.Marshal(namespaces)
+	if err != nil {
+		return errors.Wrap(err, "Could not serialize namespaces")
 	}
-
-	// Backup db.namespaces
-	nspfx := []byte("namespacepfx")
-	wb3 := db.db.NewWriteBatch()
-	defer wb3.Cancel()
-	db.namespaces.Range(func(key, value interface{}) bool {
-		// (map[string]map[string]string)
-		//key
-		var b []byte
-		b = append(b, nspfx...)
-		b = append(b, []byte(key.(string))...)
-		//value
-		serialized, err := json.Marshal(value)
-		if err != nil {
-			log.Error(err)
-			return false
-		}
-
-		if err := wb3.Set(b, serialized); err != nil {
-			log.Error(err)
-			return false
-		}
-
-		return true
+	return db.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(namespaceKey(name), serialized)
 	})
-	if err := wb3.Flush(); err != nil {
-		return err
-	}
-	return nil
 }
 
 func (db *HodDB) Backup(w io.Writer) error {
@@ -250,6 +155,10 @@ func MakeHodDB(cfg *Config) (*HodDB, error) {
 		}()
 	}
 
+	if cfg.Database.WatchFiles && cfg.Database.WatchInterval <= 0 {
+		return nil, errors.New("Database.WatchInterval must be positive")
+	}
+
 	/* open view database */
 	dbdir := filepath.Join(cfg.Database.Path, "_db_")
 	if err := os.MkdirAll(dbdir, 0700); err != nil {
@@ -260,17 +169,55 @@ func MakeHodDB(cfg *Config) (*HodDB, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "Could not open badger db")
 	}
+	// the database is closed if it can not be made ready, so it can be opened again
+	opened := false
+	defer func() {
+		if !opened {
+			db.Close()
+		}
+	}()
 
 	hod := &HodDB{
-		db:     db,
-		cfg:    cfg,
-		hashes: make(map[hashkeyentry]EntityKey),
-		uris:   make(map[EntityKey]turtle.URI),
-		graphs: make(map[string]struct{}),
+		db:          db,
+		cfg:         cfg,
+		uriCache:    newLRUCache(cfg.dictionaryCacheSize()),
+		keyCache:    newLRUCache(cfg.dictionaryCacheSize()),
+		unsavedKeys: make(map[hashkeyentry]EntityKey),
+		unsavedURIs: make(map[EntityKey]turtle.URI),
+		nextIDs:     make(map[[4]byte]uint32),
+		graphs:      make(map[string]struct{}),
+		pending:     make(map[string]uint64),
 	}
 	if err := hod.loadInternal(); err != nil {
 		return nil, errors.Wrap(err, "could not reconstitute")
 	}
+	if err := hod.resumeDrops(); err != nil {
+		return nil, errors.Wrap(err, "could not finish dropping graphs")
+	}
+	if err := hod.loadRules(); err != nil {
+		return nil, errors.Wrap(err, "could not load rules")
+	}
+
+	numBuildings := len(cfg.Database.Buildings)
+
+	processed := 0
+	for _, bundle := range cfg.buildingBundles() {
+		s := time.Now()
+		if err := hod.Load(bundle); err != nil {
+			log.Error(errors.Wrapf(err, "Could not load file %s", bundle.GraphName))
+		}
+		processtime := time.Since(s)
+		processed += 1
+		log.Infof("Loaded in %d/%d (%.2f%%) buildings from config file (%s took %s)", processed, numBuildings, 100*float64(processed)/float64(numBuildings), bundle.GraphName, processtime)
+	}
+	if err := hod.addConfigRules(cfg.Database.Rules); err != nil {
+		return nil, errors.Wrap(err, "could not add rules from config")
+	}
+
+	if cfg.Database.WatchFiles {
+		hod.stopWatch = make(chan struct{})
+		go hod.watchBuildings(cfg.Database.WatchInterval, hod.stopWatch)
+	}
 
 	// start GC on the database
 	go func() {
@@ -285,29 +232,7 @@ func MakeHodDB(cfg *Config) (*HodDB, error) {
 		}
 	}()
 
-	numBuildings := len(cfg.Database.Buildings)
-
-	processed := 0
-	for graphname, graphfile := range cfg.Database.Buildings {
-		bundle := FileBundle{
-			GraphName:     graphname,
-			TTLFile:       graphfile,
-			OntologyFiles: cfg.Database.Ontologies,
-		}
-		s := time.Now()
-		if err := hod.Load(bundle); err != nil {
-			log.Error(errors.Wrapf(err, "Could not load file %s", graphname))
-		}
-		processtime := time.Since(s)
-		processed += 1
-		log.Infof("Loaded in %d/%d (%.2f%%) buildings from config file (%s took %s)", processed, numBuildings, 100*float64(processed)/float64(numBuildings), graphname, processtime)
-	}
-
-	// write namespaces, etc to the badger file
-	if err := hod.saveInternal(); err != nil {
-		return nil, errors.Wrap(err, "Could not save internal data structures")
-	}
-
+	opened = true
 	return hod, nil
 }
 
@@ -325,16 +250,23 @@ func MakeHodDBLambda(cfg *Config, backup io.Reader) (*HodDB, error) {
 	}
 
 	hod := &HodDB{
-		db:     db,
-		cfg:    cfg,
-		hashes: make(map[hashkeyentry]EntityKey),
-		uris:   make(map[EntityKey]turtle.URI),
-		graphs: make(map[string]struct{}),
+		db:          db,
+		cfg:         cfg,
+		uriCache:    newLRUCache(cfg.dictionaryCacheSize()),
+		keyCache:    newLRUCache(cfg.dictionaryCacheSize()),
+		unsavedKeys: make(map[hashkeyentry]EntityKey),
+		unsavedURIs: make(map[EntityKey]turtle.URI),
+		nextIDs:     make(map[[4]byte]uint32),
+		graphs:      make(map[string]struct{}),
+		pending:     make(map[string]uint64),
 	}
 
 	if err := hod.loadInternal(); err != nil {
 		return nil, errors.Wrap(err, "could not reconstitute internal data structures")
 	}
+	if err := hod.loadRules(); err != nil {
+		return nil, errors.Wrap(err, "could not load rules")
+	}
 
 	go func() {
 		ticker := time.NewTicker(1 * time.Minute)
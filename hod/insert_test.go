@@ -1,8 +1,10 @@
 package hod
 
 import (
+	"context"
 	"fmt"
 	logpb "github.com/gtfierro/hoddb/proto"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"io/ioutil"
 	"os"
@@ -73,16 +75,89 @@ func TestInsertExample(t *testing.T) {
 	require.NoError(err)
 	require.NotNil(entity)
 
+	// BrickFrame gives bf:isPointOf the range bf:Point, a subclass of
+	// bf:Taggable, so vav_1 has both types
 	edges = entity.GetAllOutEdges()
-	require.Equal(5, len(edges))
+	require.Equal(7, len(edges))
 
 	edges = entity.GetAllInEdges()
 	require.Equal(4, len(edges))
 
 	edges = entity.GetAllOutPlusEdges()
-	require.Equal(5, len(edges))
+	require.Equal(7, len(edges))
 
 	edges = entity.GetAllInPlusEdges()
 	require.Equal(4, len(edges))
 
 }
+
+func TestInsertQuery(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+
+	iq, err := hod.ParseInsertQuery("INSERT { ?x rdf:type brick:Location . ?x bf:isLocatedIn bldg:floor_1 } TO test WHERE { ?x rdf:type brick:Room }")
+	require.NoError(err, "parse insert")
+	resp, err := hod.Insert(context.Background(), iq)
+	require.NoError(err, "insert")
+	require.Equal(int64(2), resp.Count, "inserted triples")
+
+	rows, err := hod.run_query("test", "SELECT ?x WHERE { ?x rdf:type brick:Location }")
+	require.NoError(err)
+	require.Equal(1, len(rows))
+
+	rows, err = hod.run_query("test", "SELECT ?x WHERE { ?x bf:isLocatedIn bldg:floor_1 }")
+	require.NoError(err)
+	require.Equal(1, len(rows))
+
+	// no WHERE clause inserts the template as-is
+	iq, err = hod.ParseInsertQuery("INSERT { bldg:room_2 rdf:type brick:Room } TO test")
+	require.NoError(err, "parse insert")
+	resp, err = hod.Insert(context.Background(), iq)
+	require.NoError(err, "insert")
+	require.Equal(int64(1), resp.Count, "inserted triples")
+
+	rows, err = hod.run_query("test", "SELECT ?x WHERE { ?x rdf:type brick:Room }")
+	require.NoError(err)
+	require.Equal(2, len(rows))
+
+	// template variables must be bound by the WHERE clause
+	iq, err = hod.ParseInsertQuery("INSERT { ?y rdf:type brick:Room } TO test WHERE { ?x rdf:type brick:Room }")
+	require.NoError(err, "parse insert")
+	_, err = hod.Insert(context.Background(), iq)
+	require.Error(err, "unbound variable")
+
+	// the parts of the WHERE clause that are not evaluated are rejected, not ignored
+	for _, where := range []string{
+		"{ ?x rdf:type brick:Room . FILTER(?x = bldg:room_1) }",
+		"{ ?x rdf:type brick:Room . OPTIONAL { ?x bf:isPartOf ?y } }",
+		"{ { ?x rdf:type brick:Room } UNION { ?x rdf:type brick:Floor } }",
+		"{ ?x rdf:type brick:Room . GRAPH other { ?x rdf:type brick:Room } }",
+	} {
+		_, err = hod.ParseInsertQuery("INSERT { ?x rdf:type brick:Location } TO test WHERE " + where)
+		require.Equal(ErrParse, errors.Cause(err), where)
+	}
+	// nested groups of triple patterns are part of the WHERE clause
+	iq, err = hod.ParseInsertQuery("INSERT { ?x rdf:type brick:Space } TO test WHERE { { ?x rdf:type brick:Room } }")
+	require.NoError(err, "parse insert")
+	require.Len(iq.Where, 1)
+}
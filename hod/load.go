@@ -1,15 +1,19 @@
 package hod
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"sort"
 
 	"github.com/dgraph-io/badger/v2"
 	logpb "github.com/gtfierro/hoddb/proto"
 	turtle "github.com/gtfierro/hoddb/turtle"
+	rdf "github.com/gtfierro/hoddb/turtle/rdfparser"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -29,26 +33,56 @@ type FileBundle struct {
 	OntologyFiles []string
 }
 
-func (bundle FileBundle) getKeyValue() ([]byte, []byte) {
-	var files = []string{bundle.TTLFile}
+// returns the paths of the bundle's files, with the ontologies sorted
+func (bundle FileBundle) files() []string {
+	var files []string
+	if bundle.TTLFile != "" {
+		files = append(files, bundle.TTLFile)
+	}
 	ontology_files := bundle.OntologyFiles[:]
 	sort.Strings(ontology_files)
-	files = append(files, ontology_files...)
-	h := sha256.New()
-	for _, filename := range files {
-		if filename == "" {
-			continue
-		}
-		f, err := os.Open(filename)
-		if err != nil {
-			log.Fatal(err)
+	for _, filename := range ontology_files {
+		if filename != "" {
+			files = append(files, filename)
 		}
-		defer f.Close()
-		if _, err := io.Copy(h, f); err != nil {
-			log.Fatal(err)
+	}
+	return files
+}
+
+// returns the key marking the bundle as loaded, and the hash of its graph's
+// file. The ontologies are loaded into the ontology graph, which keeps track of
+// their changes itself
+func (bundle FileBundle) getKeyValue() ([]byte, []byte, error) {
+	h := sha256.New()
+	if bundle.TTLFile != "" {
+		if err := hashFile(h, bundle.TTLFile); err != nil {
+			return nil, nil, err
 		}
 	}
-	return bundle.getKey(), h.Sum(nil)
+	return bundle.getKey(), h.Sum(nil), nil
+}
+
+func hashFile(h io.Writer, filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return errors.Wrap(err, "could not open file")
+	}
+	defer f.Close()
+	_, err = io.Copy(h, f)
+	return errors.Wrapf(err, "could not read file %s", filename)
+}
+
+// parses the Turtle file. Returns ErrParse if the file is not valid Turtle
+func parseFile(filename string) (turtle.DataSet, error) {
+	contents, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return *turtle.NewDataSet(), errors.Wrap(err, "could not open file")
+	}
+	dataset, err := turtle.ParseReader(bytes.NewReader(contents), rdf.Turtle)
+	if err != nil {
+		return dataset, errors.Wrapf(ErrParse, "%s: %s", filename, err)
+	}
+	return skolemize(dataset, fileLoadID(filename)), nil
 }
 
 func (bundle FileBundle) getKey() []byte {
@@ -69,10 +103,13 @@ type Graph struct {
 
 	rules []InferenceRule
 	hod   *HodDB
+	// hash of the files the graph was loaded from
+	source []byte
 }
 
-func (hod *HodDB) markBundleLoaded(bundle FileBundle) error {
-	key, value := bundle.getKeyValue()
+// records that the bundle was loaded from files with the given hash
+func (hod *HodDB) markBundleLoaded(bundle FileBundle, value []byte) error {
+	key := bundle.getKey()
 	txn := hod.db.NewTransaction(true)
 	if err := txn.Set(key, value); err != nil {
 		txn.Discard()
@@ -86,20 +123,107 @@ func (hod *HodDB) markBundleLoaded(bundle FileBundle) error {
 }
 
 func (hod *HodDB) isFileBundleLoaded(bundle FileBundle) (bool, error) {
-	key := bundle.getKey()
-	txn := hod.db.NewTransaction(false)
-	_, err := txn.Get(key)
-	if err == badger.ErrKeyNotFound {
-		return false, nil
-	} else if err != nil {
-		txn.Discard()
-		return false, err
+	hash, err := hod.loadedBundleHash(bundle)
+	return hash != nil, err
+}
+
+// returns the hash of the bundle's files when the bundle was last loaded, or nil
+// if it has not been loaded
+func (hod *HodDB) loadedBundleHash(bundle FileBundle) ([]byte, error) {
+	var hash []byte
+	err := hod.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(bundle.getKey())
+		if err == badger.ErrKeyNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		hash, err = item.ValueCopy(nil)
+		return err
+	})
+	return hash, err
+}
+
+// brings the graph of a bundle whose files have changed up to date. The files
+// hold everything that was added to the graph: triples that are no longer in
+// them are deleted (including triples added by other means), and new ones are
+// added, as one new version of the graph
+func (hod *HodDB) reloadBundle(bundle FileBundle) error {
+	graph, err := hod.loadFileBundle(bundle)
+	if err != nil {
+		return errors.Wrapf(err, "could not load file %s for graph %s", bundle.TTLFile, bundle.GraphName)
 	}
-	if err := txn.Commit(); err != nil {
-		txn.Discard()
-		return false, err
+	if err := hod.reloadGraph(graph); err != nil {
+		return err
+	}
+	return errors.Wrap(hod.markBundleLoaded(bundle, graph.source), "could not mark bundle as loaded")
+}
+
+// makes the triples asserted in the graph those of graph.Data, as one new
+// version of the graph
+func (hod *HodDB) reloadGraph(graph Graph) error {
+	added, removed, err := hod.diffAsserted(graph.Name, graph.Data.Triples)
+	if err != nil {
+		return errors.Wrap(err, "could not compare files to graph")
+	}
+	log.Infof("Reloading graph %s: %d triples added, %d removed", graph.Name, len(added.Triples), len(removed.Triples))
+
+	err = hod.newVersion(graph.Name, func(entry *versionEntry) (err error) {
+		entry.Source = graph.source
+		if len(removed.Triples) > 0 {
+			if entry.Removed, err = hod.deleteTriples(graph.Name, removed); err != nil {
+				return err
+			}
+		}
+		if len(added.Triples) > 0 {
+			entry.Added = len(added.Triples)
+			if err := hod.markAsserted(graph.Name, added.Triples); err != nil {
+				return errors.Wrap(err, "Could not record added triples")
+			}
+			return hod.inferAndAddTriples(graph.Name, added)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not reload graph")
+	}
+	return hod.addNamespaces(graph.Name, graph.Data.Namespaces)
+}
+
+// returns the triples that are not yet asserted in the graph, and the asserted
+// triples that are not in the given triples
+func (hod *HodDB) diffAsserted(graphname string, triples []turtle.Triple) (added, removed turtle.DataSet, err error) {
+	asserted, err := hod.getAsserted(graphname, latestVersion)
+	if err != nil {
+		return
+	}
+	seen := make(map[turtle.Triple]struct{})
+	for _, triple := range triples {
+		if _, found := seen[triple]; found {
+			continue
+		}
+		seen[triple] = struct{}{}
+		subject, sfound := hod.lookupURI(graphname, triple.Subject)
+		predicate, pfound := hod.lookupURI(graphname, triple.Predicate)
+		object, ofound := hod.lookupURI(graphname, triple.Object)
+		key := tripleKey{subject, predicate, object}
+		if _, found := asserted[key]; sfound && pfound && ofound && found {
+			delete(asserted, key)
+			continue
+		}
+		added.Triples = append(added.Triples, triple)
 	}
-	return true, nil
+	for key := range asserted {
+		subject, sfound := hod.getURI(key[0])
+		predicate, pfound := hod.getURI(key[1])
+		object, ofound := hod.getURI(key[2])
+		if !sfound || !pfound || !ofound {
+			log.Warningf("Unknown URI in a triple of graph %s", graphname)
+			continue
+		}
+		removed.Triples = append(removed.Triples, turtle.Triple{Subject: subject, Predicate: predicate, Object: object})
+	}
+	return
 }
 
 func (hod *HodDB) loadFileBundle(bundle FileBundle) (Graph, error) {
@@ -107,65 +231,71 @@ func (hod *HodDB) loadFileBundle(bundle FileBundle) (Graph, error) {
 		Name: bundle.GraphName,
 		hod:  hod,
 	}
+	var err error
+	if _, g.source, err = bundle.getKeyValue(); err != nil {
+		return g, err
+	}
 
 	// load graph
-	var (
-		dataset turtle.DataSet
-		err     error
-	)
 	log.Warning(bundle.TTLFile)
 	if bundle.TTLFile != "" {
-		dataset, err = turtle.Parse(bundle.TTLFile)
+		g.Data, err = parseFile(bundle.TTLFile)
 		if err != nil {
 			return g, err
 		}
 	} else {
-		_dataset := turtle.NewDataSet()
-		dataset = *_dataset
-	}
-
-	// load ontologies
-	for _, ontology_file := range bundle.OntologyFiles {
-		ontology_dataset, _ := turtle.Parse(ontology_file)
-		for _, triple := range ontology_dataset.Triples {
-			dataset.Triples = append(dataset.Triples, triple)
-		}
+		g.Data = *turtle.NewDataSet()
 	}
 
-	g.Data = dataset
-
 	g.getInferenceRules()
 
 	return g, nil
 }
 
-// find some basic OWL inference instances that we can do
+// find some basic OWL inference instances that we can do, in the graph's
+// triples and in the ontology graph
 func (g *Graph) getInferenceRules() {
 	for _, triple := range g.Data.Triples {
-
 		// RULE: populate inverse edges
 		if triple.Predicate.Namespace == OWL_NAMESPACE && triple.Predicate.Value == "inverseOf" {
-			pred := triple.Subject
-			invpred := triple.Object
-			newrule := func(input turtle.Triple) []turtle.Triple {
-				if input.Predicate == pred {
-					return []turtle.Triple{{
-						Subject:   input.Object,
-						Predicate: invpred,
-						Object:    input.Subject,
-					}}
-				} else if input.Predicate == invpred {
-					return []turtle.Triple{{
-						Subject:   input.Object,
-						Predicate: pred,
-						Object:    input.Subject,
-					}}
-				}
-				return nil
-			}
-			g.rules = append(g.rules, newrule)
+			g.addInverseRule(triple.Subject, triple.Object)
+		}
+	}
+	if g.Name == ontologyGraph || !g.hod.graphExists(ontologyGraph) {
+		return
+	}
+	rows, err := g.hod.run_query(ontologyGraph, `SELECT ?s ?o WHERE { ?s owl:inverseOf ?o }`)
+	if err != nil {
+		log.Error(errors.Wrap(err, "Could not find inverse edges in ontologies"))
+		return
+	}
+	for _, row := range rows {
+		g.addInverseRule(turtle.URIFromProto(row.Values[0]), turtle.URIFromProto(row.Values[1]))
+	}
+}
+
+func (g *Graph) addInverseRule(pred, invpred turtle.URI) {
+	newrule := func(input turtle.Triple) []turtle.Triple {
+		// literals can not be subjects, as in the reasoner
+		if input.Object.IsLiteral() {
+			return nil
+		}
+		if input.Predicate == pred {
+			return []turtle.Triple{{
+				Subject:   input.Object,
+				Predicate: invpred,
+				Object:    input.Subject,
+			}}
+		} else if input.Predicate == invpred {
+			return []turtle.Triple{{
+				Subject:   input.Object,
+				Predicate: pred,
+				Object:    input.Subject,
+			}}
 		}
+		return nil
 	}
+	g.rules = append(g.rules, newrule)
 }
 
 // apply rules to triples to generate new triples
@@ -219,7 +349,7 @@ func (g *Graph) ExpandTriples() {
 	}
 }
 
-func (g *Graph) CompileEntities() map[EntityKey]*Entity {
+func (g *Graph) CompileEntities() (map[EntityKey]*Entity, error) {
 	entities := make(map[EntityKey]*Entity)
 
 	getEntity := func(key EntityKey) *Entity {
@@ -243,9 +373,18 @@ func (g *Graph) CompileEntities() map[EntityKey]*Entity {
 
 	// add triples
 	for _, triple := range g.Data.Triples {
-		subjectHash := g.hod.hashURI(g.Name, triple.Subject)
-		predicateHash := g.hod.hashURI(g.Name, triple.Predicate)
-		objectHash := g.hod.hashURI(g.Name, triple.Object)
+		subjectHash, err := g.hod.hashURI(g.Name, triple.Subject)
+		if err != nil {
+			return nil, err
+		}
+		predicateHash, err := g.hod.hashURI(g.Name, triple.Predicate)
+		if err != nil {
+			return nil, err
+		}
+		objectHash, err := g.hod.hashURI(g.Name, triple.Object)
+		if err != nil {
+			return nil, err
+		}
 
 		subject := getEntity(subjectHash)
 		subject.addOutEdge(predicateHash, objectHash, logpb.Pattern_Single)
@@ -262,7 +401,7 @@ func (g *Graph) CompileEntities() map[EntityKey]*Entity {
 		entity.Compile()
 	}
 
-	return entities
+	return entities, nil
 }
 
 // what do we need for ad-hoc update sof triples?
@@ -272,11 +411,8 @@ func (g *Graph) CompileEntities() map[EntityKey]*Entity {
 
 func (hod *HodDB) MakeTripleUpdate(data turtle.DataSet, name string) (Graph, error) {
 	// load ontologies
-	for _, ontology_file := range hod.cfg.Database.Ontologies {
-		ontology_dataset, _ := turtle.Parse(ontology_file)
-		for _, triple := range ontology_dataset.Triples {
-			data.Triples = append(data.Triples, triple)
-		}
+	if err := hod.loadOntologies(hod.cfg.Database.Ontologies); err != nil {
+		return Graph{}, err
 	}
 	g := Graph{
 		Name: name,
@@ -289,12 +425,14 @@ func (hod *HodDB) MakeTripleUpdate(data turtle.DataSet, name string) (Graph, err
 }
 
 func LoadTriplesFromFile(filename string) (turtle.DataSet, error) {
-	d, _ := turtle.Parse(filename)
-	return d, nil
+	return parseFile(filename)
 }
 
 func LoadTriplesFromFileIntoDataSet(filename string, dataset turtle.DataSet) error {
-	d, _ := turtle.Parse(filename)
+	d, err := parseFile(filename)
+	if err != nil {
+		return err
+	}
 	for _, triple := range d.Triples {
 		dataset.Triples = append(dataset.Triples, triple)
 	}
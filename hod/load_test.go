@@ -1,9 +1,16 @@
 package hod
 
 import (
-	//	"fmt"
-	//	"github.com/stretchr/testify/require"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestLoad(t *testing.T) {
@@ -28,3 +35,98 @@ func TestLoad(t *testing.T) {
 	//
 	//	graph.ExpandTriples()
 }
+
+// the triple that editExample removes, and those it adds
+const (
+	exampleRemoved = "bldg:floor_1 bf:isPartOf bldg:building_1 .\n"
+	exampleAdded   = "bldg:room_2 a brick:Room ;\n    bf:isPartOf bldg:floor_1 .\n"
+)
+
+// writes a copy of example.ttl that has one triple removed and two added
+func editExample(t *testing.T, file string) {
+	example, err := ioutil.ReadFile("example.ttl")
+	require.NoError(t, err)
+	edited := strings.Replace(string(example), exampleRemoved, exampleAdded, 1)
+	require.NotEqual(t, string(example), edited)
+	require.NoError(t, ioutil.WriteFile(file, []byte(edited), 0600))
+}
+
+// returns the values of the first variable of the query's rows, sorted
+func queryValues(t *testing.T, hod *HodDB, qstr string) []string {
+	q, err := hod.ParseQuery(qstr, 0)
+	require.NoError(t, err)
+	resp, err := hod.Select(context.Background(), q)
+	require.NoError(t, err)
+	var values []string
+	for _, row := range resp.Rows {
+		values = append(values, row.Values[0].Value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+func TestReloadBundle(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	file := filepath.Join(dir, "building.ttl")
+	example, err := ioutil.ReadFile("example.ttl")
+	require.NoError(err)
+	require.NoError(ioutil.WriteFile(file, example, 0600))
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       file,
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	require.NoError(hod.Load(bundle), "load files")
+	versions := func() int {
+		entries, err := hod.listVersions("test", 0, 0, 0)
+		require.NoError(err)
+		return len(entries)
+	}
+	rooms := "SELECT ?r FROM test WHERE { ?r rdf:type brick:Room }"
+	parents := "SELECT ?p FROM test WHERE { bldg:room_1 bf:isPartOf+ ?p }"
+	require.Equal([]string{"room_1"}, queryValues(t, hod, rooms))
+	require.Equal([]string{"building_1", "floor_1", "hvaczone_1"}, queryValues(t, hod, parents))
+
+	// loading unchanged files does nothing
+	require.NoError(hod.Load(bundle))
+	require.Equal(1, versions())
+
+	// changed files are applied as the difference to the graph
+	editExample(t, file)
+	require.NoError(hod.Load(bundle))
+	require.Equal(2, versions())
+	entries, err := hod.listVersions("test", 0, 0, 1)
+	require.NoError(err)
+	require.Equal(2, entries[0].Added)
+	require.Equal(1, entries[0].Removed)
+	_, hash, err := bundle.getKeyValue()
+	require.NoError(err)
+	require.Equal(hash, entries[0].Source)
+
+	require.Equal([]string{"room_1", "room_2"}, queryValues(t, hod, rooms))
+	require.Equal([]string{"floor_1", "hvaczone_1"}, queryValues(t, hod, parents))
+	require.Equal([]string{"room_1", "room_2"}, queryValues(t, hod, "SELECT ?r FROM test WHERE { bldg:floor_1 bf:hasPart ?r }"))
+	require.Empty(queryValues(t, hod, "SELECT ?p FROM test WHERE { bldg:floor_1 bf:isPartOf ?p }"))
+
+	// the change is remembered when the database is reopened
+	require.NoError(hod.Close())
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "reopen log")
+	defer hod.Close()
+	require.NoError(hod.Load(bundle))
+	require.Equal(2, versions())
+	require.Equal([]string{"room_1", "room_2"}, queryValues(t, hod, rooms))
+}
@@ -0,0 +1,73 @@
+package hod
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lruCache holds up to size values, evicting the least recently used one when
+// it is full
+type lruCache struct {
+	size    int
+	order   *list.List
+	entries map[interface{}]*list.Element
+	sync.Mutex
+}
+
+type lruEntry struct {
+	key   interface{}
+	value interface{}
+}
+
+func newLRUCache(size int) *lruCache {
+	return &lruCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[interface{}]*list.Element),
+	}
+}
+
+func (c *lruCache) get(key interface{}) (interface{}, bool) {
+	c.Lock()
+	defer c.Unlock()
+	elem, found := c.entries[key]
+	if !found {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry).value, true
+}
+
+func (c *lruCache) add(key, value interface{}) {
+	c.Lock()
+	defer c.Unlock()
+	if elem, found := c.entries[key]; found {
+		elem.Value.(*lruEntry).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key, value})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// removes the values whose keys match
+func (c *lruCache) removeIf(match func(key interface{}) bool) {
+	c.Lock()
+	defer c.Unlock()
+	for key, elem := range c.entries {
+		if match(key) {
+			c.order.Remove(elem)
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *lruCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.order.Len()
+}
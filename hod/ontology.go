@@ -0,0 +1,338 @@
+package hod
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/dgraph-io/badger/v2"
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+)
+
+// Ontologies are loaded once, into a graph of their own, instead of into every
+// graph that uses them. The ontology graph can be queried like any other graph
+// but only changes when its files do. Queries against any other graph see the
+// ontology graph layered under it: the entity for a URI is the graph's entity
+// merged with the ontology graph's entity for the same URI. The two graphs
+// assign their own IDs, so the merged entity's edges use the graph's key for a
+// URI it has, and the ontology graph's key for the URIs only the ontology has.
+// Queries always read the newest committed version of the ontology graph.
+
+// name of the graph holding the ontologies
+const ontologyGraph = "_ontology_"
+
+// returns the bundle marking the ontology file as loaded into the ontology graph
+func ontologyBundle(file string) FileBundle {
+	return FileBundle{GraphName: ontologyGraph, TTLFile: file}
+}
+
+// returns the ontology files that have been loaded into the ontology graph
+func (hod *HodDB) loadedOntologies() ([]string, error) {
+	var files []string
+	err := hod.db.View(func(txn *badger.Txn) error {
+		prefix := len(ontologyBundle("").getKey())
+		for _, marker := range hod.bundleMarkers(txn, ontologyGraph) {
+			files = append(files, string(marker[prefix:]))
+		}
+		return nil
+	})
+	return files, err
+}
+
+// loads the ontology files into the ontology graph, unless they are already
+// loaded and have not changed since. The ontology graph keeps the files loaded
+// into it before, so if any file is new or changed all of them are read again
+// and the difference is applied to the graph as one new version, and then to
+// the graphs layered over it
+func (hod *HodDB) loadOntologies(files []string) error {
+	hod.ontologyLock.Lock()
+	defer hod.ontologyLock.Unlock()
+
+	var changed bool
+	for _, file := range files {
+		if file == "" {
+			continue
+		}
+		bundle := ontologyBundle(file)
+		loaded, err := hod.loadedBundleHash(bundle)
+		if err != nil {
+			return errors.Wrap(err, "could not check if ontology is loaded")
+		}
+		_, hash, err := bundle.getKeyValue()
+		if err != nil {
+			return errors.Wrapf(err, "could not read ontology %s", file)
+		}
+		if !bytes.Equal(loaded, hash) {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	loaded, err := hod.loadedOntologies()
+	if err != nil {
+		return errors.Wrap(err, "could not list loaded ontologies")
+	}
+	all := append(loaded, files...)
+	sort.Strings(all)
+	graph := Graph{
+		Name: ontologyGraph,
+		Data: *turtle.NewDataSet(),
+		hod:  hod,
+	}
+	sources := make(map[string][]byte)
+	for _, file := range all {
+		if _, found := sources[file]; found || file == "" {
+			continue
+		}
+		if _, sources[file], err = ontologyBundle(file).getKeyValue(); err != nil {
+			return errors.Wrapf(err, "could not read ontology %s", file)
+		}
+		dataset, err := parseFile(file)
+		if err != nil {
+			return errors.Wrap(err, "could not load ontology")
+		}
+		graph.Data.Triples = append(graph.Data.Triples, dataset.Triples...)
+		for prefix, full := range dataset.Namespaces {
+			if _, found := graph.Data.Namespaces[prefix]; !found {
+				graph.Data.Namespaces[prefix] = full
+			}
+		}
+	}
+	graph.getInferenceRules()
+
+	// the other graphs keep what they inferred from the ontologies, so they
+	// are given the difference too
+	layered, err := hod.resolveGraphs(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	var before map[turtle.Triple]struct{}
+	if hod.graphExists(ontologyGraph) && len(layered) > 0 {
+		if before, err = hod.graphTriples(ontologyGraph); err != nil {
+			return errors.Wrap(err, "could not read ontologies")
+		}
+	}
+
+	if hod.graphExists(ontologyGraph) {
+		log.Infof("Reloading ontologies %v", all)
+		err = hod.reloadGraph(graph)
+	} else {
+		log.Infof("Loading ontologies %v", all)
+		err = hod.addGraph(graph)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not load ontologies")
+	}
+
+	if len(layered) > 0 {
+		after, err := hod.graphTriples(ontologyGraph)
+		if err != nil {
+			return errors.Wrap(err, "could not read ontologies")
+		}
+		var added, removed []turtle.Triple
+		for triple := range after {
+			if _, found := before[triple]; !found {
+				added = append(added, triple)
+			}
+		}
+		for triple := range before {
+			if _, found := after[triple]; !found {
+				removed = append(removed, triple)
+			}
+		}
+		if err := hod.reasonOntologyChange(layered, added, removed); err != nil {
+			return errors.Wrap(err, "could not apply ontologies to graphs")
+		}
+	}
+	for file, hash := range sources {
+		if err := hod.markBundleLoaded(ontologyBundle(file), hash); err != nil {
+			return errors.Wrap(err, "could not mark ontology as loaded")
+		}
+	}
+	return nil
+}
+
+// applies the triples added to and removed from the ontology graph, including
+// the triples inferred in it, to the graphs layered over it. What a graph
+// inferred from the removed triples is retracted and re-derived as when
+// triples are deleted, and then what the added triples entail is inferred.
+// Each graph that changes gets a new version
+func (hod *HodDB) reasonOntologyChange(graphs []string, added, removed []turtle.Triple) error {
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	for _, graph := range graphs {
+		if !hod.graphExists(graph) {
+			continue
+		}
+		log.Infof("Applying ontology changes to graph %s: %d triples added, %d removed", graph, len(added), len(removed))
+		err := hod.newVersion(graph, func(entry *versionEntry) error {
+			if len(removed) > 0 {
+				if err := hod.retract(graph, make(map[tripleKey]turtle.Triple), removed); err != nil {
+					return err
+				}
+			}
+			return hod.reason(graph, added)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "Could not apply ontology changes to graph %s", graph)
+		}
+	}
+	return nil
+}
+
+// returns the triples of the graph, including the inferred triples
+func (hod *HodDB) graphTriples(graphname string) (map[turtle.Triple]struct{}, error) {
+	cursor, err := hod.Cursor(graphname)
+	if err != nil {
+		return nil, err
+	}
+	triples := make(map[turtle.Triple]struct{})
+	err = cursor.Iterate(func(key EntityKey, ent *Entity) bool {
+		subject, found := hod.getURI(key)
+		if !found {
+			return false
+		}
+		for _, edge := range ent.GetAllOutEdges() {
+			predicate, pfound := hod.getURI(edge[0])
+			object, ofound := hod.getURI(edge[1])
+			if pfound && ofound {
+				triples[turtle.Triple{Subject: subject, Predicate: predicate, Object: object}] = struct{}{}
+			}
+		}
+		return false
+	})
+	return triples, err
+}
+
+// returns an error if the graph can not be written to by requests
+func checkWritable(graphname string) error {
+	if graphname == ontologyGraph {
+		return errors.Wrapf(ErrReadOnlyGraph, "Graph %s", graphname)
+	}
+	return nil
+}
+
+// layers the newest committed version of the ontology graph under the cursor's
+// graph, if it has been loaded
+func (c *Cursor) layerOntology() {
+	if c.graphname == ontologyGraph || !c.hod.graphExists(ontologyGraph) {
+		return
+	}
+	version, found, err := c.hod.resolveVersion(ontologyGraph, logpb.TimeFilter_At, 0)
+	if err != nil {
+		log.Error(errors.Wrap(err, "Could not find version of the ontology graph"))
+		return
+	} else if !found {
+		return
+	}
+	c.layered = true
+	c.ontologyVersion = version
+	copy(c.ontology[:], hashString(ontologyGraph))
+}
+
+// returns the key of the URI in the cursor's graph if it has one, or else its
+// key in the ontology graph
+func (c *Cursor) canonicalKey(key EntityKey) EntityKey {
+	if key.Graph != c.ontology {
+		return key
+	}
+	uri, found := c.hod.getURI(key)
+	if !found {
+		return key
+	}
+	if own, found := c.hod.lookupURI(c.graphname, uri); found {
+		return own
+	}
+	return key
+}
+
+// returns the entity in the cursor's graph merged with the entity for the same
+// URI in the ontology graph. Returns badger.ErrKeyNotFound if neither graph has
+// the entity
+func (c *Cursor) getLayeredEntity(key EntityKey) (*Entity, error) {
+	var own *Entity
+	shared := key
+	if key.Graph != c.ontology {
+		entity, err := c.hod.getEntityAt(key, c.key.version())
+		if err == nil {
+			own = entity
+		} else if err != badger.ErrKeyNotFound {
+			return nil, err
+		}
+		uri, found := c.hod.getURI(key)
+		if found {
+			shared, found = c.hod.lookupURI(ontologyGraph, uri)
+		}
+		if !found {
+			return entity, err
+		}
+	}
+
+	entity, err := c.hod.getEntityAt(shared, c.ontologyVersion)
+	if err == badger.ErrKeyNotFound && own != nil {
+		return own, nil
+	} else if err != nil {
+		return nil, err
+	}
+	merged := newEntity(key.unversioned())
+	if own != nil {
+		own.FromCompiled()
+		merged = own
+	}
+	entity.FromCompiled()
+	for pred, subjects := range entity.inedge {
+		for subject, pattern := range subjects {
+			merged.addInEdge(c.canonicalKey(pred), c.canonicalKey(subject), pattern)
+		}
+	}
+	for pred, objects := range entity.outedge {
+		for object, pattern := range objects {
+			merged.addOutEdge(c.canonicalKey(pred), c.canonicalKey(object), pattern)
+		}
+	}
+	for endpoints := range entity.endpoints {
+		merged.addEndpoints(c.canonicalKey(endpoints[0]), c.canonicalKey(endpoints[1]))
+	}
+	merged.Compile()
+	return merged, nil
+}
+
+// calls f on each entity of the cursor's graph, merged with the ontology
+// graph's, and then on each entity that only the ontology graph has, stopping
+// early if f returns true
+func (c *Cursor) iterLayeredEntities(f func(EntityKey, *Entity) bool) error {
+	seen := newEntitySet()
+	var err error
+	var stopped bool
+	visit := func(key EntityKey, _ *Entity) bool {
+		key = c.canonicalKey(key)
+		if seen.addIfNotHas(key) {
+			return false
+		}
+		var entity *Entity
+		if entity, err = c.getEntity(key); err != nil {
+			return true
+		}
+		stopped = f(key, entity)
+		return stopped
+	}
+	if iterErr := c.Iterate(visit); iterErr != nil {
+		return iterErr
+	} else if err != nil || stopped {
+		return err
+	}
+	shared, iterErr := c.hod.Cursor(ontologyGraph)
+	if iterErr != nil {
+		return iterErr
+	}
+	shared.key = shared.key.atVersion(c.ontologyVersion)
+	if iterErr := shared.Iterate(visit); iterErr != nil {
+		return iterErr
+	}
+	return err
+}
@@ -0,0 +1,166 @@
+package hod
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOntologyGraph(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	defer hod.Close()
+
+	fancy := filepath.Join(dir, "fancy.ttl")
+	require.NoError(ioutil.WriteFile(fancy, []byte(`
+@prefix bldg: <http://buildsys.org/ontologies/building_example#> .
+@prefix brick: <https://brickschema.org/schema/1.1/Brick#> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+bldg:fancy_1 a bldg:Fancy_AHU .
+bldg:Fancy_AHU rdfs:subClassOf brick:AHU .
+`), 0600))
+	ontologies := []string{"Brick.ttl", "BrickFrame.ttl"}
+	require.NoError(hod.Load(FileBundle{GraphName: "building", TTLFile: "example.ttl", OntologyFiles: ontologies}))
+	require.NoError(hod.Load(FileBundle{GraphName: "fancy", TTLFile: fancy, OntologyFiles: ontologies}))
+
+	// the ontologies are loaded once, and not into the buildings
+	versions := func(graph string) int {
+		entries, err := hod.listVersions(graph, 0, 0, 0)
+		require.NoError(err)
+		return len(entries)
+	}
+	require.Equal(1, versions(ontologyGraph))
+	hvac := turtle.URI{Namespace: "https://brickschema.org/schema/1.1/Brick", Value: "HVAC"}
+	chiller := turtle.URI{Namespace: "https://brickschema.org/schema/1.1/Brick", Value: "Chiller"}
+	_, found := hod.lookupURI("building", chiller)
+	require.False(found)
+	_, found = hod.lookupURI(ontologyGraph, chiller)
+	require.True(found)
+	_, buildingEntities, err := hod.graphCounts("building")
+	require.NoError(err)
+	_, ontologyEntities, err := hod.graphCounts(ontologyGraph)
+	require.NoError(err)
+	require.True(buildingEntities < 60, buildingEntities)
+	require.True(ontologyEntities > 1000, ontologyEntities)
+
+	// but queries against the buildings see them
+	require.Equal([]string{"ahu_1", "vav_1"}, queryValues(t, hod, "SELECT ?x FROM building WHERE { ?x rdf:type/rdfs:subClassOf* brick:HVAC }"))
+	require.Equal([]string{"fancy_1"}, queryValues(t, hod, "SELECT ?x FROM fancy WHERE { ?x rdf:type/rdfs:subClassOf* brick:Equipment }"))
+	require.Equal([]string{"Air_Handler_Unit", "Equipment", "HVAC", "Heating_Ventilation_Air_Conditioning_System", "TagSet"}, queryValues(t, hod, "SELECT ?c FROM fancy WHERE { bldg:Fancy_AHU rdfs:subClassOf+ ?c . FILTER(?c != brick:AHU) }"))
+	require.Contains(queryValues(t, hod, "SELECT ?c FROM building WHERE { ?c rdfs:subClassOf+ brick:HVAC }"), "AHU")
+	require.Equal([]string{"hvaczone_1"}, queryValues(t, hod, "SELECT ?z FROM building WHERE { ?z rdf:type ?c . ?c rdfs:label \"HVAC Zone\"@en }"))
+	require.Contains(queryValues(t, hod, "SELECT ?s FROM building WHERE { ?s ?p brick:HVAC }"), "AHU")
+	require.Equal(queryValues(t, hod, "SELECT ?c FROM _ontology_ WHERE { ?c rdfs:subClassOf brick:HVAC }"),
+		queryValues(t, hod, "SELECT ?c FROM building WHERE { ?c rdfs:subClassOf brick:HVAC }"))
+
+	// the ontology graph can not be written to, and is not one of all graphs
+	err = hod.AddTriples(ontologyGraph, turtle.DataSet{Triples: []turtle.Triple{{Subject: hvac, Predicate: hvac, Object: hvac}}})
+	require.Equal(ErrReadOnlyGraph, errors.Cause(err))
+	err = hod.DropGraph(&logpb.DropGraphRequest{Graph: ontologyGraph}, &progressRecorder{ctx: context.Background()})
+	require.Equal(codes.FailedPrecondition, status.Code(statusError(err)))
+	graphs, err := hod.resolveGraphs(context.Background(), nil)
+	require.NoError(err)
+	require.Equal([]string{"building", "fancy"}, graphs)
+
+	// new and changed ontology files are applied to the ontology graph
+	extension := filepath.Join(dir, "extension.ttl")
+	require.NoError(ioutil.WriteFile(extension, []byte(`
+@prefix brick: <https://brickschema.org/schema/1.1/Brick#> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+brick:Rooftop_Unit rdfs:subClassOf brick:AHU .
+`), 0600))
+	bundle := FileBundle{GraphName: "building", TTLFile: "example.ttl", OntologyFiles: append(ontologies, extension)}
+	subclasses := "SELECT ?c FROM building WHERE { ?c rdfs:subClassOf brick:AHU }"
+	before := queryValues(t, hod, subclasses)
+	require.NoError(hod.Load(bundle))
+	require.Equal(2, versions(ontologyGraph))
+	require.Contains(queryValues(t, hod, subclasses), "Rooftop_Unit")
+	require.NoError(ioutil.WriteFile(extension, []byte("@prefix brick: <https://brickschema.org/schema/1.1/Brick#> .\n"), 0600))
+	require.NoError(hod.Load(bundle))
+	require.Equal(3, versions(ontologyGraph))
+	// the other files are still loaded
+	require.Equal(before, queryValues(t, hod, subclasses))
+}
+
+func TestOntologyChange(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+
+	building := filepath.Join(dir, "building.ttl")
+	require.NoError(ioutil.WriteFile(building, []byte(`
+@prefix bldg: <http://buildsys.org/ontologies/building_example#> .
+bldg:fancy_1 a bldg:Fancy_Equipment ;
+    bldg:serves bldg:room_1 .
+`), 0600))
+	extension := filepath.Join(dir, "extension.ttl")
+	writeExtension := func(class, inverse string) {
+		require.NoError(ioutil.WriteFile(extension, []byte(fmt.Sprintf(`
+@prefix bldg: <http://buildsys.org/ontologies/building_example#> .
+@prefix brick: <https://brickschema.org/schema/1.1/Brick#> .
+@prefix owl: <http://www.w3.org/2002/07/owl#> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+bldg:Fancy_Equipment rdfs:subClassOf brick:%s .
+bldg:serves owl:inverseOf bldg:%s .
+`, class, inverse)), 0600))
+	}
+	writeExtension("AHU", "servedBy")
+	bundle := FileBundle{GraphName: "building", TTLFile: building, OntologyFiles: []string{"Brick.ttl", extension}}
+	require.NoError(hod.Load(bundle))
+
+	types := "SELECT ?x FROM building WHERE { ?x rdf:type brick:%s }"
+	require.Equal([]string{"fancy_1"}, queryValues(t, hod, fmt.Sprintf(types, "AHU")))
+	require.Equal([]string{"fancy_1"}, queryValues(t, hod, fmt.Sprintf(types, "Air_Handler_Unit")))
+	require.Equal([]string{"fancy_1"}, queryValues(t, hod, fmt.Sprintf(types, "Equipment")))
+	require.Empty(queryValues(t, hod, fmt.Sprintf(types, "Chiller")))
+	servedBy := "SELECT ?x FROM building WHERE { bldg:room_1 bldg:%s ?x }"
+	require.Equal([]string{"fancy_1"}, queryValues(t, hod, fmt.Sprintf(servedBy, "servedBy")))
+
+	// what the building inferred from the old superclass is retracted, unless
+	// the new one still supports it, and what the new one entails is inferred
+	writeExtension("Chiller", "isServedBy")
+	require.NoError(hod.Load(bundle))
+	require.Empty(queryValues(t, hod, fmt.Sprintf(types, "AHU")))
+	require.Empty(queryValues(t, hod, fmt.Sprintf(types, "Air_Handler_Unit")))
+	require.Equal([]string{"fancy_1"}, queryValues(t, hod, fmt.Sprintf(types, "Chiller")))
+	require.Equal([]string{"fancy_1"}, queryValues(t, hod, fmt.Sprintf(types, "Equipment")))
+	require.Empty(queryValues(t, hod, fmt.Sprintf(servedBy, "servedBy")))
+	require.Equal([]string{"fancy_1"}, queryValues(t, hod, fmt.Sprintf(servedBy, "isServedBy")))
+	require.NoError(hod.Close())
+
+	// also once reopened
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "reopen log")
+	defer hod.Close()
+	require.Equal([]string{"fancy_1"}, queryValues(t, hod, fmt.Sprintf(types, "Chiller")))
+	require.Empty(queryValues(t, hod, fmt.Sprintf(types, "AHU")))
+}
@@ -126,8 +126,9 @@ func TestOperators(t *testing.T) {
 	qt = makeQueryTerm(cursor, makeTriple("?v1", RDF_TYPE, "?v2"))
 	rso := &restrictSubjectObjectByPredicate{term: *qt}
 	require.NoError(rso.run(cursor), "run restrictSubjectObjectByPredicate 1")
-	// TODO: this is 62 rather than 6 because we are loading in BrickFrame.ttl
-	require.Equal(62, len(cursor.rel.rows))
+	// BrickFrame.ttl is loaded into the ontology graph, not this one, but the
+	// types it implies are: vav_1 and ztemp_1 are also bf:Point and bf:Taggable
+	require.Equal(10, len(cursor.rel.rows))
 
 	cursor, err = hod.Cursor("example")
 	require.NoError(err, "create cursor")
@@ -135,7 +136,7 @@ func TestOperators(t *testing.T) {
 	qt = makeQueryTerm(cursor, makeTriple("?v1", BF_ISPARTOF, "?v2"))
 	rso = &restrictSubjectObjectByPredicate{term: *qt}
 	require.NoError(rso.run(cursor), "run restrictSubjectObjectByPredicate 1")
-	require.Equal(4, len(cursor.rel.rows))
+	require.Equal(5, len(cursor.rel.rows))
 
 	// case 2 (?s > 0, ?o = 0)
 	cursor, err = hod.Cursor("example")
@@ -149,12 +150,12 @@ func TestOperators(t *testing.T) {
 
 	// puts HVACZONE_1 into "?v2"
 	// this resolves room_1 partof hvaczone_1 and room_1 partof floor_1
-	// and floor_1 ispartof building_1
-	// the join should keep both rows for room_1
+	// and floor_1 ispartof building_1, so room_1 ispartof building_1
+	// the join should keep all three rows for room_1
 	qt = makeQueryTerm(cursor, makeTriple("?v1", BF_ISPARTOF, "?v2"))
 	rso = &restrictSubjectObjectByPredicate{term: *qt}
 	require.NoError(rso.run(cursor), "join on ?v1")
-	require.Equal(2, len(cursor.rel.rows))
+	require.Equal(3, len(cursor.rel.rows))
 
 	// case 3 (?s = 0, ?o > 0)
 	cursor, err = hod.Cursor("example")
@@ -163,7 +164,7 @@ func TestOperators(t *testing.T) {
 	qt = makeQueryTerm(cursor, makeTriple(ROOM_1, BF_ISPARTOF, "?v1"))
 	ro = &resolveObject{term: *qt}
 	require.NoError(ro.run(cursor), "define ?v1")
-	require.Equal(2, len(cursor.rel.rows))
+	require.Equal(3, len(cursor.rel.rows))
 
 	// this should return room1 partof floor1 and floor1 partof building
 	// we are joining on floor1, so we should get the floor1, building1 row
@@ -181,7 +182,7 @@ func TestOperators(t *testing.T) {
 	qt = makeQueryTerm(cursor, makeTriple("?v1", BF_ISPARTOF, "?v2"))
 	rso = &restrictSubjectObjectByPredicate{term: *qt}
 	require.NoError(rso.run(cursor), "run restrictSubjectObjectByPredicate 1")
-	require.Equal(4, len(cursor.rel.rows))
+	require.Equal(5, len(cursor.rel.rows))
 
 	qt = makeQueryTerm(cursor, makeTriple("?v1", BF_ISPOINTOF, "?v2"))
 	rso = &restrictSubjectObjectByPredicate{term: *qt}
@@ -218,8 +219,12 @@ func TestOperators(t *testing.T) {
 	qt = makeQueryTerm(cursor, makeTriple("?v1", RDF_TYPE, "?v2"))
 	rovs := &resolveObjectFromVarSubject{term: *qt}
 	require.NoError(rovs.run(cursor), "resolveObjectFromVarSubject")
-	require.Equal(1, len(cursor.rel.rows))
-	require.Equal(cursor.ContextualizeURI(stringtoURI(BRICK_VAV)), cursor.rel.rows[0].valueAt(1))
+	require.Equal(3, len(cursor.rel.rows))
+	var types []EntityKey
+	for _, row := range cursor.rel.rows {
+		types = append(types, row.valueAt(1))
+	}
+	require.Contains(types, cursor.ContextualizeURI(stringtoURI(BRICK_VAV)))
 
 	////// resolveSubjectPredFromObject
 	// case 1: ?s = 0, ?p = 0
@@ -325,7 +330,7 @@ func TestOperators(t *testing.T) {
 	qt = makeQueryTerm(cursor, makeTriple(ROOM_1, "?v1", "?v2"))
 	pos := &resolvePredObjectFromSubject{term: *qt}
 	require.NoError(pos.run(cursor), "run resolvePredObjectFromSubject")
-	require.Equal(4, len(cursor.rel.rows))
+	require.Equal(5, len(cursor.rel.rows))
 
 	// case 2: ?p >0, ?o = 0
 	// ROOM_1 ?p BRICK_ROOM
@@ -383,7 +388,7 @@ func TestOperators(t *testing.T) {
 	qt = makeQueryTerm(cursor, makeTriple("?v1", "?v2", "?v3"))
 	vtfs := &resolveVarTripleFromSubject{term: *qt}
 	require.NoError(vtfs.run(cursor), "run resolveVarTripleFromSubject")
-	require.Equal(4, len(cursor.rel.rows))
+	require.Equal(5, len(cursor.rel.rows))
 
 	// resolveVarTripleFromObject
 	cursor, err = hod.Cursor("example")
@@ -165,7 +165,17 @@ func (rso *restrictSubjectObjectByPredicate) run(cursor *Cursor) error {
 		cursor.rel.join(rsopRelation, []string{objectVar}, cursor)
 	} else {
 		// TODO: need to merge in WITHOUT replacing other values
-		cursor.rel.rows = rsopRelation.rows
+		// the values go in the positions the cursor's relation has for the
+		// variables, and are indexed so that later terms can join on them
+		cursor.rel.rows = cursor.rel.rows[:0]
+		for _, row := range rsopRelation.rows {
+			newRow := newRelationRow()
+			newRow.addValue(cursor.rel.vars[subjectVar], row.valueAt(rsopRelation.vars[subjectVar]))
+			newRow.addValue(cursor.rel.vars[objectVar], row.valueAt(rsopRelation.vars[objectVar]))
+			cursor.rel.rows = append(cursor.rel.rows, newRow)
+			row.release()
+		}
+		cursor.rel.reindex()
 	}
 	return nil
 }
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	rdf "github.com/gtfierro/hoddb/turtle/rdfparser"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -21,7 +24,11 @@ var example_graph_test_cases = []struct {
 	},
 	{
 		"SELECT ?x FROM test WHERE { bldg:room_1 rdf:type ?x }",
-		[][]*logpb.URI{{stringtoURI("https://brickschema.org/schema/1.1/Brick#Room")}},
+		[][]*logpb.URI{
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#Room")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#Location")},
+			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#TagSet")},
+		},
 	},
 	{
 		"SELECT ?x FROM test WHERE { bldg:room_1 ?x brick:Room }",
@@ -39,7 +46,10 @@ var example_graph_test_cases = []struct {
 		[][]*logpb.URI{
 			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#isPartOf"), stringtoURI("http://buildsys.org/ontologies/building_example#floor_1")},
 			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#isPartOf"), stringtoURI("http://buildsys.org/ontologies/building_example#hvaczone_1")},
+			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#isPartOf"), stringtoURI("http://buildsys.org/ontologies/building_example#building_1")},
 			{stringtoURI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type"), stringtoURI("https://brickschema.org/schema/1.1/Brick#Room")},
+			{stringtoURI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type"), stringtoURI("https://brickschema.org/schema/1.1/Brick#Location")},
+			{stringtoURI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type"), stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#TagSet")},
 			{stringtoURI("http://www.w3.org/2000/01/rdf-schema#label"), &logpb.URI{Value: "Room 1"}},
 		},
 	},
@@ -48,7 +58,10 @@ var example_graph_test_cases = []struct {
 		[][]*logpb.URI{
 			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#isPartOf"), stringtoURI("http://buildsys.org/ontologies/building_example#floor_1")},
 			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#isPartOf"), stringtoURI("http://buildsys.org/ontologies/building_example#hvaczone_1")},
+			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#isPartOf"), stringtoURI("http://buildsys.org/ontologies/building_example#building_1")},
 			{stringtoURI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type"), stringtoURI("https://brickschema.org/schema/1.1/Brick#Room")},
+			{stringtoURI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type"), stringtoURI("https://brickschema.org/schema/1.1/Brick#Location")},
+			{stringtoURI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type"), stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#TagSet")},
 			{stringtoURI("http://www.w3.org/2000/01/rdf-schema#label"), &logpb.URI{Value: "Room 1"}},
 		},
 	},
@@ -57,6 +70,7 @@ var example_graph_test_cases = []struct {
 		[][]*logpb.URI{
 			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#hasPart"), stringtoURI("http://buildsys.org/ontologies/building_example#floor_1")},
 			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#hasPart"), stringtoURI("http://buildsys.org/ontologies/building_example#hvaczone_1")},
+			{stringtoURI("https://brickschema.org/schema/1.1/BrickFrame#hasPart"), stringtoURI("http://buildsys.org/ontologies/building_example#building_1")},
 		},
 	},
 	////		{
@@ -120,6 +134,7 @@ var example_graph_test_cases = []struct {
 	},
 	{
 		"SELECT ?s ?p FROM test WHERE { ?s ?p brick:Zone_Temperature_Sensor . ?s rdfs:subClassOf brick:Zone_Temperature_Sensor }",
+		// subclasses are transitive, so this includes the subclasses of subclasses
 		[][]*logpb.URI{
 			{stringtoURI("https://brickschema.org/schema/1.1/Brick#Average_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
 			{stringtoURI("https://brickschema.org/schema/1.1/Brick#Coldest_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
@@ -130,8 +145,96 @@ var example_graph_test_cases = []struct {
 			{stringtoURI("https://brickschema.org/schema/1.1/Brick#AHU_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
 			{stringtoURI("https://brickschema.org/schema/1.1/Brick#FCU_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
 			{stringtoURI("https://brickschema.org/schema/1.1/Brick#Zone_Air_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#AHU_Average_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#AHU_Coldest_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#AHU_Highest_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#AHU_Lowest_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#AHU_Warmest_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#AHU_Zone_Air_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#HVAC_Average_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#HVAC_Coldest_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#HVAC_Highest_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#HVAC_Lowest_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#HVAC_Warmest_Zone_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#FCU_Zone_Air_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
+			{stringtoURI("https://brickschema.org/schema/1.1/Brick#VAV_Zone_Air_Temperature_Sensor"), stringtoURI("http://www.w3.org/2000/01/rdf-schema#subClassOf")},
 		},
 	},
+	{
+		"SELECT ?x FROM test WHERE { { ?x rdf:type brick:AHU } UNION { ?x rdf:type brick:VAV } }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1")}, {stringtoURI("http://buildsys.org/ontologies/building_example#vav_1")}},
+	},
+	{
+		"SELECT ?x ?y FROM test WHERE { ?x bf:feeds ?y . { ?x rdf:type brick:AHU } UNION { ?y rdf:type brick:HVAC_Zone } }",
+		[][]*logpb.URI{
+			{stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1"), stringtoURI("http://buildsys.org/ontologies/building_example#vav_1")},
+			{stringtoURI("http://buildsys.org/ontologies/building_example#vav_1"), stringtoURI("http://buildsys.org/ontologies/building_example#hvaczone_1")},
+		},
+	},
+	{
+		"SELECT ?x ?y FROM test WHERE { { ?x rdf:type brick:Room } UNION { ?x bf:feeds ?y } }",
+		[][]*logpb.URI{
+			{stringtoURI("http://buildsys.org/ontologies/building_example#room_1"), &logpb.URI{}},
+			{stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1"), stringtoURI("http://buildsys.org/ontologies/building_example#vav_1")},
+			{stringtoURI("http://buildsys.org/ontologies/building_example#vav_1"), stringtoURI("http://buildsys.org/ontologies/building_example#hvaczone_1")},
+		},
+	},
+	{
+		"SELECT ?x FROM test WHERE { { ?x rdf:type brick:AHU } UNION { ?x rdf:type brick:VAV } . { ?x bf:feeds bldg:vav_1 } UNION { ?x rdf:type brick:Room } }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1")}},
+	},
+	{
+		"SELECT DISTINCT ?x FROM test WHERE { { { ?x bf:feeds ?y } . ?y rdf:type ?t { ?y bf:feeds ?z } . ?z rdf:type brick:HVAC_Zone } }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1")}},
+	},
+	{
+		"SELECT ?x FROM test WHERE { ?x rdf:type brick:VAV . { ?x bf:feeds ?y } }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#vav_1")}},
+	},
+	{
+		"SELECT ?x ?y FROM test WHERE { ?x rdf:type brick:Room . OPTIONAL { ?x bf:feeds ?y } }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#room_1"), &logpb.URI{}}},
+	},
+	{
+		"SELECT ?x ?f FROM test WHERE { ?x rdf:type brick:VAV . OPTIONAL { ?f bf:feeds ?x . ?f rdf:type brick:AHU } }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#vav_1"), stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1")}},
+	},
+	{
+		"SELECT ?x ?z FROM test WHERE { { ?x rdf:type brick:AHU } UNION { ?x rdf:type brick:VAV } OPTIONAL { ?x bf:feeds ?z . ?z rdf:type brick:HVAC_Zone } }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1"), &logpb.URI{}}, {stringtoURI("http://buildsys.org/ontologies/building_example#vav_1"), stringtoURI("http://buildsys.org/ontologies/building_example#hvaczone_1")}},
+	},
+	{
+		"SELECT ?x ?y ?l FROM test WHERE { ?x rdf:type brick:AHU OPTIONAL { ?x bf:feeds ?y OPTIONAL { ?y rdfs:label ?l } } }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1"), stringtoURI("http://buildsys.org/ontologies/building_example#vav_1"), &logpb.URI{}}},
+	},
+	{
+		"SELECT DISTINCT ?x FROM test WHERE { ?x rdf:type ?t . FILTER(CONTAINS(?x, \"vav\")) }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#vav_1")}},
+	},
+	{
+		"SELECT ?x ?l FROM test WHERE { ?x rdfs:label ?l FILTER(regex(?l, \"^room [0-9]\", \"i\") && isLiteral(?l)) }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#room_1"), &logpb.URI{Value: "Room 1"}}},
+	},
+	{
+		"SELECT ?x ?y FROM test WHERE { ?x bf:feeds ?y FILTER(?x = bldg:ahu_1) }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1"), stringtoURI("http://buildsys.org/ontologies/building_example#vav_1")}},
+	},
+	{
+		"SELECT ?x ?y FROM test WHERE { ?x bf:feeds ?y FILTER(?x != <http://buildsys.org/ontologies/building_example#ahu_1>) }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#vav_1"), stringtoURI("http://buildsys.org/ontologies/building_example#hvaczone_1")}},
+	},
+	{
+		"SELECT ?x ?y FROM test WHERE { ?x bf:feeds ?y FILTER(STRSTARTS(?y, \"http://buildsys.org/ontologies/building_example#vav\")) }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#ahu_1"), stringtoURI("http://buildsys.org/ontologies/building_example#vav_1")}},
+	},
+	{
+		"SELECT ?x ?y FROM test WHERE { ?x bf:isPartOf ?y FILTER(isURI(?y) && ?x != bldg:room_1) }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#floor_1"), stringtoURI("http://buildsys.org/ontologies/building_example#building_1")}, {stringtoURI("http://buildsys.org/ontologies/building_example#ztemp_1"), stringtoURI("http://buildsys.org/ontologies/building_example#vav_1")}},
+	},
+	{
+		"SELECT ?x ?y FROM test WHERE { { ?x rdf:type brick:AHU } UNION { ?x rdf:type brick:Room } OPTIONAL { ?x bf:feeds ?y } FILTER(!BOUND(?y)) }",
+		[][]*logpb.URI{{stringtoURI("http://buildsys.org/ontologies/building_example#room_1"), &logpb.URI{}}},
+	},
 }
 
 var berkeley_graph_test_cases = []struct {
@@ -190,10 +293,10 @@ var berkeley_graph_test_cases = []struct {
 		"COUNT ?sensor ?room FROM soda WHERE { ?sensor rdf:type/rdfs:subClassOf* brick:Zone_Temperature_Sensor . ?room rdf:type brick:Room . ?vav rdf:type brick:VAV . ?zone rdf:type brick:HVAC_Zone . ?vav bf:feeds+ ?zone . ?zone bf:hasPart ?room . ?sensor bf:isPointOf ?vav }",
 		232,
 	},
-	//{
-	//	"COUNT ?sensor ?room FROM soda WHERE { ?sensor rdf:type/rdfs:subClassOf* brick:Zone_Temperature_Sensor . ?vav rdf:type brick:VAV . ?zone rdf:type brick:HVAC_Zone . ?room rdf:type brick:Room . ?vav bf:feeds+ ?zone . ?zone bf:hasPart ?room  { ?sensor bf:isPointOf ?vav } UNION { ?sensor bf:isPointOf ?room } }",
-	//	232,
-	//},
+	{
+		"COUNT ?sensor ?room FROM soda WHERE { ?sensor rdf:type/rdfs:subClassOf* brick:Zone_Temperature_Sensor . ?vav rdf:type brick:VAV . ?zone rdf:type brick:HVAC_Zone . ?room rdf:type brick:Room . ?vav bf:feeds+ ?zone . ?zone bf:hasPart ?room  { ?sensor bf:isPointOf ?vav } UNION { ?sensor bf:isPointOf ?room } }",
+		232,
+	},
 	{
 		"COUNT ?sensor ?room FROM soda WHERE { ?sensor rdf:type/rdfs:subClassOf* brick:Zone_Temperature_Sensor . ?room rdf:type brick:Room . ?vav rdf:type brick:VAV . ?zone rdf:type brick:HVAC_Zone . ?vav bf:feeds+ ?zone . ?zone bf:hasPart ?room . ?sensor bf:isPointOf ?room }",
 		0,
@@ -222,9 +325,10 @@ var berkeley_graph_test_cases = []struct {
 		"COUNT ?building FROM soda WHERE { ?building rdfs:label \"Soda Hall\" }",
 		1,
 	},
+	// including the triples inferred from the ontology
 	{
 		"COUNT ?s ?p ?o FROM soda WHERE {?s ?p ?o}",
-		13967,
+		33530,
 	},
 }
 
@@ -273,6 +377,449 @@ database:
 	}
 }
 
+func TestQueryOptional(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+
+	// rows without a match for the optional group are kept, with ?z left empty
+	q, err := hod.ParseQuery("SELECT ?y ?z FROM test WHERE { ?x bf:isPartOf ?y . OPTIONAL { ?y bf:feeds ?z } }", 0)
+	require.NoError(err)
+	resp, err := hod.Select(context.Background(), q)
+	require.NoError(err)
+	// floor_1 and room_1 are both part of building_1
+	require.Equal(5, len(resp.Rows))
+	fed := make(map[string]string)
+	for _, row := range resp.Rows {
+		require.Equal(2, len(row.Values))
+		fed[row.Values[0].Value] = row.Values[1].Value
+	}
+	require.Equal(map[string]string{"floor_1": "", "building_1": "", "hvaczone_1": "", "vav_1": "hvaczone_1"}, fed)
+}
+
+func TestQueryFilter(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+
+	iq, err := hod.ParseInsertQuery(`INSERT { bldg:room_1 bf:area "20" . bldg:floor_1 bf:area "150" } TO test`)
+	require.NoError(err)
+	_, err = hod.Insert(context.Background(), iq)
+	require.NoError(err)
+
+	for _, test := range []struct {
+		query string
+		areas []string
+	}{
+		{`SELECT ?a FROM test WHERE { ?x bf:area ?a FILTER(?a > 100) }`, []string{"150"}},
+		{`SELECT ?a FROM test WHERE { ?x bf:area ?a FILTER(?a <= 20) }`, []string{"20"}},
+		{`SELECT ?a FROM test WHERE { ?x bf:area ?a FILTER(?a < 100 || ?a >= 150) }`, []string{"20", "150"}},
+		{`SELECT ?a FROM test WHERE { ?x bf:area ?a FILTER(?a > 10 && ?a < 100) }`, []string{"20"}},
+		{`SELECT ?a FROM test WHERE { ?x bf:area ?a FILTER(?a = "150.0") }`, []string{"150"}},
+		// comparisons with unbound variables are errors, which drop the row
+		{`SELECT ?a FROM test WHERE { ?x bf:area ?a FILTER(?b > 10) }`, nil},
+		{`SELECT ?a FROM test WHERE { ?x bf:area ?a FILTER(?b > 10 || ?a > 100) }`, []string{"150"}},
+	} {
+		q, err := hod.ParseQuery(test.query, 0)
+		require.NoError(err, test.query)
+		resp, err := hod.Select(context.Background(), q)
+		require.NoError(err, test.query)
+		var areas []string
+		for _, row := range resp.Rows {
+			areas = append(areas, row.Values[0].Value)
+		}
+		require.ElementsMatch(test.areas, areas, test.query)
+	}
+
+	q, err := hod.ParseQuery(`SELECT ?x FROM test WHERE { ?x bf:area ?a FILTER(regex(?x, "(")) }`, 0)
+	require.NoError(err)
+	_, err = hod.Select(context.Background(), q)
+	require.Error(err, "invalid regex")
+}
+
+func TestQueryLiterals(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NoError(hod.Load(FileBundle{GraphName: "test", TTLFile: "example.ttl", OntologyFiles: []string{"BrickFrame.ttl"}}))
+
+	ds, err := turtle.ParseReader(strings.NewReader(`
+@prefix bldg: <http://buildsys.org/ontologies/building_example#> .
+@prefix bf: <https://brickschema.org/schema/1.1/BrickFrame#> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+@prefix xsd: <http://www.w3.org/2001/XMLSchema#> .
+bldg:room_1 bf:hasValue "72.5"^^xsd:double ; rdfs:label "Room 101"@en ; bf:area 20 .
+bldg:room_2 bf:hasValue "65"^^xsd:integer ; bf:area "20" .
+`), rdf.Turtle)
+	require.NoError(err)
+	require.NoError(hod.AddTriples("test", ds))
+
+	xsd := turtle.XSD_NAMESPACE + "#"
+	values := func(query string) []*logpb.URI {
+		q, err := hod.ParseQuery(query, 0)
+		require.NoError(err, query)
+		resp, err := hod.Select(context.Background(), q)
+		require.NoError(err, query)
+		var values []*logpb.URI
+		for _, row := range resp.Rows {
+			values = append(values, row.Values[0])
+		}
+		return values
+	}
+	check := func() {
+		value := values("SELECT ?v FROM test WHERE { bldg:room_1 bf:hasValue ?v }")
+		require.Len(value, 1)
+		require.Equal("72.5", value[0].Value)
+		require.Equal(xsd+"double", value[0].Datatype)
+		label := values("SELECT ?l FROM test WHERE { bldg:room_1 rdfs:label ?l FILTER(?l != \"Room 1\") }")
+		require.Len(label, 1)
+		require.Equal("Room 101", label[0].Value)
+		require.Equal("en", label[0].Lang)
+		require.Empty(label[0].Datatype)
+	}
+	check()
+
+	// terms match if their value, datatype and language tag match
+	for _, test := range []struct {
+		query string
+		rooms []string
+	}{
+		{`SELECT ?x FROM test WHERE { ?x bf:hasValue "72.5"^^xsd:double }`, []string{"room_1"}},
+		{`SELECT ?x FROM test WHERE { ?x bf:hasValue "72.5"^^<http://www.w3.org/2001/XMLSchema#double> }`, []string{"room_1"}},
+		{`SELECT ?x FROM test WHERE { ?x bf:hasValue "72.5" }`, nil},
+		{`SELECT ?x FROM test WHERE { ?x rdfs:label "Room 101"@en }`, []string{"room_1"}},
+		{`SELECT ?x FROM test WHERE { ?x rdfs:label "Room 101" }`, nil},
+		{`SELECT ?x FROM test WHERE { ?x bf:area 20 }`, []string{"room_1"}},
+		{`SELECT ?x FROM test WHERE { ?x bf:area "20" }`, []string{"room_2"}},
+		// numeric literals compare as numbers, whatever their type
+		{`SELECT ?x FROM test WHERE { ?x bf:hasValue ?v FILTER(?v > 70) }`, []string{"room_1"}},
+		{`SELECT ?x FROM test WHERE { ?x bf:hasValue ?v FILTER(?v < 70.5 && isLiteral(?v)) }`, []string{"room_2"}},
+		{`SELECT ?x FROM test WHERE { ?x bf:hasValue ?v FILTER(?v = 6.5e1) }`, []string{"room_2"}},
+		{`SELECT ?x FROM test WHERE { ?x bf:area ?a FILTER(?a = 20) }`, []string{"room_1", "room_2"}},
+		{`SELECT ?x FROM test WHERE { ?x rdfs:label ?l FILTER(?l = "Room 101"@en) }`, []string{"room_1"}},
+	} {
+		var rooms []string
+		for _, uri := range values(test.query) {
+			rooms = append(rooms, uri.Value)
+		}
+		require.ElementsMatch(test.rooms, rooms, test.query)
+	}
+
+	// the dictionary keeps the datatypes and language tags
+	require.NoError(hod.Close())
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "reopen log")
+	defer hod.Close()
+	check()
+}
+
+func TestQuerySolutionModifiers(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+
+	iq, err := hod.ParseInsertQuery(`INSERT { bldg:room_1 bf:area "20" . bldg:floor_1 bf:area "150" . bldg:hvaczone_1 bf:area "9" } TO test`)
+	require.NoError(err)
+	_, err = hod.Insert(context.Background(), iq)
+	require.NoError(err)
+
+	for _, test := range []struct {
+		query  string
+		values []string
+	}{
+		{`SELECT DISTINCT ?x FROM test WHERE { ?x rdf:type ?t FILTER(STRSTARTS(?x, "http://buildsys.org/ontologies/building_example#")) } ORDER BY ?x`, []string{"ahu_1", "floor_1", "hvaczone_1", "room_1", "vav_1", "ztemp_1"}},
+		{`SELECT DISTINCT ?x FROM test WHERE { ?x rdf:type ?t FILTER(STRSTARTS(?x, "http://buildsys.org/ontologies/building_example#")) } ORDER BY ?x DESC`, []string{"ztemp_1", "vav_1", "room_1", "hvaczone_1", "floor_1", "ahu_1"}},
+		{`SELECT DISTINCT ?x FROM test WHERE { ?x rdf:type ?t FILTER(STRSTARTS(?x, "http://buildsys.org/ontologies/building_example#")) } ORDER BY ?x LIMIT 2`, []string{"ahu_1", "floor_1"}},
+		{`SELECT DISTINCT ?x FROM test WHERE { ?x rdf:type ?t FILTER(STRSTARTS(?x, "http://buildsys.org/ontologies/building_example#")) } ORDER BY ?x LIMIT 2 OFFSET 2`, []string{"hvaczone_1", "room_1"}},
+		{`SELECT DISTINCT ?x FROM test WHERE { ?x rdf:type ?t FILTER(STRSTARTS(?x, "http://buildsys.org/ontologies/building_example#")) } ORDER BY ?x OFFSET 4 LIMIT 10`, []string{"vav_1", "ztemp_1"}},
+		{`SELECT DISTINCT ?x FROM test WHERE { ?x rdf:type ?t FILTER(STRSTARTS(?x, "http://buildsys.org/ontologies/building_example#")) } ORDER BY ?x OFFSET 10`, nil},
+		// ordering on a variable that is not selected
+		{`SELECT ?x FROM test WHERE { ?x bf:area ?a } ORDER BY ?a`, []string{"hvaczone_1", "room_1", "floor_1"}},
+		{`SELECT ?a FROM test WHERE { ?x bf:area ?a } ORDER BY ?a DESC`, []string{"150", "20", "9"}},
+		{`SELECT ?x FROM test WHERE { ?x bf:area ?a } ORDER BY ?a LIMIT 1 AT now`, []string{"hvaczone_1"}},
+	} {
+		q, err := hod.ParseQuery(test.query, 0)
+		require.NoError(err, test.query)
+		resp, err := hod.Select(context.Background(), q)
+		require.NoError(err, test.query)
+		var values []string
+		for _, row := range resp.Rows {
+			require.Equal(1, len(row.Values), test.query)
+			values = append(values, row.Values[0].Value)
+		}
+		require.Equal(test.values, values, test.query)
+		require.Equal(int64(len(test.values)), resp.Count, test.query)
+	}
+
+	// without an ORDER BY, any rows can be returned
+	q, err := hod.ParseQuery(`SELECT DISTINCT ?x FROM test WHERE { ?x rdf:type ?t FILTER(STRSTARTS(?x, "http://buildsys.org/ontologies/building_example#")) } LIMIT 4`, 0)
+	require.NoError(err)
+	resp, err := hod.Select(context.Background(), q)
+	require.NoError(err)
+	require.Equal(4, len(resp.Rows))
+	q, err = hod.ParseQuery(`SELECT DISTINCT ?x FROM test WHERE { ?x rdf:type ?t FILTER(STRSTARTS(?x, "http://buildsys.org/ontologies/building_example#")) } OFFSET 4`, 0)
+	require.NoError(err)
+	resp, err = hod.Select(context.Background(), q)
+	require.NoError(err)
+	require.Equal(2, len(resp.Rows))
+}
+
+func TestQueryDistinct(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	defer hod.Close()
+	for _, graph := range []string{"test", "test2"} {
+		require.NoError(hod.Load(FileBundle{GraphName: graph, TTLFile: "example.ttl", OntologyFiles: []string{"BrickFrame.ttl"}}))
+	}
+
+	// values that differ only in their datatype or language are not duplicates
+	iq, err := hod.ParseInsertQuery(`INSERT { bldg:room_1 bf:area "20" . bldg:floor_1 bf:area "20" . bldg:vav_1 bf:area "20"@en . bldg:hvaczone_1 bf:area "20"^^xsd:integer } TO test`)
+	require.NoError(err)
+	_, err = hod.Insert(context.Background(), iq)
+	require.NoError(err)
+
+	for _, test := range []struct {
+		query  string
+		values []string
+	}{
+		// room_1 is part of floor_1, hvaczone_1 and, through floor_1, building_1
+		{`SELECT ?x FROM test WHERE { ?x bf:isPartOf ?p }`, []string{"floor_1", "room_1", "room_1", "room_1", "ztemp_1"}},
+		{`SELECT DISTINCT ?x FROM test WHERE { ?x bf:isPartOf ?p }`, []string{"floor_1", "room_1", "ztemp_1"}},
+		{`SELECT REDUCED ?x FROM test WHERE { ?x bf:isPartOf ?p }`, []string{"floor_1", "room_1", "ztemp_1"}},
+		{`SELECT DISTINCT ?x ?p FROM test WHERE { ?x bf:isPartOf ?p }`, []string{"floor_1", "room_1", "room_1", "room_1", "ztemp_1"}},
+		{`SELECT ?x FROM test WHERE { ?x bf:isPartOf ?p } ORDER BY ?p ?x`, []string{"floor_1", "room_1", "room_1", "room_1", "ztemp_1"}},
+		{`SELECT DISTINCT ?x FROM test WHERE { ?x bf:isPartOf ?p } ORDER BY ?p`, []string{"floor_1", "room_1", "ztemp_1"}},
+		// UNION branches and graphs each add their rows
+		{`SELECT ?x FROM test WHERE { { ?x rdf:type brick:Room } UNION { ?x rdfs:label "Room 1" } }`, []string{"room_1", "room_1"}},
+		{`SELECT DISTINCT ?x FROM test WHERE { { ?x rdf:type brick:Room } UNION { ?x rdfs:label "Room 1" } }`, []string{"room_1"}},
+		{`SELECT ?x FROM test test2 WHERE { ?x rdf:type brick:Room }`, []string{"room_1", "room_1"}},
+		{`SELECT DISTINCT ?x FROM test test2 WHERE { ?x rdf:type brick:Room }`, []string{"room_1"}},
+		{`SELECT DISTINCT ?a FROM test WHERE { ?x bf:area ?a }`, []string{"20", "20", "20"}},
+	} {
+		require.Equal(test.values, queryValues(t, hod, test.query), test.query)
+
+		q, err := hod.ParseQuery(test.query, 0)
+		require.NoError(err, test.query)
+		resp, err := hod.Count(context.Background(), q)
+		require.NoError(err, test.query)
+		require.Equal(int64(len(test.values)), resp.Count, test.query)
+	}
+
+	q, err := hod.ParseQuery(`SELECT DISTINCT ?a FROM test WHERE { ?x bf:area ?a }`, 0)
+	require.NoError(err)
+	resp, err := hod.Select(context.Background(), q)
+	require.NoError(err)
+	var areas []string
+	for _, row := range resp.Rows {
+		areas = append(areas, row.Values[0].Value+"^^"+row.Values[0].Datatype+"@"+row.Values[0].Lang)
+	}
+	require.ElementsMatch([]string{"20^^@", "20^^@en", "20^^http://www.w3.org/2001/XMLSchema#integer@"}, areas)
+}
+
+func TestQueryAggregates(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+
+	iq, err := hod.ParseInsertQuery(`INSERT { bldg:room_2 rdf:type brick:Room . bldg:room_2 bf:isPartOf bldg:floor_1 .
+		bldg:room_3 rdf:type brick:Room . bldg:room_3 bf:isPartOf bldg:floor_1 .
+		bldg:room_1 bf:area "20" . bldg:room_2 bf:area "35" . bldg:room_3 bf:area "9" } TO test`)
+	require.NoError(err)
+	_, err = hod.Insert(context.Background(), iq)
+	require.NoError(err)
+
+	for _, test := range []struct {
+		query string
+		rows  [][]string
+	}{
+		{
+			`SELECT ?f (COUNT(?r) AS ?n) FROM test WHERE { ?r rdf:type brick:Room . ?r bf:isPartOf ?f } GROUP BY ?f ORDER BY ?n DESC ?f`,
+			[][]string{{"building_1", "3"}, {"floor_1", "3"}, {"hvaczone_1", "1"}},
+		},
+		{
+			`SELECT (COUNT(?f) AS ?n) (COUNT(DISTINCT ?r) AS ?rooms) FROM test WHERE { ?r rdf:type brick:Room . ?r bf:isPartOf ?f }`,
+			[][]string{{"7", "3"}},
+		},
+		{
+			`SELECT (COUNT(*) AS ?n) FROM test WHERE { ?r rdf:type brick:Room }`,
+			[][]string{{"3"}},
+		},
+		{
+			`SELECT (MIN(?a) AS ?min) (MAX(?a) AS ?max) FROM test WHERE { ?r bf:area ?a }`,
+			[][]string{{"9", "35"}},
+		},
+		{
+			`SELECT (MAX(?r) AS ?last) (GROUP_CONCAT(DISTINCT ?r ; SEPARATOR=",") AS ?rooms) FROM test WHERE { ?r rdf:type brick:Room . ?r bf:isPartOf bldg:hvaczone_1 }`,
+			[][]string{{"room_1", "http://buildsys.org/ontologies/building_example#room_1"}},
+		},
+		{
+			`SELECT ?f FROM test WHERE { ?r rdf:type brick:Room . ?r bf:isPartOf ?f } GROUP BY ?f ORDER BY ?f`,
+			[][]string{{"building_1"}, {"floor_1"}, {"hvaczone_1"}},
+		},
+		{
+			`SELECT ?f (COUNT(?r) AS ?n) FROM test WHERE { ?r rdf:type brick:Room . ?r bf:isPartOf ?f } GROUP BY ?f ORDER BY ?n LIMIT 1`,
+			[][]string{{"hvaczone_1", "1"}},
+		},
+		// aggregates with no GROUP BY have a row even if nothing matches
+		{
+			`SELECT (COUNT(?x) AS ?n) (SAMPLE(?x) AS ?s) FROM test WHERE { ?x rdf:type brick:Chiller }`,
+			[][]string{{"0", ""}},
+		},
+	} {
+		q, err := hod.ParseQuery(test.query, 0)
+		require.NoError(err, test.query)
+		resp, err := hod.Select(context.Background(), q)
+		require.NoError(err, test.query)
+		var rows [][]string
+		for _, row := range resp.Rows {
+			var values []string
+			for _, value := range row.Values {
+				values = append(values, value.Value)
+			}
+			rows = append(rows, values)
+		}
+		require.Equal(test.rows, rows, test.query)
+	}
+
+	q, err := hod.ParseQuery(`SELECT (GROUP_CONCAT(?a ; SEPARATOR=",") AS ?areas) FROM test WHERE { ?r bf:area ?a }`, 0)
+	require.NoError(err)
+	resp, err := hod.Select(context.Background(), q)
+	require.NoError(err)
+	require.Equal(1, len(resp.Rows))
+	require.ElementsMatch([]string{"9", "20", "35"}, strings.Split(resp.Rows[0].Values[0].Value, ","))
+
+	// selected variables must be grouped on
+	q, err = hod.ParseQuery(`SELECT ?r (COUNT(?r) AS ?n) FROM test WHERE { ?r bf:isPartOf ?f } GROUP BY ?f`, 0)
+	require.NoError(err)
+	_, err = hod.Select(context.Background(), q)
+	require.Error(err)
+
+	// Count returns the number of rows without the rows
+	q, err = hod.ParseQuery(`SELECT ?r FROM test WHERE { ?r rdf:type brick:Room }`, 0)
+	require.NoError(err)
+	resp, err = hod.Count(context.Background(), q)
+	require.NoError(err)
+	require.Equal(int64(3), resp.Count)
+	require.Equal(0, len(resp.Rows))
+	q, err = hod.ParseQuery(`SELECT ?r FROM test WHERE { ?r rdf:type brick:Room } LIMIT 2`, 0)
+	require.NoError(err)
+	resp, err = hod.Count(context.Background(), q)
+	require.NoError(err)
+	require.Equal(int64(2), resp.Count)
+	q, err = hod.ParseQuery(`SELECT ?f (COUNT(?r) AS ?n) FROM test WHERE { ?r bf:isPartOf ?f } GROUP BY ?f`, 0)
+	require.NoError(err)
+	resp, err = hod.Count(context.Background(), q)
+	require.NoError(err)
+	require.Equal(int64(4), resp.Count)
+}
+
 func TestQueryTwoGraphs(t *testing.T) {
 	require := require.New(t)
 	dir, err := ioutil.TempDir("", "_log_test_")
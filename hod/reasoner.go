@@ -0,0 +1,328 @@
+package hod
+
+import (
+	"github.com/dgraph-io/badger/v2"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+)
+
+// The reasoner materializes the triples entailed by the RDFS and OWL terms
+// below, so queries can match them directly: a query for ?x rdf:type
+// brick:Temperature_Sensor finds the instances of all of its subclasses.
+// Reasoning is forward chaining and semi-naive: the rules only fire on the
+// triples added by a write, and then on the triples inferred from those, until
+// nothing new is inferred. The other premises of a rule are looked up in the
+// graph, with the ontology graph layered under it.
+
+var (
+	rdfType               = turtle.URI{Namespace: RDF_NAMESPACE, Value: "type"}
+	rdfsSubClassOf        = turtle.URI{Namespace: RDFS_NAMESPACE, Value: "subClassOf"}
+	rdfsSubPropertyOf     = turtle.URI{Namespace: RDFS_NAMESPACE, Value: "subPropertyOf"}
+	rdfsDomain            = turtle.URI{Namespace: RDFS_NAMESPACE, Value: "domain"}
+	rdfsRange             = turtle.URI{Namespace: RDFS_NAMESPACE, Value: "range"}
+	owlEquivalentClass    = turtle.URI{Namespace: OWL_NAMESPACE, Value: "equivalentClass"}
+	owlTransitiveProperty = turtle.URI{Namespace: OWL_NAMESPACE, Value: "TransitiveProperty"}
+	owlSymmetricProperty  = turtle.URI{Namespace: OWL_NAMESPACE, Value: "SymmetricProperty"}
+	owlInverseOf          = turtle.URI{Namespace: OWL_NAMESPACE, Value: "inverseOf"}
+	owlSameAs             = turtle.URI{Namespace: OWL_NAMESPACE, Value: "sameAs"}
+)
+
+// adds the triples inferred from the new triples of the graph, and from the
+// triples inferred from those, until nothing new is inferred. The new triples
+// must already be in the graph. The rules saved in the database are applied
+// along with the RDFS and OWL rules
+func (hod *HodDB) reason(graphname string, triples []turtle.Triple) error {
+	seen := make(map[turtle.Triple]struct{}, len(triples))
+	for _, triple := range triples {
+		seen[triple] = struct{}{}
+	}
+	rules := hod.graphRules(graphname)
+	for delta := triples; len(delta) > 0; {
+		store, err := hod.newTripleStore(graphname)
+		if err != nil {
+			return err
+		}
+		var inferred []turtle.Triple
+		infer := func(triples []turtle.Triple) {
+			for _, t := range triples {
+				if _, found := seen[t]; found {
+					continue
+				}
+				seen[t] = struct{}{}
+				if !store.has(t) {
+					inferred = append(inferred, t)
+				}
+			}
+		}
+		for _, triple := range delta {
+			infer(store.infer(triple))
+		}
+		// rules are evaluated against the whole graph, but only once it has
+		// new triples that their WHERE clause may match
+		for _, rule := range rules {
+			if !rule.triggeredBy(delta) {
+				continue
+			}
+			built, err := store.applyRule(rule.rule)
+			if err != nil {
+				return err
+			}
+			infer(built)
+		}
+		if store.err != nil {
+			return errors.Wrap(store.err, "Could not read graph")
+		}
+		if len(inferred) > 0 {
+			dataset := turtle.DataSet{Namespaces: make(map[string]string), Triples: inferred}
+			if err := hod.addTriples(graphname, dataset); err != nil {
+				return err
+			}
+		}
+		delta = inferred
+	}
+	return nil
+}
+
+// the newest state of a graph, with the ontology graph layered under it
+type tripleStore struct {
+	hod    *HodDB
+	cursor *Cursor
+	// the first error reading the graph
+	err error
+}
+
+func (hod *HodDB) newTripleStore(graphname string) (*tripleStore, error) {
+	cursor, err := hod.Cursor(graphname)
+	if err != nil {
+		return nil, err
+	}
+	cursor.layerOntology()
+	return &tripleStore{hod: hod, cursor: cursor}, nil
+}
+
+func (s *tripleStore) key(uri turtle.URI) EntityKey {
+	return s.cursor.ContextualizeURI(convertURI(uri))
+}
+
+// returns nil if the graph does not have the entity
+func (s *tripleStore) entity(uri turtle.URI) *Entity {
+	ent, err := s.cursor.getEntity(s.key(uri))
+	if err != nil {
+		if err != badger.ErrKeyNotFound && s.err == nil {
+			s.err = err
+		}
+		return nil
+	}
+	return ent
+}
+
+func (s *tripleStore) uris(keys []EntityKey) []turtle.URI {
+	uris := make([]turtle.URI, 0, len(keys))
+	for _, key := range keys {
+		if uri, found := s.hod.getURI(key); found {
+			uris = append(uris, uri)
+		}
+	}
+	return uris
+}
+
+// returns the objects of the subject's edges for the predicate
+func (s *tripleStore) objects(subject, predicate turtle.URI) []turtle.URI {
+	ent := s.entity(subject)
+	if ent == nil {
+		return nil
+	}
+	return s.uris(ent.OutEdges(s.key(predicate)))
+}
+
+// returns the subjects of the object's edges for the predicate
+func (s *tripleStore) subjects(predicate, object turtle.URI) []turtle.URI {
+	ent := s.entity(object)
+	if ent == nil {
+		return nil
+	}
+	return s.uris(ent.InEdges(s.key(predicate)))
+}
+
+// returns the subject and object of each edge for the predicate
+func (s *tripleStore) pairs(predicate turtle.URI) [][2]turtle.URI {
+	ent := s.entity(predicate)
+	if ent == nil {
+		return nil
+	}
+	var pairs [][2]turtle.URI
+	for _, endpoint := range ent.compiled.Endpoints {
+		subject, sfound := s.hod.getURI(EntityKeyFromBytes(endpoint.Src))
+		object, ofound := s.hod.getURI(EntityKeyFromBytes(endpoint.Dst))
+		if sfound && ofound {
+			pairs = append(pairs, [2]turtle.URI{subject, object})
+		}
+	}
+	return pairs
+}
+
+// returns the predicate and object of each of the subject's edges
+func (s *tripleStore) edges(subject turtle.URI) [][2]turtle.URI {
+	ent := s.entity(subject)
+	if ent == nil {
+		return nil
+	}
+	var edges [][2]turtle.URI
+	for _, edge := range ent.GetAllOutEdges() {
+		predicate, pfound := s.hod.getURI(edge[0])
+		object, ofound := s.hod.getURI(edge[1])
+		if pfound && ofound {
+			edges = append(edges, [2]turtle.URI{predicate, object})
+		}
+	}
+	return edges
+}
+
+// returns the subject and predicate of each of the object's edges
+func (s *tripleStore) inEdges(object turtle.URI) [][2]turtle.URI {
+	ent := s.entity(object)
+	if ent == nil {
+		return nil
+	}
+	var edges [][2]turtle.URI
+	for _, edge := range ent.GetAllInEdges() {
+		predicate, pfound := s.hod.getURI(edge[0])
+		subject, sfound := s.hod.getURI(edge[1])
+		if pfound && sfound {
+			edges = append(edges, [2]turtle.URI{subject, predicate})
+		}
+	}
+	return edges
+}
+
+func (s *tripleStore) has(triple turtle.Triple) bool {
+	ent := s.entity(triple.Subject)
+	if ent == nil {
+		return false
+	}
+	object := s.key(triple.Object)
+	for _, key := range ent.OutEdges(s.key(triple.Predicate)) {
+		if key.is(object.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
+
+// returns the triples that the rules infer from the triple and the graph.
+// The triple may be any premise of a rule, so each rule is applied once for
+// each of its premises that the triple can match
+func (s *tripleStore) infer(triple turtle.Triple) []turtle.Triple {
+	var inferred []turtle.Triple
+	add := func(subject, predicate, object turtle.URI) {
+		// literals can not be subjects
+		if !subject.IsLiteral() {
+			inferred = append(inferred, turtle.Triple{Subject: subject, Predicate: predicate, Object: object})
+		}
+	}
+	subject, predicate, object := triple.Subject, triple.Predicate, triple.Object
+
+	// the triple is about a class or property
+	switch predicate {
+	case rdfType:
+		// rdfs9: instances of a class are instances of its superclasses
+		for _, super := range s.objects(object, rdfsSubClassOf) {
+			add(subject, rdfType, super)
+		}
+		switch object {
+		case owlTransitiveProperty:
+			for _, pair := range s.pairs(subject) {
+				for _, next := range s.objects(pair[1], subject) {
+					add(pair[0], subject, next)
+				}
+			}
+		case owlSymmetricProperty:
+			for _, pair := range s.pairs(subject) {
+				add(pair[1], subject, pair[0])
+			}
+		}
+	case rdfsSubClassOf:
+		// rdfs9
+		for _, instance := range s.subjects(rdfType, subject) {
+			add(instance, rdfType, object)
+		}
+		// rdfs11: subclasses are transitive
+		for _, super := range s.objects(object, rdfsSubClassOf) {
+			add(subject, rdfsSubClassOf, super)
+		}
+		for _, sub := range s.subjects(rdfsSubClassOf, subject) {
+			add(sub, rdfsSubClassOf, object)
+		}
+	case owlEquivalentClass:
+		add(subject, rdfsSubClassOf, object)
+		add(object, rdfsSubClassOf, subject)
+	case rdfsSubPropertyOf:
+		// rdfs7: edges for a property are edges for its superproperties
+		for _, pair := range s.pairs(subject) {
+			add(pair[0], object, pair[1])
+		}
+		// rdfs5: subproperties are transitive
+		for _, super := range s.objects(object, rdfsSubPropertyOf) {
+			add(subject, rdfsSubPropertyOf, super)
+		}
+		for _, sub := range s.subjects(rdfsSubPropertyOf, subject) {
+			add(sub, rdfsSubPropertyOf, object)
+		}
+	case rdfsDomain:
+		// rdfs2: the subjects of a property are instances of its domain
+		for _, pair := range s.pairs(subject) {
+			add(pair[0], rdfType, object)
+		}
+	case rdfsRange:
+		// rdfs3: the objects of a property are instances of its range
+		for _, pair := range s.pairs(subject) {
+			add(pair[1], rdfType, object)
+		}
+	case owlInverseOf:
+		for _, pair := range s.pairs(subject) {
+			add(pair[1], object, pair[0])
+		}
+		for _, pair := range s.pairs(object) {
+			add(pair[1], subject, pair[0])
+		}
+	case owlSameAs:
+		// the subject's edges are also the edges of what it is the same as
+		for _, edge := range s.edges(subject) {
+			add(object, edge[0], edge[1])
+		}
+	}
+
+	// the triple is an edge for a property the graph says something about
+	for _, super := range s.objects(predicate, rdfsSubPropertyOf) {
+		add(subject, super, object)
+	}
+	for _, class := range s.objects(predicate, rdfsDomain) {
+		add(subject, rdfType, class)
+	}
+	for _, class := range s.objects(predicate, rdfsRange) {
+		add(object, rdfType, class)
+	}
+	for _, inverse := range s.objects(predicate, owlInverseOf) {
+		add(object, inverse, subject)
+	}
+	for _, inverse := range s.subjects(owlInverseOf, predicate) {
+		add(object, inverse, subject)
+	}
+	for _, class := range s.objects(predicate, rdfType) {
+		switch class {
+		case owlTransitiveProperty:
+			for _, next := range s.objects(object, predicate) {
+				add(subject, predicate, next)
+			}
+			for _, previous := range s.subjects(predicate, subject) {
+				add(previous, predicate, object)
+			}
+		case owlSymmetricProperty:
+			add(object, predicate, subject)
+		}
+	}
+	for _, same := range s.objects(subject, owlSameAs) {
+		add(same, predicate, object)
+	}
+	return inferred
+}
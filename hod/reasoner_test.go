@@ -0,0 +1,95 @@
+package hod
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReasoner(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	defer hod.Close()
+
+	building := filepath.Join(dir, "building.ttl")
+	require.NoError(ioutil.WriteFile(building, []byte(`
+@prefix ex: <http://example.com/building#> .
+@prefix brick: <https://brickschema.org/schema/1.1/Brick#> .
+@prefix owl: <http://www.w3.org/2002/07/owl#> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+ex:ts1 a brick:Zone_Temperature_Sensor .
+ex:ts2 a ex:Special_Sensor .
+ex:Special_Sensor owl:equivalentClass brick:Supply_Air_Temperature_Sensor .
+ex:observes rdfs:domain ex:Observer ;
+    rdfs:range ex:Observed .
+ex:monitors rdfs:subPropertyOf ex:observes .
+ex:ts1 ex:monitors ex:room_1 .
+ex:adjacentTo a owl:SymmetricProperty .
+ex:room_1 ex:adjacentTo ex:room_2 .
+ex:contains a owl:TransitiveProperty .
+ex:building_1 ex:contains ex:floor_1 .
+ex:floor_1 ex:contains ex:room_1 .
+`), 0600))
+	require.NoError(hod.Load(FileBundle{GraphName: "test", TTLFile: building, OntologyFiles: []string{"Brick.ttl", "BrickFrame.ttl"}}))
+
+	// subclasses, from the ontology and from the graph
+	require.Equal([]string{"ts1", "ts2"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x rdf:type brick:Temperature_Sensor }"))
+	require.Equal([]string{"ts1", "ts2"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x a brick:Sensor }"))
+	require.Equal([]string{"ts2"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x rdf:type brick:Supply_Air_Temperature_Sensor }"))
+	// subproperties, domains and ranges
+	require.Equal([]string{"ts1"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x ex:observes ex:room_1 }"))
+	require.Equal([]string{"ts1"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x rdf:type ex:Observer }"))
+	require.Equal([]string{"room_1"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x rdf:type ex:Observed }"))
+	// symmetric and transitive properties
+	require.Equal([]string{"room_1"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ex:room_2 ex:adjacentTo ?x }"))
+	require.Equal([]string{"floor_1", "room_1"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ex:building_1 ex:contains ?x }"))
+
+	ex := func(value string) turtle.URI {
+		return turtle.URI{Namespace: "http://example.com/building", Value: value}
+	}
+	exTriple := func(s, p, o string) turtle.Triple {
+		return turtle.Triple{Subject: ex(s), Predicate: ex(p), Object: ex(o)}
+	}
+
+	// added triples are reasoned about, whether they are data or rules
+	require.NoError(hod.AddTriples("test", turtle.DataSet{Triples: []turtle.Triple{
+		exTriple("room_1", "contains", "desk_1"),
+		{Subject: ex("ts3"), Predicate: rdfType, Object: ex("Special_Sensor")},
+		{Subject: ex("observes"), Predicate: rdfsSubPropertyOf, Object: ex("relatesTo")},
+	}}))
+	require.Equal([]string{"desk_1", "floor_1", "room_1"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ex:building_1 ex:contains ?x }"))
+	require.Equal([]string{"ts1", "ts2", "ts3"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x rdf:type brick:Temperature_Sensor }"))
+	require.Equal([]string{"ts1"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x ex:relatesTo ex:room_1 }"))
+
+	// deleting a triple retracts what was only inferred from it
+	require.NoError(hod.DeleteTriples("test", turtle.DataSet{Triples: []turtle.Triple{exTriple("floor_1", "contains", "room_1")}}))
+	require.Equal([]string{"floor_1"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ex:building_1 ex:contains ?x }"))
+	require.Equal([]string{"desk_1"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ex:room_1 ex:contains ?x }"))
+	require.NoError(hod.DeleteTriples("test", turtle.DataSet{Triples: []turtle.Triple{exTriple("ts1", "monitors", "room_1")}}))
+	require.Empty(queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x ex:observes ex:room_1 }"))
+	require.Empty(queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x rdf:type ex:Observer }"))
+
+	// triples that are still supported by other triples are re-derived
+	sat := turtle.URI{Namespace: "https://brickschema.org/schema/1.1/Brick", Value: "Supply_Air_Temperature_Sensor"}
+	require.NoError(hod.AddTriples("test", turtle.DataSet{Triples: []turtle.Triple{{Subject: ex("ts2"), Predicate: rdfType, Object: sat}}}))
+	require.NoError(hod.DeleteTriples("test", turtle.DataSet{Triples: []turtle.Triple{{Subject: ex("ts2"), Predicate: rdfType, Object: ex("Special_Sensor")}}}))
+	require.Equal([]string{"ts2", "ts3"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x rdf:type ex:Special_Sensor }"))
+	require.NoError(hod.DeleteTriples("test", turtle.DataSet{Triples: []turtle.Triple{{Subject: ex("ts2"), Predicate: rdfType, Object: sat}}}))
+	require.Equal([]string{"ts3"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x rdf:type ex:Special_Sensor }"))
+	require.Equal([]string{"ts1", "ts3"}, queryValues(t, hod, "SELECT ?x FROM test WHERE { ?x rdf:type brick:Temperature_Sensor }"))
+}
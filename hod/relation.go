@@ -213,6 +213,103 @@ innerRows:
 	}
 }
 
+// keeps every row of the relation, extending the rows that match rows of [other]
+// on the given variables with the values from [other]. Rows without a match are
+// kept as they are, leaving the variables that only [other] has unbound.
+// A join variable that is unbound in a row matches any value
+func (rel *relation) leftJoin(other *relation, on []string) {
+	// new variables go after the last position in use
+	nextPos := 0
+	for _, pos := range rel.vars {
+		if pos >= nextPos {
+			nextPos = pos + 1
+		}
+	}
+	for varname := range other.vars {
+		if _, found := rel.vars[varname]; !found {
+			rel.vars[varname] = nextPos
+			rel.keys = append(rel.keys, varname)
+			nextPos++
+		}
+	}
+
+	// the operators do not always keep the multiindex of [other] in step with
+	// its rows, so index the join variables here
+	var otherIndex = make(map[string]map[EntityKey]*roaring.Bitmap)
+	for _, joinVarName := range on {
+		otherIndex[joinVarName] = make(map[EntityKey]*roaring.Bitmap)
+		pos := other.vars[joinVarName]
+		for idx, row := range other.rows {
+			value := row.valueAt(pos)
+			if otherIndex[joinVarName][value] == nil {
+				otherIndex[joinVarName][value] = roaring.New()
+			}
+			otherIndex[joinVarName][value].AddInt(idx)
+		}
+	}
+
+	var joinedRows = make([]*relationRow, 0, len(rel.rows))
+	for _, innerRow := range rel.rows {
+		// start with all of the other rows and narrow them down on each bound join variable
+		otherRowsBitmap := roaring.New()
+		otherRowsBitmap.AddRange(0, uint64(len(other.rows)))
+		for _, joinVarName := range on {
+			innerRowValue := innerRow.valueAt(rel.vars[joinVarName])
+			if innerRowValue.Empty() {
+				continue
+			}
+			if otherBitmap := otherIndex[joinVarName][innerRowValue]; otherBitmap != nil {
+				otherRowsBitmap.And(otherBitmap)
+			} else {
+				otherRowsBitmap.Clear()
+			}
+		}
+		if otherRowsBitmap.IsEmpty() {
+			joinedRows = append(joinedRows, innerRow)
+			continue
+		}
+		iter := otherRowsBitmap.Iterator()
+		for iter.HasNext() {
+			row := other.rows[iter.Next()]
+			newRow := innerRow.copy()
+			for otherVarname, otherIdx := range other.vars {
+				newRow.addValue(rel.vars[otherVarname], row.valueAt(otherIdx))
+			}
+			joinedRows = append(joinedRows, newRow)
+		}
+		innerRow.release()
+	}
+	rel.rows = joinedRows
+	rel.reindex()
+}
+
+// keeps only the rows of the relation for which keep returns true
+func (rel *relation) filter(keep func(row *relationRow) bool) {
+	var kept = rel.rows[:0]
+	for _, row := range rel.rows {
+		if keep(row) {
+			kept = append(kept, row)
+		} else {
+			row.release()
+		}
+	}
+	rel.rows = kept
+	rel.reindex()
+}
+
+// rebuilds the multiindex from the rows of the relation
+func (rel *relation) reindex() {
+	rel.multiindex = make(map[string]map[EntityKey]*roaring.Bitmap)
+	for varname := range rel.vars {
+		rel.multiindex[varname] = make(map[EntityKey]*roaring.Bitmap)
+	}
+	for idx, row := range rel.rows {
+		for varname, pos := range rel.vars {
+			rel.addValueToRow(varname, row.valueAt(pos), idx)
+		}
+	}
+}
+
 func (rel *relation) addValueToRow(varname string, key EntityKey, value int) {
 	if key.Empty() {
 		return
@@ -255,9 +352,11 @@ func (row *relationRow) release() {
 
 func (row *relationRow) copy() *relationRow {
 	gr := rowPool.Get().(*relationRow)
-	if len(gr.content) < len(row.content) {
+	if cap(gr.content) < len(row.content) {
 		gr.content = make([]byte, len(row.content))
 	}
+	// don't keep values from the row's previous use
+	gr.content = gr.content[:len(row.content)]
 	copy(gr.content[:], row.content[:])
 	return gr
 }
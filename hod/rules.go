@@ -0,0 +1,447 @@
+package hod
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/proto"
+	query "github.com/gtfierro/hoddb/lang"
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rules are CONSTRUCT queries that the reasoner applies along with its RDFS and
+// OWL rules, for conventions such as "every VAV feeds the zone it controls".
+// Whenever a graph the rule applies to gets triples for one of the predicates
+// in the rule's WHERE clause, the WHERE clause is evaluated against the graph
+// and the template is added for each of the resulting rows. Like everything
+// else the reasoner infers, the triples a rule adds are not asserted, so they
+// are removed along with the triples they were built from.
+//
+// Rules come from the config or the AddRule RPC and are saved in the database,
+// so they keep applying once the server restarts.
+
+// prefix for the rules, which are keyed by the hash of the rule
+var rulepfx = []byte("rulepfx")
+
+// marks a rule that was already saved
+var errRuleExists = errors.New("rule exists")
+
+// returns the key and serialized value for saving the rule
+func ruleKeyValue(rule *logpb.ConstructQuery) ([]byte, []byte, error) {
+	serialized, err := proto.Marshal(rule)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Could not serialize rule")
+	}
+	hash := sha256.Sum256(serialized)
+	return append(append([]byte{}, rulepfx...), hash[:]...), serialized, nil
+}
+
+// ParseConstructQuery parses a CONSTRUCT query into the rule accepted by the
+// AddRule RPC. The graphs in its FROM clause are the graphs the rule applies to
+func (hod *HodDB) ParseConstructQuery(qstr string) (*logpb.ConstructQuery, error) {
+	q, err := query.Parse(qstr)
+	if err != nil {
+		return nil, errors.Wrap(ErrParse, err.Error())
+	}
+	if !q.IsConstruct() {
+		return nil, errors.Wrap(ErrParse, "not a CONSTRUCT query")
+	}
+	where, err := templateWhere(q.Where)
+	if err != nil {
+		return nil, err
+	}
+
+	// the prefixes are expanded with the namespaces of each graph the rule is
+	// applied to, which do not have to exist yet
+	unexpanded := func(uri *logpb.URI) *logpb.URI { return uri }
+	rule := &logpb.ConstructQuery{
+		Graphs: q.From.Databases,
+	}
+	if rule.Construct, err = convertTerms(q.Construct.Terms, unexpanded); err != nil {
+		return nil, err
+	}
+	if rule.Where, err = convertTerms(where, unexpanded); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// returns an error if the rule can not be applied: every variable in the
+// template must be bound by the WHERE clause, as a new blank node for each row
+// would be new every time the rule is applied
+func checkRule(rule *logpb.ConstructQuery) error {
+	if len(rule.Where) == 0 {
+		return errors.Wrap(ErrParse, "Rules must have a WHERE clause")
+	}
+	bound := make(map[string]struct{})
+	for _, triple := range rule.Where {
+		for _, uri := range append([]*logpb.URI{triple.Subject, triple.Object}, triple.Predicate...) {
+			if isVariable(uri) && uri != nil {
+				bound[uri.Value] = struct{}{}
+			}
+		}
+	}
+	for _, term := range rule.Construct {
+		if len(term.Predicate) != 1 {
+			return errors.Wrapf(ErrParse, "Template terms must have a single predicate, not a path (%s)", term)
+		}
+		for _, uri := range []*logpb.URI{term.Subject, term.Predicate[0], term.Object} {
+			if uri == nil {
+				return errors.Wrapf(ErrParse, "Template term %s is missing a URI", term)
+			}
+			if _, found := bound[uri.Value]; isVariable(uri) && !found {
+				return errors.Wrapf(ErrParse, "Variable %s in rule template is not bound by WHERE", uri.Value)
+			}
+		}
+	}
+	return nil
+}
+
+// returns true if the rule applies to all graphs, including the ones created later
+func ruleForAllGraphs(rule *logpb.ConstructQuery) bool {
+	return len(rule.Graphs) == 0 || (len(rule.Graphs) == 1 && rule.Graphs[0] == "*")
+}
+
+// returns true if the rule applies to the graph
+func ruleAppliesTo(rule *logpb.ConstructQuery, graphname string) bool {
+	if graphname == ontologyGraph {
+		return false
+	}
+	return ruleForAllGraphs(rule) || hasString(rule.Graphs, graphname)
+}
+
+// AddRule adds the rule, applies it to the graphs it names (or all graphs if
+// none or '*' are named), and from then on applies it whenever triples are
+// added to them. The response counts the triples the rule added. Only requests
+// that may access all graphs can add a rule for all graphs, as it also applies
+// to graphs created later
+func (hod *HodDB) AddRule(ctx context.Context, rule *logpb.ConstructQuery) (resp *logpb.Response, err error) {
+	resp = new(logpb.Response)
+	p, restricted := principalFromContext(ctx)
+	if restricted && !p.all && ruleForAllGraphs(rule) {
+		err = status.Error(codes.PermissionDenied, "Not allowed to add a rule for all graphs")
+		resp.Error = err.Error()
+		return resp, err
+	}
+	if _, err = hod.resolveGraphs(ctx, rule.Graphs); err != nil {
+		resp.Error = err.Error()
+		return resp, err
+	}
+	added, err := hod.addRule(rule)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, err
+	}
+	resp.Count = int64(added)
+	return resp, nil
+}
+
+// saves the rule and applies it to the graphs it applies to, returning how many
+// triples it added. Adding a rule that was already added does nothing. If the
+// rule can not be applied it is removed again, although the triples it added
+// to the graphs it was applied to before remain
+func (hod *HodDB) addRule(rule *logpb.ConstructQuery) (int, error) {
+	if err := checkRule(rule); err != nil {
+		return 0, err
+	}
+	for _, graph := range rule.Graphs {
+		if err := checkWritable(graph); err != nil {
+			return 0, err
+		}
+	}
+	graphs, err := hod.resolveGraphs(context.Background(), rule.Graphs)
+	if err != nil {
+		return 0, err
+	}
+	key, serialized, err := ruleKeyValue(rule)
+	if err != nil {
+		return 0, err
+	}
+	err = hod.db.Update(func(txn *badger.Txn) error {
+		if _, err := txn.Get(key); err == nil {
+			return errRuleExists
+		} else if err != badger.ErrKeyNotFound {
+			return err
+		}
+		return txn.Set(key, serialized)
+	})
+	if err == errRuleExists {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Wrap(err, "Could not save rule")
+	}
+	// the rule is applied to the triples it builds too
+	hod.Lock()
+	hod.rules = append(hod.rules, rule)
+	hod.Unlock()
+
+	added, err := hod.applyNewRule(rule, graphs)
+	if err != nil {
+		if removeErr := hod.removeRule(key, rule); removeErr != nil {
+			log.Error(errors.Wrap(removeErr, "Could not remove rule that failed to apply"))
+		}
+		return added, err
+	}
+	return added, nil
+}
+
+// applies the rule to the graphs, returning how many triples it added
+func (hod *HodDB) applyNewRule(rule *logpb.ConstructQuery, graphs []string) (int, error) {
+	var added int
+	for _, graph := range graphs {
+		if !hod.graphExists(graph) {
+			// the rule applies once triples are added to the graph
+			continue
+		}
+		// only make a new version of the graph if the rule adds to it
+		if triples, err := hod.applyRule(graph, rule); err != nil {
+			return added, errors.Wrapf(err, "Could not apply rule to graph %s", graph)
+		} else if len(triples) == 0 {
+			continue
+		}
+		err := hod.newVersion(graph, func(entry *versionEntry) error {
+			triples, err := hod.applyRule(graph, rule)
+			if err != nil || len(triples) == 0 {
+				return err
+			}
+			added += len(triples)
+			dataset := turtle.DataSet{Namespaces: make(map[string]string), Triples: triples}
+			return hod.inferAndAddTriples(graph, dataset)
+		})
+		if err != nil {
+			return added, errors.Wrapf(err, "Could not apply rule to graph %s", graph)
+		}
+	}
+	return added, nil
+}
+
+// removes the saved rule, and stops applying it
+func (hod *HodDB) removeRule(key []byte, rule *logpb.ConstructQuery) error {
+	hod.Lock()
+	rules := make([]*logpb.ConstructQuery, 0, len(hod.rules))
+	for _, other := range hod.rules {
+		if other != rule {
+			rules = append(rules, other)
+		}
+	}
+	hod.rules = rules
+	hod.Unlock()
+	return hod.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
+// loads the rules saved in the database
+func (hod *HodDB) loadRules() error {
+	return hod.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(rulepfx); it.ValidForPrefix(rulepfx); it.Next() {
+			rule := new(logpb.ConstructQuery)
+			err := it.Item().Value(func(v []byte) error {
+				return proto.Unmarshal(v, rule)
+			})
+			if err != nil {
+				return errors.Wrap(err, "Could not read rule")
+			}
+			hod.rules = append(hod.rules, rule)
+		}
+		return nil
+	})
+}
+
+// returns a copy of the rule that applies to the graph's new name instead of
+// its old one, or nil if the rule does not name the graph
+func renameRuleGraph(rule *logpb.ConstructQuery, from, to string) *logpb.ConstructQuery {
+	if !hasString(rule.Graphs, from) {
+		return nil
+	}
+	renamed := proto.Clone(rule).(*logpb.ConstructQuery)
+	renamed.Graphs = nil
+	for _, graph := range rule.Graphs {
+		if graph == from {
+			graph = to
+		}
+		if !hasString(renamed.Graphs, graph) {
+			renamed.Graphs = append(renamed.Graphs, graph)
+		}
+	}
+	return renamed
+}
+
+// re-keys the saved rules that name the renamed graph
+func renameSavedRules(txn *badger.Txn, from, to string) error {
+	var renamed []*logpb.ConstructQuery
+	var keys [][]byte
+	it := txn.NewIterator(badger.DefaultIteratorOptions)
+	for it.Seek(rulepfx); it.ValidForPrefix(rulepfx); it.Next() {
+		rule := new(logpb.ConstructQuery)
+		err := it.Item().Value(func(v []byte) error {
+			return proto.Unmarshal(v, rule)
+		})
+		if err != nil {
+			it.Close()
+			return errors.Wrap(err, "Could not read rule")
+		}
+		if rule = renameRuleGraph(rule, from, to); rule != nil {
+			renamed = append(renamed, rule)
+			keys = append(keys, it.Item().KeyCopy(nil))
+		}
+	}
+	it.Close()
+	for idx, rule := range renamed {
+		if err := txn.Delete(keys[idx]); err != nil {
+			return err
+		}
+		key, serialized, err := ruleKeyValue(rule)
+		if err != nil {
+			return err
+		}
+		if err := txn.Set(key, serialized); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parses the rules in the config and adds the ones that are new
+func (hod *HodDB) addConfigRules(rules []string) error {
+	for _, qstr := range rules {
+		rule, err := hod.ParseConstructQuery(qstr)
+		if err != nil {
+			return errors.Wrapf(err, "Could not parse rule %s", qstr)
+		}
+		if _, err := hod.addRule(rule); err != nil {
+			return errors.Wrapf(err, "Could not add rule %s", qstr)
+		}
+	}
+	return nil
+}
+
+// a rule as applied to one graph
+type graphRule struct {
+	rule *logpb.ConstructQuery
+	// the predicates in the WHERE clause, with the graph's prefixes expanded
+	predicates map[turtle.URI]struct{}
+	// the WHERE clause has a variable predicate, so any triple may match it
+	anyPredicate bool
+	// the predicates in the template, with the graph's prefixes expanded
+	templates map[turtle.URI]struct{}
+	// the template has a variable predicate, so it may build any triple
+	anyTemplate bool
+}
+
+// returns the rules that apply to the graph
+func (hod *HodDB) graphRules(graphname string) []graphRule {
+	hod.RLock()
+	rules := hod.rules
+	hod.RUnlock()
+
+	var applied []graphRule
+	for _, rule := range rules {
+		if !ruleAppliesTo(rule, graphname) {
+			continue
+		}
+		gr := graphRule{
+			rule:       rule,
+			predicates: make(map[turtle.URI]struct{}),
+			templates:  make(map[turtle.URI]struct{}),
+		}
+		for _, triple := range rule.Where {
+			for _, pred := range triple.Predicate {
+				gr.anyPredicate = hod.addRulePredicate(gr.predicates, pred, graphname) || gr.anyPredicate
+			}
+		}
+		for _, triple := range rule.Construct {
+			gr.anyTemplate = hod.addRulePredicate(gr.templates, triple.Predicate[0], graphname) || gr.anyTemplate
+		}
+		applied = append(applied, gr)
+	}
+	return applied
+}
+
+// adds the predicate of a rule to the set, returning true if it is a variable
+func (hod *HodDB) addRulePredicate(predicates map[turtle.URI]struct{}, pred *logpb.URI, graphname string) bool {
+	if isVariable(pred) {
+		return true
+	}
+	if expanded := hod.expandURI(proto.Clone(pred).(*logpb.URI), graphname); expanded != nil {
+		predicates[turtle.URIFromProto(expanded)] = struct{}{}
+	}
+	return false
+}
+
+// returns true if one of the triples may match the rule's WHERE clause
+func (gr graphRule) triggeredBy(triples []turtle.Triple) bool {
+	if gr.anyPredicate {
+		return len(triples) > 0
+	}
+	for _, triple := range triples {
+		if _, found := gr.predicates[triple.Predicate]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// returns true if the rule's template may build triples for one of the predicates
+func (gr graphRule) mayBuild(predicates map[turtle.URI]struct{}) bool {
+	if gr.anyTemplate {
+		return true
+	}
+	for predicate := range predicates {
+		if _, found := gr.templates[predicate]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluates the rule against the newest state of the graph, returning the
+// triples its template builds that the graph does not have yet
+func (hod *HodDB) applyRule(graphname string, rule *logpb.ConstructQuery) ([]turtle.Triple, error) {
+	store, err := hod.newTripleStore(graphname)
+	if err != nil {
+		return nil, err
+	}
+	built, err := store.applyRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	var triples []turtle.Triple
+	for _, triple := range built {
+		if !store.has(triple) {
+			triples = append(triples, triple)
+		}
+	}
+	if store.err != nil {
+		return nil, errors.Wrap(store.err, "Could not read graph")
+	}
+	return triples, nil
+}
+
+// evaluates the rule against the newest state of the store's graph, including
+// a write in progress, returning the triples its template builds. Literals can
+// not be subjects, so the triples the template would build about a literal are
+// left out
+func (s *tripleStore) applyRule(rule *logpb.ConstructQuery) ([]turtle.Triple, error) {
+	// expanding the graph's prefixes changes the rule's URIs
+	rule = proto.Clone(rule).(*logpb.ConstructQuery)
+	version, _ := s.hod.pendingVersion(s.cursor.graphname)
+	dataset, err := s.hod.instantiateTemplate(context.Background(), s.cursor.graphname, version, rule.Construct, rule.Where)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not apply rule")
+	}
+	triples := dataset.Triples[:0]
+	for _, triple := range dataset.Triples {
+		if !triple.Subject.IsLiteral() {
+			triples = append(triples, triple)
+		}
+	}
+	return triples, nil
+}
@@ -0,0 +1,177 @@
+package hod
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	logpb "github.com/gtfierro/hoddb/proto"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRules(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	building := filepath.Join(dir, "building.ttl")
+	require.NoError(ioutil.WriteFile(building, []byte(`
+@prefix ex: <http://example.com/building#> .
+@prefix bf: <https://brickschema.org/schema/1.1/BrickFrame#> .
+@prefix brick: <https://brickschema.org/schema/1.1/Brick#> .
+@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+ex:vav_1 a brick:VAV ;
+    bf:controls ex:zone_1 .
+ex:Fancy_VAV rdfs:subClassOf brick:VAV .
+ex:vav_2 a ex:Fancy_VAV ;
+    bf:controls ex:zone_2 .
+`), 0600))
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s
+    buildings:
+        test: %s
+    ontologies:
+        - Brick.ttl
+        - BrickFrame.ttl
+    rules:
+        - "CONSTRUCT { ?vav bf:feeds ?zone } WHERE { ?vav rdf:type brick:VAV . ?vav bf:controls ?zone }"
+    `, dir, building)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.Len(cfg.Database.Rules, 1)
+
+	// a rule that can not be added keeps the database from opening, and leaves
+	// it closed
+	rules := cfg.Database.Rules
+	cfg.Database.Rules = []string{"CONSTRUCT { ?vav bf:feeds ?zone } WHERE { ?vav rdf:type brick:VAV }"}
+	_, err = MakeHodDB(cfg)
+	require.Equal(ErrParse, errors.Cause(err))
+	cfg.Database.Rules = rules
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+
+	// the rules from the config apply to the buildings in it, along with the
+	// subclasses and inverses the reasoner infers
+	feeds := "SELECT ?z FROM test WHERE { ?v bf:feeds ?z }"
+	require.Equal([]string{"zone_1", "zone_2"}, queryValues(t, hod, feeds))
+	require.Equal([]string{"vav_2"}, queryValues(t, hod, "SELECT ?v FROM test WHERE { ex:zone_2 bf:isFedBy ?v }"))
+
+	ex := func(value string) turtle.URI {
+		return turtle.URI{Namespace: "http://example.com/building", Value: value}
+	}
+	controls := turtle.URI{Namespace: "https://brickschema.org/schema/1.1/BrickFrame", Value: "controls"}
+	vav := turtle.URI{Namespace: "https://brickschema.org/schema/1.1/Brick", Value: "VAV"}
+
+	// and to triples added later
+	require.NoError(hod.AddTriples("test", turtle.DataSet{Triples: []turtle.Triple{
+		{Subject: ex("vav_3"), Predicate: rdfType, Object: vav},
+		{Subject: ex("vav_3"), Predicate: controls, Object: ex("zone_3")},
+	}}))
+	require.Equal([]string{"zone_1", "zone_2", "zone_3"}, queryValues(t, hod, feeds))
+
+	// rules added by RPC apply to the triples already in the graph, including
+	// the ones other rules built
+	rule, err := hod.ParseConstructQuery("CONSTRUCT { ?zone rdf:type brick:HVAC_Zone } FROM test WHERE { ?vav bf:feeds ?zone }")
+	require.NoError(err)
+	resp, err := hod.AddRule(context.Background(), rule)
+	require.NoError(err)
+	require.EqualValues(3, resp.Count)
+	zones := "SELECT ?z FROM test WHERE { ?z rdf:type brick:Zone }"
+	require.Equal([]string{"zone_1", "zone_2", "zone_3"}, queryValues(t, hod, zones))
+	// adding it again adds nothing
+	resp, err = hod.AddRule(context.Background(), rule)
+	require.NoError(err)
+	require.EqualValues(0, resp.Count)
+
+	_, err = hod.ParseConstructQuery("SELECT ?x WHERE { ?x rdf:type brick:VAV }")
+	require.Equal(ErrParse, errors.Cause(err))
+	// the WHERE clause of a rule is only triple patterns
+	_, err = hod.ParseConstructQuery("CONSTRUCT { ?vav bf:feeds ?zone } WHERE { ?vav rdf:type brick:VAV . OPTIONAL { ?vav bf:controls ?zone } }")
+	require.Equal(ErrParse, errors.Cause(err))
+	unbound, err := hod.ParseConstructQuery("CONSTRUCT { ?vav bf:feeds ?zone } WHERE { ?vav rdf:type brick:VAV }")
+	require.NoError(err)
+	_, err = hod.AddRule(context.Background(), unbound)
+	require.Equal(ErrParse, errors.Cause(err))
+	// rules for all graphs also apply to graphs that the request may not access
+	restricted := context.WithValue(context.Background(), principalKey{}, newPrincipal([]string{"test"}))
+	forAll, err := hod.ParseConstructQuery("CONSTRUCT { ?zone rdf:type brick:Location } WHERE { ?vav bf:feeds ?zone }")
+	require.NoError(err)
+	_, err = hod.AddRule(restricted, forAll)
+	require.Equal(codes.PermissionDenied, status.Code(err))
+	// a rule that can not be applied is not kept
+	hod.graphs["ghost"] = struct{}{}
+	ghost, err := hod.ParseConstructQuery("CONSTRUCT { ?zone rdf:type brick:Space } FROM test ghost WHERE { ?vav bf:feeds ?zone }")
+	require.NoError(err)
+	_, err = hod.addRule(ghost)
+	require.Error(err)
+	delete(hod.graphs, "ghost")
+	require.NotContains(hod.rules, ghost)
+	key, _, err := ruleKeyValue(ghost)
+	require.NoError(err)
+	require.NoError(hod.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(key)
+		require.Equal(badger.ErrKeyNotFound, err)
+		return nil
+	}))
+
+	// deleting a triple retracts what the rules built from it
+	require.NoError(hod.DeleteTriples("test", turtle.DataSet{Triples: []turtle.Triple{
+		{Subject: ex("vav_1"), Predicate: controls, Object: ex("zone_1")},
+	}}))
+	require.Equal([]string{"zone_2", "zone_3"}, queryValues(t, hod, feeds))
+	require.Equal([]string{"zone_2", "zone_3"}, queryValues(t, hod, zones))
+	require.NoError(hod.Close())
+
+	// the rules are saved in the database
+	cfg, err = ReadConfigFromString(fmt.Sprintf(`database:
+    path: %s    `, dir))
+	require.NoError(err, "read config")
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NoError(hod.AddTriples("test", turtle.DataSet{Triples: []turtle.Triple{
+		{Subject: ex("vav_4"), Predicate: rdfType, Object: vav},
+		{Subject: ex("vav_4"), Predicate: controls, Object: ex("zone_4")},
+	}}))
+	require.Equal([]string{"zone_2", "zone_3", "zone_4"}, queryValues(t, hod, feeds))
+	require.Equal([]string{"zone_2", "zone_3", "zone_4"}, queryValues(t, hod, zones))
+
+	// rules naming a graph follow it when it is renamed, also once reopened
+	addVAV := func(graph, name, zone string) {
+		namespaces := map[string]string{
+			"bf":    "https://brickschema.org/schema/1.1/BrickFrame",
+			"brick": "https://brickschema.org/schema/1.1/Brick",
+			"rdf":   "http://www.w3.org/1999/02/22-rdf-syntax-ns",
+		}
+		require.NoError(hod.AddTriples(graph, turtle.DataSet{Namespaces: namespaces, Triples: []turtle.Triple{
+			{Subject: ex(name), Predicate: rdfType, Object: vav},
+			{Subject: ex(name), Predicate: controls, Object: ex(zone)},
+		}}))
+	}
+	renamedZones := "SELECT ?z FROM renamed WHERE { ?z rdf:type brick:Zone }"
+	require.NoError(hod.RenameGraph(&logpb.RenameGraphRequest{Graph: "test", NewName: "renamed"}, &progressRecorder{ctx: context.Background()}))
+	addVAV("renamed", "vav_5", "zone_5")
+	require.Equal([]string{"zone_2", "zone_3", "zone_4", "zone_5"}, queryValues(t, hod, renamedZones))
+	require.NoError(hod.Close())
+
+	hod, err = MakeHodDB(cfg)
+	require.NoError(err, "reopen log")
+	defer hod.Close()
+	addVAV("renamed", "vav_6", "zone_6")
+	require.Equal([]string{"zone_2", "zone_3", "zone_4", "zone_5", "zone_6"}, queryValues(t, hod, renamedZones))
+	// and no longer apply to a new graph with the old name
+	addVAV("test", "vav_7", "zone_7")
+	require.Empty(queryValues(t, hod, zones))
+	require.Equal([]string{"zone_7"}, queryValues(t, hod, feeds))
+}
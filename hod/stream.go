@@ -0,0 +1,61 @@
+package hod
+
+import (
+	"context"
+
+	logpb "github.com/gtfierro/hoddb/proto"
+)
+
+// the number of rows in each response sent by SelectStream
+const streamBatchSize = 400
+
+// rowStream collects the rows of a query into batches and sends each batch as
+// its own response once it is full
+type rowStream struct {
+	send  func(*logpb.Response) error
+	batch []*logpb.Row
+	sent  bool
+}
+
+// adds a row to the current batch, sending the batch if it is full
+func (s *rowStream) add(resp *logpb.Response, row *logpb.Row) error {
+	s.batch = append(s.batch, row)
+	if len(s.batch) < streamBatchSize {
+		return nil
+	}
+	return s.flush(resp)
+}
+
+// sends the rows in the current batch with the variables and version of resp.
+// A query with no rows still sends one (empty) response
+func (s *rowStream) flush(resp *logpb.Response) error {
+	if len(s.batch) == 0 && s.sent {
+		return nil
+	}
+	s.sent = true
+	batch := &logpb.Response{
+		Version:   resp.Version,
+		Variables: resp.Variables,
+		Count:     int64(len(s.batch)),
+		Rows:      s.batch,
+	}
+	s.batch = nil
+	return s.send(batch)
+}
+
+// SelectBatches runs the query and calls f with the rows in batches as they are
+// produced, rather than building a single response. Queries with an ORDER BY or
+// aggregates produce their rows only once all solutions are known. The
+// solutions for each graph (and each alternative of a UNION) are found before
+// their first row is produced, so streaming bounds the size of each response
+// but not the memory the query uses. Running the query stops when the context
+// is done or f returns an error
+func (hod *HodDB) SelectBatches(ctx context.Context, query *logpb.SelectQuery, f func(*logpb.Response) error) error {
+	_, err := hod.selectQuery(ctx, query, false, &rowStream{send: f})
+	return err
+}
+
+// SelectStream is Select with the rows sent in batches over a stream
+func (hod *HodDB) SelectStream(query *logpb.SelectQuery, srv logpb.HodDB_SelectStreamServer) error {
+	return hod.SelectBatches(srv.Context(), query, srv.Send)
+}
@@ -0,0 +1,122 @@
+package hod
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	logpb "github.com/gtfierro/hoddb/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectBatches(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+
+	// enough rooms to fill several batches
+	var inserts []string
+	for i := 0; i < 1000; i++ {
+		inserts = append(inserts, fmt.Sprintf("bldg:stream_room_%d rdf:type brick:Room . bldg:stream_room_%d bf:isPartOf bldg:floor_1", i, i))
+	}
+	iq, err := hod.ParseInsertQuery(fmt.Sprintf("INSERT { %s } TO test", strings.Join(inserts, " . ")))
+	require.NoError(err)
+	_, err = hod.Insert(context.Background(), iq)
+	require.NoError(err)
+
+	// the batches of a streamed query have the same rows as Select. Unless the
+	// rows are ordered, only the number of rows of a LIMIT is the same
+	for _, test := range []struct {
+		query      string
+		minBatches int
+		ordered    bool
+		limited    bool
+	}{
+		{"SELECT ?x ?c FROM test WHERE { ?x rdf:type brick:Room . ?x bf:isPartOf ?c }", 3, false, false},
+		{"SELECT ?x ?c FROM test WHERE { ?x rdf:type brick:Room . ?x bf:isPartOf ?c } LIMIT 450 OFFSET 20", 2, false, true},
+		{"SELECT ?x ?c FROM test WHERE { ?x rdf:type brick:Room . ?x bf:isPartOf ?c } ORDER BY ?x ?c", 3, true, false},
+		{"SELECT ?x ?c FROM test WHERE { ?x rdf:type brick:Room . ?x bf:isPartOf ?c } ORDER BY ?x DESC ?c LIMIT 10 OFFSET 395", 1, true, true},
+		{"SELECT ?c (COUNT(?x) AS ?n) FROM test WHERE { ?x rdf:type brick:Room . ?x bf:isPartOf ?c } GROUP BY ?c", 1, false, false},
+		{"SELECT ?x FROM test WHERE { ?x rdf:type brick:Nothing }", 1, false, false},
+	} {
+		q, err := hod.ParseQuery(test.query, 0)
+		require.NoError(err, test.query)
+		expected, err := hod.Select(context.Background(), q)
+		require.NoError(err, test.query)
+
+		q, err = hod.ParseQuery(test.query, 0)
+		require.NoError(err, test.query)
+		var rows []*logpb.Row
+		var batches int
+		err = hod.SelectBatches(context.Background(), q, func(resp *logpb.Response) error {
+			batches++
+			require.True(len(resp.Rows) <= streamBatchSize, test.query)
+			require.Equal(int64(len(resp.Rows)), resp.Count, test.query)
+			require.Equal(expected.Variables, resp.Variables, test.query)
+			rows = append(rows, resp.Rows...)
+			return nil
+		})
+		require.NoError(err, test.query)
+		require.True(batches >= test.minBatches, test.query)
+		require.Equal(len(expected.Rows), len(rows), test.query)
+		var expectedRows, streamedRows []string
+		for idx := range rows {
+			expectedRows = append(expectedRows, expected.Rows[idx].String())
+			streamedRows = append(streamedRows, rows[idx].String())
+		}
+		if test.ordered {
+			require.Equal(expectedRows, streamedRows, test.query)
+		} else if !test.limited {
+			require.ElementsMatch(expectedRows, streamedRows, test.query)
+		}
+	}
+
+	// an error sending a batch stops the query
+	q, err := hod.ParseQuery("SELECT ?x ?c FROM test WHERE { ?x rdf:type brick:Room . ?x bf:isPartOf ?c }", 0)
+	require.NoError(err)
+	var batches int
+	stop := errors.New("stop")
+	err = hod.SelectBatches(context.Background(), q, func(resp *logpb.Response) error {
+		batches++
+		return stop
+	})
+	require.Equal(stop, err)
+	require.Equal(1, batches)
+
+	// so does cancelling the context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	q, err = hod.ParseQuery("SELECT ?x ?c FROM test WHERE { ?x rdf:type brick:Room . ?x bf:isPartOf ?c }", 0)
+	require.NoError(err)
+	err = hod.SelectBatches(ctx, q, func(resp *logpb.Response) error {
+		return nil
+	})
+	require.Equal(context.Canceled, err)
+	_, err = hod.Select(ctx, q)
+	require.Equal(context.Canceled, err)
+}
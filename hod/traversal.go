@@ -227,6 +227,9 @@ func (cursor *Cursor) getPredicatesFromSubject(subjectKey EntityKey) ([]EntityKe
 }
 
 func (cursor *Cursor) iterAllEntities(F func(EntityKey, *Entity) bool) error {
+	if cursor.layered {
+		return cursor.iterLayeredEntities(F)
+	}
 	return cursor.Iterate(F)
 }
 
@@ -0,0 +1,303 @@
+package hod
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/proto"
+	logpb "github.com/gtfierro/hoddb/proto"
+	"github.com/pkg/errors"
+)
+
+// Every write to a graph (loading it, adding triples, deleting triples) creates
+// a new version of that graph. Versions are the Unix nanosecond timestamp of the
+// write. Entities are stored under their key with the Version slot set to the
+// version that wrote them, so reading an entity at a version means finding the
+// most recent copy of it at or before that version. An empty value marks an
+// entity that was removed from the graph at that version.
+
+// prefix for the keys recording the committed versions of each graph
+var versionpfx = []byte("versionpfx")
+
+// reads the newest state of an entity, including any write in progress. Only
+// writes read at this version; queries read the newest committed version
+const latestVersion = math.MaxUint64
+
+func versionKey(graphname string, version uint64) []byte {
+	var v = make([]byte, 8)
+	binary.BigEndian.PutUint64(v, version)
+	var b []byte
+	b = append(b, versionpfx...)
+	b = append(b, hashString(graphname)...)
+	b = append(b, v...)
+	return b
+}
+
+// returns the key under which this entity is stored for the given version
+func (key EntityKey) atVersion(version uint64) EntityKey {
+	binary.BigEndian.PutUint64(key.Version[:], version)
+	return key
+}
+
+func (key EntityKey) version() uint64 {
+	return binary.BigEndian.Uint64(key.Version[:])
+}
+
+// returns the key of the entity without its version
+func (key EntityKey) unversioned() EntityKey {
+	key.Version = _e8
+	return key
+}
+
+// a record of a write to a graph, stored under the version it created
+type versionEntry struct {
+	Graph   string
+	Version uint64
+	// number of triples the write was asked to add or remove, not counting
+	// the triples the inference rules generate
+	Added   int
+	Removed int
+	// hash of the files the graph was loaded from, if the write loaded a file bundle
+	Source []byte
+}
+
+// runs the write as a new version of the graph. Writes are serialized; the new
+// version is only visible to queries once the write succeeds, and the entities
+// the write stored are removed if it fails. The write fills in the triple
+// counts and source of the version's entry.
+func (hod *HodDB) newVersion(graphname string, write func(entry *versionEntry) error) error {
+	hod.writeLock.Lock()
+	defer hod.writeLock.Unlock()
+
+	latest, err := hod.listVersions(graphname, logpb.TimeFilter_At, 0, 1)
+	if err != nil {
+		return errors.Wrap(err, "Could not read graph versions")
+	}
+	entry := &versionEntry{
+		Graph:   graphname,
+		Version: uint64(time.Now().UnixNano()),
+	}
+	if len(latest) > 0 && entry.Version <= latest[0].Version {
+		entry.Version = latest[0].Version + 1
+	}
+
+	hod.Lock()
+	hod.pending[graphname] = entry.Version
+	hod.Unlock()
+	defer func() {
+		hod.Lock()
+		delete(hod.pending, graphname)
+		hod.Unlock()
+	}()
+
+	// the IDs the write assigned are saved even if it failed, as other
+	// entities may use them by the time it fails
+	err = write(entry)
+	if flushErr := hod.flushDictionary(); err == nil {
+		err = flushErr
+	}
+	if err == nil {
+		err = hod.commitVersion(entry)
+	}
+	if err != nil {
+		if rollbackErr := hod.rollbackVersion(graphname, entry.Version); rollbackErr != nil {
+			log.Error(errors.Wrapf(rollbackErr, "Could not remove failed write to graph %s", graphname))
+		}
+		return err
+	}
+	return nil
+}
+
+// records the version written by a successful write
+func (hod *HodDB) commitVersion(entry *versionEntry) error {
+	if err := hod.saveNamespaces(entry.Graph); err != nil {
+		return errors.Wrap(err, "Could not save namespaces")
+	}
+	serialized, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "Could not serialize version")
+	}
+	return hod.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(versionKey(entry.Graph, entry.Version), serialized)
+	})
+}
+
+// removes the entities, and the records of asserted triples, that a failed
+// write stored at the version it was writing. They are found by scanning the
+// graph, as writes do not keep track of what they stored
+func (hod *HodDB) rollbackVersion(graphname string, version uint64) error {
+	var keys [][]byte
+	prefix := hashString(graphname)
+	asserted := append(append([]byte{}, assertedpfx...), prefix...)
+	err := hod.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			key := it.Item().Key()
+			if len(key) == 16 && EntityKeyFromBytes(key).version() == version {
+				keys = append(keys, it.Item().KeyCopy(nil))
+			}
+		}
+		for it.Seek(asserted); it.ValidForPrefix(asserted); it.Next() {
+			if assertedVersion(it.Item().Key()) == version {
+				keys = append(keys, it.Item().KeyCopy(nil))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	wb := hod.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range keys {
+		if err := wb.Delete(key); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+// returns the version being written to the graph by the write in progress
+func (hod *HodDB) writeVersion(graphname string) (uint64, error) {
+	version, found := hod.pendingVersion(graphname)
+	if !found {
+		return 0, errors.Errorf("No write in progress for graph %s", graphname)
+	}
+	return version, nil
+}
+
+// returns the version being written to the graph, if a write is in progress
+func (hod *HodDB) pendingVersion(graphname string) (uint64, bool) {
+	hod.RLock()
+	defer hod.RUnlock()
+	version, found := hod.pending[graphname]
+	return version, found
+}
+
+// returns the committed versions of the graph matching the time filter. At and
+// Before list versions at (or strictly before) the timestamp, newest first; a
+// zero timestamp with At lists all versions. After lists versions strictly after
+// the timestamp, oldest first. A limit <= 0 returns all matching versions
+func (hod *HodDB) listVersions(graphname string, filter logpb.TimeFilter, timestamp int64, limit int) ([]versionEntry, error) {
+	var ts uint64
+	if timestamp > 0 {
+		ts = uint64(timestamp)
+	}
+	opts := badger.DefaultIteratorOptions
+	var start []byte
+	switch filter {
+	case logpb.TimeFilter_Before:
+		if ts == 0 {
+			return nil, nil
+		}
+		opts.Reverse = true
+		start = versionKey(graphname, ts-1)
+	case logpb.TimeFilter_After:
+		if ts == latestVersion {
+			return nil, nil
+		}
+		start = versionKey(graphname, ts+1)
+	default:
+		if ts == 0 {
+			ts = latestVersion
+		}
+		opts.Reverse = true
+		start = versionKey(graphname, ts)
+	}
+
+	var entries []versionEntry
+	prefix := append(append([]byte{}, versionpfx...), hashString(graphname)...)
+	err := hod.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(start); it.ValidForPrefix(prefix); it.Next() {
+			if limit > 0 && len(entries) >= limit {
+				break
+			}
+			item := it.Item()
+			entry := versionEntry{
+				Graph:   graphname,
+				Version: binary.BigEndian.Uint64(item.Key()[len(prefix):]),
+			}
+			err := item.Value(func(v []byte) error {
+				// versions recorded before their entries were stored have no value
+				if len(v) == 0 {
+					return nil
+				}
+				return json.Unmarshal(v, &entry)
+			})
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// picks the version of the graph that a query with the given time filter should
+// read. A zero timestamp with the At filter reads the newest committed version.
+// The At filter with the version being written by a write in progress reads
+// what the write has stored so far; this is how rules see the triples they are
+// adding. Returns false if no version of the graph matches.
+func (hod *HodDB) resolveVersion(graphname string, filter logpb.TimeFilter, timestamp int64) (uint64, bool, error) {
+	pending, writing := hod.pendingVersion(graphname)
+	if writing && filter == logpb.TimeFilter_At && timestamp > 0 && uint64(timestamp) == pending {
+		return pending, true, nil
+	}
+
+	latest, err := hod.listVersions(graphname, logpb.TimeFilter_At, 0, 1)
+	if err != nil {
+		return 0, false, err
+	} else if len(latest) == 0 {
+		// nothing is committed while the first write to a graph is in
+		// progress, but graphs written before versions were recorded only
+		// have the one version
+		return latestVersion, !writing, nil
+	} else if timestamp == 0 && filter == logpb.TimeFilter_At {
+		return latest[0].Version, true, nil
+	}
+
+	versions, err := hod.listVersions(graphname, filter, timestamp, 1)
+	if err != nil || len(versions) == 0 {
+		return 0, false, err
+	}
+	return versions[0].Version, true, nil
+}
+
+// returns the entity as of the given version. Returns badger.ErrKeyNotFound if
+// the entity was not part of the graph at that version
+func (hod *HodDB) getEntityAt(key EntityKey, version uint64) (*Entity, error) {
+	key = key.unversioned()
+	var entity = &Entity{
+		compiled: new(logpb.Entity),
+		key:      key,
+	}
+	prefix := key.Bytes()[:8]
+	err := hod.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Reverse = true
+		opts.Prefix = prefix
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		it.Seek(key.atVersion(version).Bytes())
+		if !it.ValidForPrefix(prefix) {
+			return badger.ErrKeyNotFound
+		}
+		return it.Item().Value(func(b []byte) error {
+			if len(b) == 0 {
+				return badger.ErrKeyNotFound
+			}
+			return proto.Unmarshal(b, entity.compiled)
+		})
+	})
+	return entity, err
+}
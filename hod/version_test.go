@@ -0,0 +1,273 @@
+package hod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+	turtle "github.com/gtfierro/hoddb/turtle"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestQueryVersions(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	beforeLoad := time.Now().UnixNano()
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+	afterLoad := time.Now().UnixNano()
+
+	bldg := "http://buildsys.org/ontologies/building_example#"
+	brick := "https://brickschema.org/schema/1.1/Brick#"
+	brickframe := "https://brickschema.org/schema/1.1/BrickFrame#"
+	rdftype := turtle.URI{Namespace: RDF_NAMESPACE, Value: "type"}
+	err = hod.AddTriples("test", turtle.DataSet{
+		Triples: []turtle.Triple{
+			{
+				Subject:   turtle.ParseURI(bldg + "room_2"),
+				Predicate: rdftype,
+				Object:    turtle.ParseURI(brick + "Room"),
+			},
+		},
+	})
+	require.NoError(err, "add triples")
+	afterAdd := time.Now().UnixNano()
+
+	err = hod.DeleteTriples("test", turtle.DataSet{
+		Triples: []turtle.Triple{
+			{
+				Subject:   turtle.ParseURI(bldg + "ahu_1"),
+				Predicate: turtle.ParseURI(brickframe + "feeds"),
+				Object:    turtle.ParseURI(bldg + "vav_1"),
+			},
+		},
+	})
+	require.NoError(err, "delete triples")
+
+	for _, test := range []struct {
+		query   string
+		results int
+	}{
+		{"SELECT ?r FROM test WHERE { ?r rdf:type brick:Room }", 2},
+		{"SELECT ?r FROM test WHERE { ?r rdf:type brick:Room } AT now", 2},
+		{fmt.Sprintf("SELECT ?r FROM test WHERE { ?r rdf:type brick:Room } AT %d", afterLoad), 1},
+		{fmt.Sprintf("SELECT ?r FROM test WHERE { ?r rdf:type brick:Room } AT %d", afterAdd), 2},
+		{fmt.Sprintf("SELECT ?r FROM test WHERE { ?r rdf:type brick:Room } AFTER %d", beforeLoad), 1},
+		{fmt.Sprintf("SELECT ?r FROM test WHERE { ?r rdf:type brick:Room } AT %d", beforeLoad), 0},
+		{"SELECT ?x ?y FROM test WHERE { ?x bf:feeds ?y }", 1},
+		{fmt.Sprintf("SELECT ?x ?y FROM test WHERE { ?x bf:feeds ?y } AT %d", afterAdd), 2},
+		{fmt.Sprintf("SELECT ?x ?y FROM test WHERE { ?x bf:isFedBy ?y } AT %d", afterAdd), 2},
+		{fmt.Sprintf("SELECT ?x FROM test WHERE { bldg:ahu_1 bf:feeds+ ?x } AT %d", afterAdd), 2},
+		{"SELECT ?x FROM test WHERE { bldg:ahu_1 bf:feeds+ ?x }", 0},
+		{fmt.Sprintf("SELECT ?x ?y FROM test WHERE { ?x bf:feeds ?y } AFTER %d", afterAdd), 1},
+	} {
+		sq, err := hod.ParseQuery(test.query, 0)
+		require.NoError(err, test.query)
+		resp, err := hod.Select(context.Background(), sq)
+		require.NoError(err, test.query)
+		require.Equal(test.results, len(resp.Rows), test.query)
+	}
+
+	// responses report the version they read; the one before the deletion still has the edge
+	sq, err := hod.ParseQuery("SELECT ?x ?y FROM test WHERE { ?x bf:feeds ?y }", 0)
+	require.NoError(err)
+	resp, err := hod.Select(context.Background(), sq)
+	require.NoError(err)
+	require.True(resp.Version > afterAdd, "version of deletion")
+
+	sq, err = hod.ParseQuery(fmt.Sprintf("SELECT ?x ?y FROM test WHERE { ?x bf:feeds ?y } BEFORE %d", resp.Version), 0)
+	require.NoError(err)
+	resp, err = hod.Select(context.Background(), sq)
+	require.NoError(err)
+	require.Equal(2, len(resp.Rows))
+}
+
+func TestListVersions(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.NotNil(cfg, "config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+
+	bundle := FileBundle{
+		GraphName:     "test",
+		TTLFile:       "example.ttl",
+		OntologyFiles: []string{"BrickFrame.ttl"},
+	}
+	err = hod.Load(bundle)
+	require.NoError(err, "load files")
+	afterLoad := time.Now().UnixNano()
+
+	iq, err := hod.ParseInsertQuery("INSERT { bldg:room_2 rdf:type brick:Room } TO test")
+	require.NoError(err, "parse insert")
+	_, err = hod.Insert(context.Background(), iq)
+	require.NoError(err, "insert")
+
+	dq, err := hod.ParseDeleteQuery("DELETE { ?r rdf:type brick:Room } FROM test WHERE { ?r rdf:type brick:Room }")
+	require.NoError(err, "parse delete")
+	_, err = hod.Delete(context.Background(), dq)
+	require.NoError(err, "delete")
+
+	// newest first
+	vq, err := hod.ParseVersionQuery("LIST VERSIONS FOR test")
+	require.NoError(err, "parse versions")
+	resp, err := hod.Versions(context.Background(), vq)
+	require.NoError(err, "versions")
+	require.Equal(3, len(resp.Rows))
+	for _, row := range resp.Rows {
+		require.Equal("test", row.Values[0].Value)
+	}
+	// delete
+	require.Equal("0", resp.Rows[0].Values[2].Value)
+	require.Equal("2", resp.Rows[0].Values[3].Value)
+	require.Equal("", resp.Rows[0].Values[4].Value)
+	// insert
+	require.Equal("1", resp.Rows[1].Values[2].Value)
+	require.Equal("0", resp.Rows[1].Values[3].Value)
+	// load
+	require.NotEqual("", resp.Rows[2].Values[4].Value)
+
+	vq, err = hod.ParseVersionQuery("LIST VERSIONS FOR test LIMIT 1")
+	require.NoError(err, "parse versions")
+	resp, err = hod.Versions(context.Background(), vq)
+	require.NoError(err, "versions")
+	require.Equal(1, len(resp.Rows))
+	latest := resp.Rows[0].Values[1].Value
+
+	// oldest first
+	vq, err = hod.ParseVersionQuery(fmt.Sprintf("LIST VERSIONS AFTER %d FOR test", afterLoad))
+	require.NoError(err, "parse versions")
+	resp, err = hod.Versions(context.Background(), vq)
+	require.NoError(err, "versions")
+	require.Equal(2, len(resp.Rows))
+	require.Equal("1", resp.Rows[0].Values[2].Value)
+	require.Equal(latest, resp.Rows[1].Values[1].Value)
+
+	vq, err = hod.ParseVersionQuery(fmt.Sprintf("LIST VERSIONS BEFORE %s FOR test", latest))
+	require.NoError(err, "parse versions")
+	resp, err = hod.Versions(context.Background(), vq)
+	require.NoError(err, "versions")
+	require.Equal(2, len(resp.Rows))
+
+	vq, err = hod.ParseVersionQuery("LIST VERSIONS FOR other")
+	require.NoError(err, "parse versions")
+	resp, err = hod.Versions(context.Background(), vq)
+	require.NoError(err, "versions")
+	require.Equal(0, len(resp.Rows))
+}
+
+func TestFailedWrite(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	cfgStr := fmt.Sprintf(`database:
+    path: %s    `, dir)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	defer hod.Close()
+
+	require.NoError(hod.Load(FileBundle{GraphName: "test", TTLFile: "example.ttl", OntologyFiles: []string{"BrickFrame.ttl"}}))
+	versions, err := hod.listVersions("test", 0, 0, 0)
+	require.NoError(err)
+	triples, _, err := hod.graphCounts("test")
+	require.NoError(err)
+
+	rooms := "SELECT ?r FROM test WHERE { ?r rdf:type brick:Room }"
+	room := turtle.Triple{
+		Subject:   turtle.ParseURI("http://buildsys.org/ontologies/building_example#room_9"),
+		Predicate: turtle.URI{Namespace: RDF_NAMESPACE, Value: "type"},
+		Object:    turtle.ParseURI("https://brickschema.org/schema/1.1/Brick#Room"),
+	}
+	failed := errors.New("failed")
+	err = hod.newVersion("test", func(entry *versionEntry) error {
+		if err := hod.markAsserted("test", []turtle.Triple{room}); err != nil {
+			return err
+		}
+		if err := hod.addTriples("test", turtle.DataSet{Triples: []turtle.Triple{room}}); err != nil {
+			return err
+		}
+		// queries do not see a write in progress
+		require.Equal([]string{"room_1"}, queryValues(t, hod, rooms))
+		return failed
+	})
+	require.Equal(failed, err)
+
+	// nor what a failed write stored
+	require.Equal([]string{"room_1"}, queryValues(t, hod, rooms))
+	after, err := hod.listVersions("test", 0, 0, 0)
+	require.NoError(err)
+	require.Equal(versions, after)
+	// the triple is not recorded as added either
+	afterTriples, _, err := hod.graphCounts("test")
+	require.NoError(err)
+	require.Equal(triples, afterTriples)
+	key, found := hod.lookupURI("test", room.Subject)
+	if found {
+		_, err = hod.GetEntity(key)
+		require.Equal(badger.ErrKeyNotFound, err)
+	}
+
+	// or once later writes succeed
+	require.NoError(hod.AddTriples("test", turtle.DataSet{Triples: []turtle.Triple{{Subject: room.Subject, Predicate: room.Predicate, Object: turtle.ParseURI("https://brickschema.org/schema/1.1/Brick#Location")}}}))
+	require.Equal([]string{"room_1"}, queryValues(t, hod, rooms))
+
+	// a failed delete keeps the triples it deleted
+	triples, _, err = hod.graphCounts("test")
+	require.NoError(err)
+	deleted := turtle.Triple{
+		Subject:   turtle.ParseURI("http://buildsys.org/ontologies/building_example#room_1"),
+		Predicate: room.Predicate,
+		Object:    room.Object,
+	}
+	err = hod.newVersion("test", func(entry *versionEntry) error {
+		if _, err := hod.deleteTriples("test", turtle.DataSet{Triples: []turtle.Triple{deleted}}); err != nil {
+			return err
+		}
+		return failed
+	})
+	require.Equal(failed, err)
+	require.Equal([]string{"room_1"}, queryValues(t, hod, rooms))
+	afterTriples, _, err = hod.graphCounts("test")
+	require.NoError(err)
+	require.Equal(triples, afterTriples)
+	// and they can still be deleted
+	require.NoError(hod.DeleteTriples("test", turtle.DataSet{Triples: []turtle.Triple{deleted}}))
+	require.Empty(queryValues(t, hod, rooms))
+}
@@ -0,0 +1,87 @@
+package hod
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// the size and modification time of a file, which change when the file does
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// returns the file bundles of the buildings in the config
+func (cfg *Config) buildingBundles() []FileBundle {
+	var bundles []FileBundle
+	for graphname, graphfile := range cfg.Database.Buildings {
+		bundles = append(bundles, FileBundle{
+			GraphName:     graphname,
+			TTLFile:       graphfile,
+			OntologyFiles: cfg.Database.Ontologies,
+		})
+	}
+	return bundles
+}
+
+// checks the files of the buildings in the config every interval until stop is
+// closed, and reloads the buildings whose files have changed. Files are polled
+// rather than watched for events, so files that editors replace instead of
+// writing to are noticed too
+func (hod *HodDB) watchBuildings(interval time.Duration, stop chan struct{}) {
+	bundles := hod.cfg.buildingBundles()
+	stamps := make(map[string]fileStamp)
+	// returns the files that changed since the last check
+	check := func() map[string]bool {
+		changed := make(map[string]bool)
+		for _, bundle := range bundles {
+			for _, file := range bundle.files() {
+				if _, checked := changed[file]; checked {
+					continue
+				}
+				info, err := os.Stat(file)
+				if err != nil {
+					// the file may be in the middle of being replaced
+					log.Warning(errors.Wrapf(err, "Could not check file %s", file))
+					continue
+				}
+				stamp := fileStamp{size: info.Size(), modTime: info.ModTime()}
+				last, found := stamps[file]
+				changed[file] = found && last != stamp
+				stamps[file] = stamp
+			}
+		}
+		return changed
+	}
+
+	check()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		changed := check()
+		for _, bundle := range bundles {
+			for _, file := range bundle.files() {
+				if !changed[file] {
+					continue
+				}
+				if err := hod.reloadBuilding(bundle); err != nil {
+					log.Error(errors.Wrapf(err, "Could not reload building %s", bundle.GraphName))
+				}
+				break
+			}
+		}
+	}
+}
+
+func (hod *HodDB) reloadBuilding(bundle FileBundle) error {
+	hod.graphLock.Lock()
+	defer hod.graphLock.Unlock()
+	return hod.Load(bundle)
+}
@@ -0,0 +1,67 @@
+package hod
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchBuildings(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "_log_test_")
+	require.NoError(err)
+	defer os.RemoveAll(dir) // clean up
+
+	file := filepath.Join(dir, "building.ttl")
+	example, err := ioutil.ReadFile("example.ttl")
+	require.NoError(err)
+	require.NoError(ioutil.WriteFile(file, example, 0600))
+
+	cfgStr := fmt.Sprintf(`
+database:
+    path: %s
+    buildings:
+        test: %s
+    ontologies:
+        - BrickFrame.ttl
+    watchFiles: true
+    watchInterval: 50ms
+    `, dir, file)
+	cfg, err := ReadConfigFromString(cfgStr)
+	require.NoError(err, "read config")
+	require.True(cfg.Database.WatchFiles)
+	require.Equal(50*time.Millisecond, cfg.Database.WatchInterval)
+
+	hod, err := MakeHodDB(cfg)
+	require.NoError(err, "open log")
+	require.NotNil(hod, "log")
+	defer hod.Close()
+
+	rooms := "SELECT ?r FROM test WHERE { ?r rdf:type brick:Room }"
+	require.Equal([]string{"room_1"}, queryValues(t, hod, rooms))
+
+	// the graph is updated soon after the file is edited
+	editExample(t, file)
+	deadline := time.Now().Add(10 * time.Second)
+	for len(queryValues(t, hod, rooms)) != 2 && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Equal([]string{"room_1", "room_2"}, queryValues(t, hod, rooms))
+	require.Empty(queryValues(t, hod, "SELECT ?p FROM test WHERE { bldg:floor_1 bf:isPartOf ?p }"))
+
+	// the watch interval must be positive
+	cfg.Database.WatchInterval = 0
+	cfg.Database.Path = filepath.Join(dir, "other")
+	_, err = MakeHodDB(cfg)
+	require.Error(err)
+	// and the database is left closed, so it can be opened again
+	cfg.Database.WatchFiles = false
+	other, err := MakeHodDB(cfg)
+	require.NoError(err, "open other log")
+	require.NoError(other.Close())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gtfierro/hoddb/lang/token"
@@ -19,6 +20,7 @@ const (
 	INSERT_QUERY
 	DELETE_QUERY
 	VERSION_QUERY
+	CONSTRUCT_QUERY
 )
 
 var debug = false
@@ -36,10 +38,14 @@ type Query struct {
 	Time      TimeClause
 	Count     bool
 	Insert    InsertClause
+	Delete    DeleteClause
+	Construct ConstructClause
 	Where     WhereClause
 	Variables []string
 	Version   VersionsQuery
 	Type      QueryType
+	// ORDER BY, LIMIT and OFFSET of a SELECT
+	Modifier SolutionModifier
 }
 
 func (q Query) Dump() {
@@ -53,6 +59,14 @@ func (q Query) IsInsert() bool {
 	return (q.Type & INSERT_QUERY) == INSERT_QUERY
 }
 
+func (q Query) IsDelete() bool {
+	return (q.Type & DELETE_QUERY) == DELETE_QUERY
+}
+
+func (q Query) IsConstruct() bool {
+	return (q.Type & CONSTRUCT_QUERY) == CONSTRUCT_QUERY
+}
+
 func (q Query) IsSelect() bool {
 	return (q.Type & SELECT_QUERY) == SELECT_QUERY
 }
@@ -84,6 +98,8 @@ func (q Query) Copy() *Query {
 		Variables: q.Variables,
 		Where:     q.Where,
 		Insert:    q.Insert,
+		Delete:    q.Delete,
+		Construct: q.Construct,
 		Count:     q.Count,
 		Type:      q.Type,
 	}
@@ -113,7 +129,7 @@ func NewQuery(selectclause, whereclause, timeclause interface{}, count bool) (Qu
 	}
 	q.PopulateVars()
 	if q.Select.AllVars {
-		q.Select.Vars = q.Variables
+		q.Select.Vars = q.namedVariables()
 	}
 	return q, nil
 }
@@ -164,11 +180,20 @@ func NewQueryMulti(selectclause, fromclause, whereclause, timeclause interface{}
 	}
 	q.PopulateVars()
 	if q.Select.AllVars {
-		q.Select.Vars = q.Variables
+		q.Select.Vars = q.namedVariables()
 	}
 	return q, nil
 }
 
+func NewModifiedQuery(selectclause, fromclause, whereclause, modifier, timeclause interface{}) (Query, error) {
+	q, err := NewQueryMulti(selectclause, fromclause, whereclause, timeclause, false)
+	if err != nil {
+		return q, err
+	}
+	q.Modifier = modifier.(SolutionModifier)
+	return q, nil
+}
+
 func NewInsertQueryMulti(insertclause, fromclause, whereclause interface{}, count bool) (Query, error) {
 	if debug {
 		fmt.Printf("%# v", pretty.Formatter(whereclause.(WhereClause)))
@@ -192,6 +217,50 @@ func NewInsertQueryMulti(insertclause, fromclause, whereclause interface{}, coun
 	return q, nil
 }
 
+func NewDeleteQueryMulti(deleteclause, fromclause, whereclause interface{}) (Query, error) {
+	if debug {
+		fmt.Printf("%# v", pretty.Formatter(whereclause.(WhereClause)))
+		fmt.Printf("%# v", pretty.Formatter(deleteclause.(DeleteClause)))
+	}
+	q := Query{
+		Where:  whereclause.(WhereClause),
+		Select: SelectClause{AllVars: true},
+		From:   fromclause.(FromClause),
+		Delete: deleteclause.(DeleteClause),
+		Type:   DELETE_QUERY,
+	}
+	if q.From.Empty() {
+		q.From.AllDBs = true
+	}
+	q.PopulateVars()
+	if q.Select.AllVars {
+		q.Select.Vars = q.Variables
+	}
+	return q, nil
+}
+
+func NewConstructQueryMulti(constructclause, fromclause, whereclause interface{}) (Query, error) {
+	if debug {
+		fmt.Printf("%# v", pretty.Formatter(whereclause.(WhereClause)))
+		fmt.Printf("%# v", pretty.Formatter(constructclause.(ConstructClause)))
+	}
+	q := Query{
+		Where:     whereclause.(WhereClause),
+		Select:    SelectClause{AllVars: true},
+		From:      fromclause.(FromClause),
+		Construct: constructclause.(ConstructClause),
+		Type:      CONSTRUCT_QUERY,
+	}
+	if q.From.Empty() {
+		q.From.AllDBs = true
+	}
+	q.PopulateVars()
+	if q.Select.AllVars {
+		q.Select.Vars = q.Variables
+	}
+	return q, nil
+}
+
 func (q *Query) PopulateVars() {
 	vars := make(map[string]int)
 	// get all variables
@@ -209,6 +278,20 @@ func (q *Query) PopulateVars() {
 			AddIfVar(path.Predicate, vars)
 		}
 	}
+	for _, triple := range q.Delete.Terms {
+		AddIfVar(triple.Subject, vars)
+		AddIfVar(triple.Object, vars)
+		for _, path := range triple.Predicates {
+			AddIfVar(path.Predicate, vars)
+		}
+	}
+	for _, triple := range q.Construct.Terms {
+		AddIfVar(triple.Subject, vars)
+		AddIfVar(triple.Object, vars)
+		for _, path := range triple.Predicates {
+			AddIfVar(path.Predicate, vars)
+		}
+	}
 	if q.Where.GraphGroup != nil {
 		VarsFromGroup(*q.Where.GraphGroup, vars)
 	}
@@ -218,6 +301,17 @@ func (q *Query) PopulateVars() {
 	}
 }
 
+// returns the variables of the query that do not stand for blank nodes
+func (q *Query) namedVariables() []string {
+	var vars = []string{}
+	for _, varname := range q.Variables {
+		if !IsBlankVar(varname) {
+			vars = append(vars, varname)
+		}
+	}
+	return vars
+}
+
 func (q Query) IterTriples(f func(t Triple) Triple) {
 	for idx, triple := range q.Where.Terms {
 		q.Where.Terms[idx] = f(triple)
@@ -225,6 +319,12 @@ func (q Query) IterTriples(f func(t Triple) Triple) {
 	for idx, triple := range q.Insert.Terms {
 		q.Insert.Terms[idx] = f(triple)
 	}
+	for idx, triple := range q.Delete.Terms {
+		q.Delete.Terms[idx] = f(triple)
+	}
+	for idx, triple := range q.Construct.Terms {
+		q.Construct.Terms[idx] = f(triple)
+	}
 	if q.Where.GraphGroup != nil {
 		q.Where.GraphGroup.IterTriples(f)
 	}
@@ -247,6 +347,15 @@ func VarsFromGroup(group GraphGroup, m map[string]int) {
 	for _, union := range group.Unions {
 		VarsFromGroup(union, m)
 	}
+	for _, optional := range group.Optionals {
+		VarsFromGroup(optional, m)
+	}
+	for _, graph := range group.Graphs {
+		if strings.HasPrefix(graph.Graph, "?") {
+			m[graph.Graph] = 1
+		}
+		VarsFromGroup(graph.Group, m)
+	}
 }
 
 func (grp GraphGroup) Expand() [][]Triple {
@@ -266,6 +375,31 @@ func (grp GraphGroup) Expand() [][]Triple {
 	return groups
 }
 
+// Like Expand, but keeps the OPTIONAL groups and FILTERs that apply to each
+// alternative. The returned groups have no Unions
+func (grp GraphGroup) Alternatives() []GraphGroup {
+	if len(grp.Unions) == 0 {
+		return []GraphGroup{{
+			Terms:     append([]Triple{}, grp.Terms...),
+			Optionals: append([]GraphGroup{}, grp.Optionals...),
+			Filters:   append([]FilterExpr{}, grp.Filters...),
+			Graphs:    append([]NamedGraphGroup{}, grp.Graphs...),
+		}}
+	}
+	var groups []GraphGroup
+	for _, union := range grp.Unions {
+		for _, subgroup := range union.Alternatives() {
+			groups = append(groups, GraphGroup{
+				Terms:     append(append([]Triple{}, grp.Terms...), subgroup.Terms...),
+				Optionals: append(append([]GraphGroup{}, grp.Optionals...), subgroup.Optionals...),
+				Filters:   append(append([]FilterExpr{}, grp.Filters...), subgroup.Filters...),
+				Graphs:    append(append([]NamedGraphGroup{}, grp.Graphs...), subgroup.Graphs...),
+			})
+		}
+	}
+	return groups
+}
+
 func (grp GraphGroup) Iter(f func(t turtle.URI)) {
 	for _, triple := range grp.Terms {
 		f(triple.Subject)
@@ -277,6 +411,12 @@ func (grp GraphGroup) Iter(f func(t turtle.URI)) {
 	for _, union := range grp.Unions {
 		union.Iter(f)
 	}
+	for _, optional := range grp.Optionals {
+		optional.Iter(f)
+	}
+	for _, graph := range grp.Graphs {
+		graph.Group.Iter(f)
+	}
 }
 
 func (grp *GraphGroup) IterTriples(f func(t Triple) Triple) {
@@ -286,17 +426,102 @@ func (grp *GraphGroup) IterTriples(f func(t Triple) Triple) {
 	for _, union := range grp.Unions {
 		union.IterTriples(f)
 	}
+	for _, optional := range grp.Optionals {
+		optional.IterTriples(f)
+	}
+	for _, graph := range grp.Graphs {
+		graph.Group.IterTriples(f)
+	}
 }
 
 type SelectClause struct {
 	Vars    []string
 	AllVars bool
+	// aggregates in the select list, e.g. (COUNT(?p) AS ?n). Their names are in Vars
+	Aggregates []Aggregate
+	// SELECT DISTINCT removes duplicate rows; SELECT REDUCED permits it
+	Distinct bool
+	Reduced  bool
+}
+
+// an aggregate function computed over the rows of each group
+type Aggregate struct {
+	// one of count, min, max, sample, group_concat
+	Function string
+	// variable the aggregate is computed over; * for COUNT(*)
+	Var      string
+	Distinct bool
+	// separator for group_concat
+	Separator string
+	// name of the variable holding the result
+	Name string
+}
+
+func NewAggregate(function string, _var interface{}, distinct bool, separator interface{}) (Aggregate, error) {
+	agg := Aggregate{
+		Function:  function,
+		Var:       _var.(string),
+		Distinct:  distinct,
+		Separator: " ",
+	}
+	if separator != nil {
+		var err error
+		if agg.Separator, err = ParseQuotedString(separator); err != nil {
+			return agg, err
+		}
+	}
+	return agg, nil
+}
+
+func NameAggregate(agg, name interface{}) (Aggregate, error) {
+	a := agg.(Aggregate)
+	a.Name = name.(string)
+	return a, nil
+}
+
+// the items of a select list are variable names or Aggregates
+func NewSelectList(item interface{}) ([]interface{}, error) {
+	return []interface{}{item}, nil
+}
+
+func AppendSelectList(list, item interface{}) ([]interface{}, error) {
+	return append(list.([]interface{}), item), nil
+}
+
+func NewSelectClauseFromList(list interface{}) (SelectClause, error) {
+	var sc SelectClause
+	for _, item := range list.([]interface{}) {
+		switch item := item.(type) {
+		case string:
+			sc.Vars = append(sc.Vars, item)
+		case Aggregate:
+			for _, varname := range sc.Vars {
+				if varname == item.Name {
+					return sc, fmt.Errorf("Variable %s is already selected", item.Name)
+				}
+			}
+			sc.Vars = append(sc.Vars, item.Name)
+			sc.Aggregates = append(sc.Aggregates, item)
+		}
+	}
+	return sc, nil
 }
 
 func NewAllSelectClause() (SelectClause, error) {
 	return SelectClause{AllVars: true}, nil
 }
 
+// marks the select clause as SELECT DISTINCT, or SELECT REDUCED if reduced is true
+func NewDistinctSelectClause(selectclause interface{}, reduced bool) (SelectClause, error) {
+	sc := selectclause.(SelectClause)
+	if reduced {
+		sc.Reduced = true
+	} else {
+		sc.Distinct = true
+	}
+	return sc, nil
+}
+
 func NewSelectClause(varlist interface{}) (SelectClause, error) {
 	return SelectClause{Vars: varlist.([]string)}, nil
 }
@@ -311,6 +536,26 @@ func NewInsertClause(triples interface{}) (InsertClause, error) {
 	}, nil
 }
 
+type DeleteClause struct {
+	Terms []Triple
+}
+
+func NewDeleteClause(triples interface{}) (DeleteClause, error) {
+	return DeleteClause{
+		Terms: triples.([]Triple),
+	}, nil
+}
+
+type ConstructClause struct {
+	Terms []Triple
+}
+
+func NewConstructClause(triples interface{}) (ConstructClause, error) {
+	return ConstructClause{
+		Terms: triples.([]Triple),
+	}, nil
+}
+
 type FromClause struct {
 	Databases []string
 	AllDBs    bool
@@ -407,6 +652,36 @@ func NewWhereClauseGraphGroup(group interface{}) (WhereClause, error) {
 type GraphGroup struct {
 	Terms  []Triple
 	Unions []GraphGroup
+	// groups joined with OPTIONAL; their variables may be unbound
+	Optionals []GraphGroup
+	// FILTER constraints on the rows of the group
+	Filters []FilterExpr
+	// groups (GRAPH) matched against other graphs and joined with the group
+	Graphs []NamedGraphGroup
+}
+
+// a group of terms matched against a graph other than the one being queried:
+// the named graph, or each graph of the query if Graph is a variable, which is
+// then bound to the name of the graph
+type NamedGraphGroup struct {
+	Graph string
+	Group GraphGroup
+}
+
+// a GRAPH pattern; the graph is a variable, a graph name or a <graph name>
+func NewNamedGraphGroup(graph, group interface{}) (GraphGroup, error) {
+	var name string
+	if tok, ok := graph.(*token.Token); ok {
+		name = strings.TrimSuffix(strings.TrimPrefix(string(tok.Lit), "<"), ">")
+	} else {
+		name = graph.(string)
+	}
+	if name == "" {
+		return GraphGroup{}, fmt.Errorf("GRAPH needs the name of a graph")
+	}
+	return GraphGroup{
+		Graphs: []NamedGraphGroup{{Graph: name, Group: group.(GraphGroup)}},
+	}, nil
 }
 
 func GraphGroupFromTriples(triples interface{}) (GraphGroup, error) {
@@ -415,6 +690,18 @@ func GraphGroupFromTriples(triples interface{}) (GraphGroup, error) {
 	}, nil
 }
 
+func NewOptionalGraphGroup(group interface{}) (GraphGroup, error) {
+	return GraphGroup{
+		Optionals: []GraphGroup{group.(GraphGroup)},
+	}, nil
+}
+
+func NewFilterGraphGroup(expr interface{}) (GraphGroup, error) {
+	return GraphGroup{
+		Filters: []FilterExpr{expr.(FilterExpr)},
+	}, nil
+}
+
 func GraphGroupUnion(left, right interface{}) (GraphGroup, error) {
 	return GraphGroup{
 		Unions: []GraphGroup{left.(GraphGroup), right.(GraphGroup)},
@@ -423,16 +710,121 @@ func GraphGroupUnion(left, right interface{}) (GraphGroup, error) {
 
 func AddTriplesToGraphGroup(left, triples interface{}) (GraphGroup, error) {
 	return GraphGroup{
-		Terms:  append(left.(GraphGroup).Terms, triples.([]Triple)...),
-		Unions: left.(GraphGroup).Unions,
+		Terms:     append(left.(GraphGroup).Terms, triples.([]Triple)...),
+		Unions:    left.(GraphGroup).Unions,
+		Optionals: left.(GraphGroup).Optionals,
+		Filters:   left.(GraphGroup).Filters,
+		Graphs:    left.(GraphGroup).Graphs,
 	}, nil
 }
 
+// joins two groups; both must match. If both groups have alternatives, each
+// combination of an alternative from the left and one from the right is an
+// alternative of the joined group
 func MergeGraphGroups(left, right interface{}) (GraphGroup, error) {
-	return GraphGroup{
-		Terms:  append(left.(GraphGroup).Terms, right.(GraphGroup).Terms...),
-		Unions: append(left.(GraphGroup).Unions, right.(GraphGroup).Unions...),
-	}, nil
+	l, r := left.(GraphGroup), right.(GraphGroup)
+	merged := GraphGroup{
+		Terms:     append(append([]Triple{}, l.Terms...), r.Terms...),
+		Optionals: append(append([]GraphGroup{}, l.Optionals...), r.Optionals...),
+		Filters:   append(append([]FilterExpr{}, l.Filters...), r.Filters...),
+		Graphs:    append(append([]NamedGraphGroup{}, l.Graphs...), r.Graphs...),
+	}
+	if len(l.Unions) == 0 || len(r.Unions) == 0 {
+		merged.Unions = append(append([]GraphGroup{}, l.Unions...), r.Unions...)
+		return merged, nil
+	}
+	for _, lunion := range l.Unions {
+		for _, runion := range r.Unions {
+			joined, _ := MergeGraphGroups(lunion, runion)
+			merged.Unions = append(merged.Unions, joined)
+		}
+	}
+	return merged, nil
+}
+
+func JoinGraphGroups(left, group, triples interface{}) (GraphGroup, error) {
+	merged, err := MergeGraphGroups(left, group)
+	if err != nil {
+		return merged, err
+	}
+	return AddTriplesToGraphGroup(merged, triples)
+}
+
+// an expression in a FILTER constraint. Operators and functions have an Op
+// and Args; variables and constant terms only have a Value
+type FilterExpr struct {
+	Op    string
+	Args  []FilterExpr
+	Value turtle.URI
+}
+
+// the number of arguments each FILTER function takes
+var filterFunctions = map[string][2]int{
+	"regex":     {2, 3},
+	"strstarts": {2, 2},
+	"contains":  {2, 2},
+	"isuri":     {1, 1},
+	"isliteral": {1, 1},
+	"isblank":   {1, 1},
+	"bound":     {1, 1},
+}
+
+func NewFilterExpr(op string, args ...interface{}) (FilterExpr, error) {
+	expr := FilterExpr{Op: op}
+	for _, arg := range args {
+		expr.Args = append(expr.Args, arg.(FilterExpr))
+	}
+	return expr, nil
+}
+
+func NewFilterCall(name, args interface{}) (FilterExpr, error) {
+	fname := strings.ToLower(name.(string))
+	// isIRI is another name for isURI
+	if fname == "isiri" {
+		fname = "isuri"
+	}
+	expr := FilterExpr{Op: fname, Args: args.([]FilterExpr)}
+	nargs, found := filterFunctions[fname]
+	if !found {
+		return expr, fmt.Errorf("Unknown FILTER function %s", name)
+	}
+	if len(expr.Args) < nargs[0] || len(expr.Args) > nargs[1] {
+		return expr, fmt.Errorf("Wrong number of arguments to %s", name)
+	}
+	if fname == "bound" && (len(expr.Args[0].Op) > 0 || !expr.Args[0].Value.IsVariable()) {
+		return expr, fmt.Errorf("Argument to %s must be a variable", name)
+	}
+	return expr, nil
+}
+
+func NewFilterArgs(expr interface{}) ([]FilterExpr, error) {
+	return []FilterExpr{expr.(FilterExpr)}, nil
+}
+
+func AppendFilterArg(args, expr interface{}) ([]FilterExpr, error) {
+	return append(args.([]FilterExpr), expr.(FilterExpr)), nil
+}
+
+// a variable or a URI in a FILTER expression
+func NewFilterTerm(value interface{}) (FilterExpr, error) {
+	if tok, ok := value.(*token.Token); ok {
+		value = string(tok.Lit)
+	}
+	return FilterExpr{Value: turtle.ParseURI(value.(string))}, nil
+}
+
+// a literal in a FILTER expression
+func NewFilterValue(value interface{}) (FilterExpr, error) {
+	return FilterExpr{Value: value.(turtle.URI)}, nil
+}
+
+// an unquoted word in a FILTER expression: numbers and booleans are typed, any
+// other word is a plain string
+func NewFilterLiteral(value interface{}) (FilterExpr, error) {
+	if uri, err := NewNumber(value); err == nil {
+		return FilterExpr{Value: uri}, nil
+	}
+	return FilterExpr{Value: turtle.URI{Value: string(value.(*token.Token).Lit)}}, nil
 }
 
 type Triple struct {
@@ -459,26 +851,127 @@ func (t Triple) Copy() Triple {
 	}
 }
 
-func NewTriple(subject, predicates, object interface{}) (Triple, error) {
-	return Triple{
-		Subject:    subject.(turtle.URI),
-		Predicates: predicates.([]PathPattern),
-		Object:     object.(turtle.URI),
-	}, nil
+// returns the triple, followed by the triples of the property lists of its
+// blank nodes
+func NewTriple(subject, predicates, object interface{}) ([]Triple, error) {
+	triple := Triple{Predicates: predicates.([]PathPattern)}
+	var subjectTriples, objectTriples []Triple
+	triple.Subject, subjectTriples = blankNodeTerm(subject)
+	triple.Object, objectTriples = blankNodeTerm(object)
+	return append(append([]Triple{triple}, subjectTriples...), objectTriples...), nil
 }
 
-func NewTripleBlock(triple interface{}) ([]Triple, error) {
-	return []Triple{triple.(Triple)}, nil
+func NewTripleBlock(triples interface{}) ([]Triple, error) {
+	return triples.([]Triple), nil
 }
 
-func AppendTripleBlock(block, triple interface{}) ([]Triple, error) {
-	return append(block.([]Triple), triple.(Triple)), nil
+func AppendTripleBlock(block, triples interface{}) ([]Triple, error) {
+	return append(block.([]Triple), triples.([]Triple)...), nil
+}
+
+// prefix of the variables that stand for the blank nodes in a query pattern. No
+// variable written in a query has it, so they are never selected by SELECT *
+const BlankVarPrefix = "?_:"
+
+// a blank node in a query pattern: a variable, and the triples of its property
+// list
+type BlankNode struct {
+	Term    turtle.URI
+	Triples []Triple
+}
+
+// counts the anonymous blank nodes that have been parsed, so each has its own
+// variable
+var anonymousBlankNodes uint64
+
+// an anonymous blank node ([] or [ predicate object ; ... ]). The triples of the
+// property list are missing their subject, which is the blank node
+func NewBlankNode(properties interface{}) (BlankNode, error) {
+	n := atomic.AddUint64(&anonymousBlankNodes, 1)
+	node := BlankNode{Term: turtle.URI{Value: fmt.Sprintf("%s[%d]", BlankVarPrefix, n)}}
+	if properties != nil {
+		for _, triple := range properties.([]Triple) {
+			if triple.Subject.IsEmpty() {
+				triple.Subject = node.Term
+			}
+			node.Triples = append(node.Triples, triple)
+		}
+	}
+	return node, nil
+}
+
+func NewPropertyList(predicates, object interface{}) ([]Triple, error) {
+	return NewTriple(turtle.URI{}, predicates, object)
+}
+
+func AppendPropertyList(list, predicates, object interface{}) ([]Triple, error) {
+	triples, err := NewTriple(turtle.URI{}, predicates, object)
+	return append(list.([]Triple), triples...), err
+}
+
+// returns the term of a triple, and the triples of its property list if it is a
+// blank node
+func blankNodeTerm(term interface{}) (turtle.URI, []Triple) {
+	if node, ok := term.(BlankNode); ok {
+		return node.Term, node.Triples
+	}
+	return term.(turtle.URI), nil
+}
+
+// true if the variable stands for a blank node in the query
+func IsBlankVar(varname string) bool {
+	return strings.HasPrefix(varname, BlankVarPrefix)
 }
 
 func NewURI(value interface{}) (turtle.URI, error) {
 	return turtle.ParseURI(value.(string)), nil
 }
 
+// an IRI, or a labeled blank node (_:label)
+func NewIRI(value interface{}) (turtle.URI, error) {
+	s := string(value.(*token.Token).Lit)
+	if strings.HasPrefix(s, "_:") {
+		return turtle.URI{Value: BlankVarPrefix + s[2:]}, nil
+	}
+	return turtle.ParseURI(s), nil
+}
+
+// a quoted string, with an optional language tag (@en) or datatype
+// (^^xsd:double). Prefixed datatypes are expanded with the graph's namespaces
+func NewLiteral(value, lang, datatype interface{}) (turtle.URI, error) {
+	s := string(value.(*token.Token).Lit)
+	uri := turtle.URI{Value: s[1 : len(s)-1]}
+	if lang != nil {
+		uri.Lang = strings.TrimPrefix(string(lang.(*token.Token).Lit), "@")
+	}
+	if datatype != nil {
+		uri.Datatype = strings.Trim(string(datatype.(*token.Token).Lit), "<>")
+	}
+	return uri, nil
+}
+
+// a number or boolean written without quotes, typed as it is in Turtle
+func NewNumber(value interface{}) (turtle.URI, error) {
+	s := string(value.(*token.Token).Lit)
+	var datatype string
+	digits := strings.TrimPrefix(s, "-")
+	_, interr := strconv.ParseInt(s, 10, 64)
+	_, floaterr := strconv.ParseFloat(s, 64)
+	switch {
+	case s == "true" || s == "false":
+		datatype = "boolean"
+	case len(digits) == 0 || digits[0] < '0' || digits[0] > '9' || floaterr != nil:
+		return turtle.URI{}, fmt.Errorf("Expected a number or a boolean, got %s", s)
+	case interr == nil:
+		datatype = "integer"
+	case strings.ContainsAny(s, "eE"):
+		datatype = "double"
+	default:
+		datatype = "decimal"
+	}
+	return turtle.URI{Value: s, Datatype: turtle.XSD_NAMESPACE + "#" + datatype}, nil
+}
+
 func NewVarList(_var interface{}) ([]string, error) {
 	return []string{_var.(string)}, nil
 }
@@ -618,6 +1111,54 @@ func NewVersionQuery(timeclause, graphselection, limit interface{}) (Query, erro
 	return q, nil
 }
 
+// the GROUP BY, ORDER BY, LIMIT and OFFSET of a query. A negative Limit means no limit
+type SolutionModifier struct {
+	GroupBy []string
+	Order   []OrderCondition
+	Limit   int
+	Offset  int
+}
+
+type OrderCondition struct {
+	Var        string
+	Descending bool
+}
+
+func NewSolutionModifier(order, limit, offset interface{}) (SolutionModifier, error) {
+	sm := SolutionModifier{
+		Limit:  limit.(int),
+		Offset: offset.(int),
+	}
+	if order != nil {
+		sm.Order = order.([]OrderCondition)
+	}
+	if sm.Offset < 0 {
+		return sm, fmt.Errorf("OFFSET must not be negative")
+	}
+	return sm, nil
+}
+
+func NewGroupModifier(groupby, modifier interface{}) (SolutionModifier, error) {
+	sm := SolutionModifier{Limit: -1}
+	if modifier != nil {
+		sm = modifier.(SolutionModifier)
+	}
+	sm.GroupBy = groupby.([]string)
+	return sm, nil
+}
+
+func NewOrderConditions(cond interface{}) ([]OrderCondition, error) {
+	return []OrderCondition{cond.(OrderCondition)}, nil
+}
+
+func AppendOrderCondition(conds, cond interface{}) ([]OrderCondition, error) {
+	return append(conds.([]OrderCondition), cond.(OrderCondition)), nil
+}
+
+func NewOrderCondition(_var interface{}, descending bool) (OrderCondition, error) {
+	return OrderCondition{Var: _var.(string), Descending: descending}, nil
+}
+
 func ParseNumber(i interface{}) (int, error) {
 	//string(i.(*token.Token).Lit)
 	integer, err := strconv.ParseInt(string(i.(*token.Token).Lit), 10, 64)
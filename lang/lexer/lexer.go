@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 97
-	NumSymbols = 110
+	NumStates  = 256
+	NumSymbols = 309
 )
 
 type Lexer struct {
@@ -21,76 +21,112 @@ var TransTab = TransitionTable{
 			return 1
 		case r == 32: // [' ',' ']
 			return 1
-		case r == 34: // ['"','"']
+		case r == 33: // ['!','!']
 			return 2
-		case r == 40: // ['(','(']
+		case r == 34: // ['"','"']
 			return 3
-		case r == 41: // [')',')']
+		case r == 38: // ['&','&']
 			return 4
-		case r == 42: // ['*','*']
+		case r == 40: // ['(','(']
 			return 5
-		case r == 43: // ['+','+']
+		case r == 41: // [')',')']
 			return 6
-		case r == 45: // ['-','-']
+		case r == 42: // ['*','*']
 			return 7
-		case r == 46: // ['.','.']
+		case r == 43: // ['+','+']
 			return 8
-		case r == 47: // ['/','/']
+		case r == 44: // [',',',']
 			return 9
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 45: // ['-','-']
 			return 10
-		case r == 60: // ['<','<']
+		case r == 46: // ['.','.']
 			return 11
-		case r == 63: // ['?','?']
+		case r == 47: // ['/','/']
 			return 12
-		case r == 65: // ['A','A']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 13
-		case r == 66: // ['B','B']
+		case r == 59: // [';',';']
 			return 14
-		case r == 67: // ['C','C']
+		case r == 60: // ['<','<']
 			return 15
-		case 68 <= r && r <= 69: // ['D','E']
+		case r == 61: // ['=','=']
 			return 16
-		case r == 70: // ['F','F']
+		case r == 62: // ['>','>']
 			return 17
-		case 71 <= r && r <= 72: // ['G','H']
-			return 16
-		case r == 73: // ['I','I']
+		case r == 63: // ['?','?']
 			return 18
+		case r == 64: // ['@','@']
+			return 19
+		case r == 65: // ['A','A']
+			return 20
+		case r == 66: // ['B','B']
+			return 21
+		case r == 67: // ['C','C']
+			return 22
+		case r == 68: // ['D','D']
+			return 23
+		case r == 69: // ['E','E']
+			return 24
+		case r == 70: // ['F','F']
+			return 25
+		case r == 71: // ['G','G']
+			return 26
+		case r == 72: // ['H','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 27
 		case 74 <= r && r <= 75: // ['J','K']
-			return 16
+			return 24
 		case r == 76: // ['L','L']
-			return 19
+			return 28
 		case r == 77: // ['M','M']
-			return 16
+			return 29
 		case r == 78: // ['N','N']
-			return 20
-		case 79 <= r && r <= 82: // ['O','R']
-			return 16
+			return 30
+		case r == 79: // ['O','O']
+			return 31
+		case 80 <= r && r <= 81: // ['P','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 32
 		case r == 83: // ['S','S']
-			return 21
+			return 33
 		case r == 84: // ['T','T']
-			return 22
+			return 34
 		case r == 85: // ['U','U']
-			return 23
+			return 35
 		case r == 86: // ['V','V']
-			return 24
+			return 36
 		case r == 87: // ['W','W']
-			return 25
+			return 37
 		case 88 <= r && r <= 90: // ['X','Z']
-			return 16
+			return 24
+		case r == 91: // ['[','[']
+			return 38
+		case r == 93: // [']',']']
+			return 39
+		case r == 94: // ['^','^']
+			return 40
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case r == 97: // ['a','a']
-			return 26
-		case 98 <= r && r <= 122: // ['b','z']
-			return 27
+			return 42
+		case 98 <= r && r <= 104: // ['b','h']
+			return 43
+		case r == 105: // ['i','i']
+			return 44
+		case 106 <= r && r <= 113: // ['j','q']
+			return 43
+		case r == 114: // ['r','r']
+			return 45
+		case 115 <= r && r <= 122: // ['s','z']
+			return 43
 		case r == 123: // ['{','{']
-			return 28
+			return 46
 		case r == 124: // ['|','|']
-			return 29
+			return 47
 		case r == 125: // ['}','}']
-			return 30
+			return 48
 		}
 		return NoState
 	},
@@ -103,21 +139,25 @@ var TransTab = TransitionTable{
 	// S2
 	func(r rune) int {
 		switch {
-		case r == 34: // ['"','"']
-			return 31
-		default:
-			return 2
+		case r == 61: // ['=','=']
+			return 49
 		}
+		return NoState
 	},
 	// S3
 	func(r rune) int {
 		switch {
+		case r == 34: // ['"','"']
+			return 50
+		default:
+			return 3
 		}
-		return NoState
 	},
 	// S4
 	func(r rune) int {
 		switch {
+		case r == 38: // ['&','&']
+			return 51
 		}
 		return NoState
 	},
@@ -136,18 +176,6 @@ var TransTab = TransitionTable{
 	// S7
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 7
-		case 48 <= r && r <= 57: // ['0','9']
-			return 10
-		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
 		}
 		return NoState
 	},
@@ -167,42 +195,29 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 13
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
 	// S11
 	func(r rune) int {
 		switch {
-		case r == 62: // ['>','>']
-			return 33
-		default:
-			return 11
 		}
+		return NoState
 	},
 	// S12
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 34
-		case 48 <= r && r <= 57: // ['0','9']
-			return 35
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
-		case r == 95: // ['_','_']
-			return 34
-		case 97 <= r && r <= 122: // ['a','z']
-			return 37
 		}
 		return NoState
 	},
@@ -210,113 +225,55 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
+		case r == 46: // ['.','.']
+			return 53
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 13
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 69: // ['A','E']
-			return 16
-		case r == 70: // ['F','F']
-			return 38
-		case 71 <= r && r <= 83: // ['G','S']
-			return 16
-		case r == 84: // ['T','T']
-			return 39
-		case 85 <= r && r <= 90: // ['U','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
 	// S14
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 7
-		case 48 <= r && r <= 57: // ['0','9']
-			return 10
-		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 68: // ['A','D']
-			return 16
-		case r == 69: // ['E','E']
-			return 40
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
 		}
 		return NoState
 	},
 	// S15
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 7
-		case 48 <= r && r <= 57: // ['0','9']
-			return 10
-		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 78: // ['A','N']
-			return 16
-		case r == 79: // ['O','O']
-			return 41
-		case 80 <= r && r <= 90: // ['P','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+		case 33 <= r && r <= 59: // ['!',';']
+			return 54
+		case r == 61: // ['=','=']
+			return 55
+		case r == 62: // ['>','>']
+			return 56
+		case 63 <= r && r <= 126: // ['?','~']
+			return 54
+		case 128 <= r && r <= 1114111: // [\u0080,\U0010ffff]
+			return 54
 		}
 		return NoState
 	},
 	// S16
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 7
-		case 48 <= r && r <= 57: // ['0','9']
-			return 10
-		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
 		}
 		return NoState
 	},
 	// S17
 	func(r rune) int {
 		switch {
-		case r == 45: // ['-','-']
-			return 7
-		case 48 <= r && r <= 57: // ['0','9']
-			return 10
-		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 78: // ['A','N']
-			return 16
-		case r == 79: // ['O','O']
-			return 42
-		case 80 <= r && r <= 81: // ['P','Q']
-			return 16
-		case r == 82: // ['R','R']
-			return 43
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+		case r == 61: // ['=','=']
+			return 57
 		}
 		return NoState
 	},
@@ -324,1537 +281,4725 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 58
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
-		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 77: // ['A','M']
-			return 16
-		case r == 78: // ['N','N']
-			return 44
-		case 79 <= r && r <= 90: // ['O','Z']
-			return 16
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 60
 		case r == 95: // ['_','_']
-			return 7
+			return 58
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 61
 		}
 		return NoState
 	},
 	// S19
+	func(r rune) int {
+		switch {
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 62
+		case 97 <= r && r <= 122: // ['a','z']
+			return 63
+		}
+		return NoState
+	},
+	// S20
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 69: // ['A','E']
+			return 24
+		case r == 70: // ['F','F']
+			return 65
+		case 71 <= r && r <= 82: // ['G','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 66
+		case r == 84: // ['T','T']
+			return 67
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S21
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 68
+		case 70 <= r && r <= 78: // ['F','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 69
+		case 80 <= r && r <= 88: // ['P','X']
+			return 24
+		case r == 89: // ['Y','Y']
+			return 70
+		case r == 90: // ['Z','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S22
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 71
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S23
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 72
+		case 70 <= r && r <= 72: // ['F','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 73
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S24
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S25
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 74
+		case 74 <= r && r <= 78: // ['J','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 75
+		case 80 <= r && r <= 81: // ['P','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 76
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S26
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 77
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S27
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 78
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S28
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 79
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S29
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case r == 65: // ['A','A']
+			return 80
+		case 66 <= r && r <= 72: // ['B','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 81
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S30
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case r == 65: // ['A','A']
+			return 82
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S31
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 69: // ['A','E']
+			return 24
+		case r == 70: // ['F','F']
+			return 83
+		case 71 <= r && r <= 79: // ['G','O']
+			return 24
+		case r == 80: // ['P','P']
+			return 84
+		case r == 81: // ['Q','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 85
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S32
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 86
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S33
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case r == 65: // ['A','A']
+			return 87
+		case 66 <= r && r <= 68: // ['B','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 88
+		case 70 <= r && r <= 83: // ['F','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 89
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S34
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 90
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S35
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 91
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S36
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 92
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S37
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 71: // ['A','G']
+			return 24
+		case r == 72: // ['H','H']
+			return 93
+		case 73 <= r && r <= 90: // ['I','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S38
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S39
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S40
+	func(r rune) int {
+		switch {
+		case r == 94: // ['^','^']
+			return 94
+		}
+		return NoState
+	},
+	// S41
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S42
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S43
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S44
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 114: // ['a','r']
+			return 43
+		case r == 115: // ['s','s']
+			return 95
+		case 116 <= r && r <= 122: // ['t','z']
+			return 43
+		}
+		return NoState
+	},
+	// S45
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 100: // ['a','d']
+			return 43
+		case r == 101: // ['e','e']
+			return 96
+		case 102 <= r && r <= 122: // ['f','z']
+			return 43
+		}
+		return NoState
+	},
+	// S46
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S47
+	func(r rune) int {
+		switch {
+		case r == 124: // ['|','|']
+			return 97
+		}
+		return NoState
+	},
+	// S48
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S49
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S50
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S51
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S52
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 98
+		case 48 <= r && r <= 57: // ['0','9']
+			return 99
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 100
+		case r == 95: // ['_','_']
+			return 98
+		case 97 <= r && r <= 122: // ['a','z']
+			return 101
+		}
+		return NoState
+	},
+	// S53
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 102
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		case 33 <= r && r <= 59: // ['!',';']
+			return 54
+		case r == 61: // ['=','=']
+			return 54
+		case r == 62: // ['>','>']
+			return 56
+		case 63 <= r && r <= 126: // ['?','~']
+			return 54
+		case 128 <= r && r <= 1114111: // [\u0080,\U0010ffff]
+			return 54
+		}
+		return NoState
+	},
+	// S55
+	func(r rune) int {
+		switch {
+		case 33 <= r && r <= 59: // ['!',';']
+			return 54
+		case r == 61: // ['=','=']
+			return 54
+		case r == 62: // ['>','>']
+			return 56
+		case 63 <= r && r <= 126: // ['?','~']
+			return 54
+		case 128 <= r && r <= 1114111: // [\u0080,\U0010ffff]
+			return 54
+		}
+		return NoState
+	},
+	// S56
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S57
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S58
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 60
+		case r == 95: // ['_','_']
+			return 58
+		case 97 <= r && r <= 122: // ['a','z']
+			return 61
+		}
+		return NoState
+	},
+	// S59
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 60
+		case r == 95: // ['_','_']
+			return 58
+		case 97 <= r && r <= 122: // ['a','z']
+			return 61
+		}
+		return NoState
+	},
+	// S60
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 60
+		case r == 95: // ['_','_']
+			return 58
+		case 97 <= r && r <= 122: // ['a','z']
+			return 61
+		}
+		return NoState
+	},
+	// S61
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 58
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 60
+		case r == 95: // ['_','_']
+			return 58
+		case 97 <= r && r <= 122: // ['a','z']
+			return 61
+		}
+		return NoState
+	},
+	// S62
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 103
+		case 48 <= r && r <= 57: // ['0','9']
+			return 104
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 62
+		case 97 <= r && r <= 122: // ['a','z']
+			return 63
+		}
+		return NoState
+	},
+	// S63
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 103
+		case 48 <= r && r <= 57: // ['0','9']
+			return 104
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 62
+		case 97 <= r && r <= 122: // ['a','z']
+			return 63
+		}
+		return NoState
+	},
+	// S64
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S65
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 105
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S66
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 106
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S67
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S68
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 69: // ['A','E']
+			return 24
+		case r == 70: // ['F','F']
+			return 107
+		case 71 <= r && r <= 90: // ['G','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S69
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 84: // ['A','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 108
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S70
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S71
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 109
+		case 79 <= r && r <= 84: // ['O','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 110
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S72
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 111
+		case 77 <= r && r <= 82: // ['M','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 112
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S73
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 113
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 114
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 115
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S76
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 116
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S77
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case r == 65: // ['A','A']
+			return 117
+		case 66 <= r && r <= 78: // ['B','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 118
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S78
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 119
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S79
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 76: // ['A','L']
+			return 24
+		case r == 77: // ['M','M']
+			return 120
+		case 78 <= r && r <= 82: // ['N','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 121
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S80
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 87: // ['A','W']
+			return 24
+		case r == 88: // ['X','X']
+			return 122
+		case 89 <= r && r <= 90: // ['Y','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S81
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 123
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 76: // ['A','L']
+			return 24
+		case r == 77: // ['M','M']
+			return 124
+		case 78 <= r && r <= 90: // ['N','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 69: // ['A','E']
+			return 24
+		case r == 70: // ['F','F']
+			return 125
+		case 71 <= r && r <= 90: // ['G','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 126
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 67: // ['A','C']
+			return 24
+		case r == 68: // ['D','D']
+			return 127
+		case 69 <= r && r <= 90: // ['E','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 67: // ['A','C']
+			return 24
+		case r == 68: // ['D','D']
+			return 128
+		case 69 <= r && r <= 70: // ['E','F']
+			return 24
+		case r == 71: // ['G','G']
+			return 129
+		case 72 <= r && r <= 90: // ['H','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 76: // ['A','L']
+			return 24
+		case r == 77: // ['M','M']
+			return 130
+		case 78 <= r && r <= 90: // ['N','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 131
+		case 77 <= r && r <= 79: // ['M','O']
+			return 24
+		case r == 80: // ['P','P']
+			return 132
+		case 81 <= r && r <= 90: // ['Q','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 133
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 134
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 135
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 136
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S95
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case r == 65: // ['A','A']
+			return 24
+		case r == 66: // ['B','B']
+			return 137
+		case 67 <= r && r <= 72: // ['C','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 138
+		case 74 <= r && r <= 75: // ['J','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 139
+		case 77 <= r && r <= 84: // ['M','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 140
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 102: // ['a','f']
+			return 43
+		case r == 103: // ['g','g']
+			return 141
+		case 104 <= r && r <= 122: // ['h','z']
+			return 43
+		}
+		return NoState
+	},
+	// S97
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S98
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 98
+		case 48 <= r && r <= 57: // ['0','9']
+			return 99
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 100
+		case r == 95: // ['_','_']
+			return 98
+		case 97 <= r && r <= 122: // ['a','z']
+			return 101
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 98
+		case 48 <= r && r <= 57: // ['0','9']
+			return 99
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 100
+		case r == 95: // ['_','_']
+			return 98
+		case 97 <= r && r <= 122: // ['a','z']
+			return 101
+		}
+		return NoState
+	},
+	// S100
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 98
+		case 48 <= r && r <= 57: // ['0','9']
+			return 99
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 100
+		case r == 95: // ['_','_']
+			return 98
+		case 97 <= r && r <= 122: // ['a','z']
+			return 101
+		}
+		return NoState
+	},
+	// S101
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 98
+		case 48 <= r && r <= 57: // ['0','9']
+			return 99
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 100
+		case r == 95: // ['_','_']
+			return 98
+		case 97 <= r && r <= 122: // ['a','z']
+			return 101
+		}
+		return NoState
+	},
+	// S102
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 102
+		case r == 69: // ['E','E']
+			return 142
+		case r == 101: // ['e','e']
+			return 142
+		}
+		return NoState
+	},
+	// S103
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 103
+		case 48 <= r && r <= 57: // ['0','9']
+			return 104
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 62
+		case 97 <= r && r <= 122: // ['a','z']
+			return 63
+		}
+		return NoState
+	},
+	// S104
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 103
+		case 48 <= r && r <= 57: // ['0','9']
+			return 104
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 62
+		case 97 <= r && r <= 122: // ['a','z']
+			return 63
+		}
+		return NoState
+	},
+	// S105
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 143
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S106
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 144
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S108
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 145
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S109
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 146
+		case r == 84: // ['T','T']
+			return 147
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S110
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 148
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S111
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 149
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S112
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 150
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S113
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 151
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S114
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 152
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 76: // ['A','L']
+			return 24
+		case r == 77: // ['M','M']
+			return 153
+		case 78 <= r && r <= 90: // ['N','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S117
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 79: // ['A','O']
+			return 24
+		case r == 80: // ['P','P']
+			return 154
+		case 81 <= r && r <= 90: // ['Q','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S118
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 84: // ['A','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 155
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S119
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 156
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S120
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 157
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S121
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 158
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S122
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S123
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S124
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 159
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S125
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 160
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S126
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 161
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S127
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 162
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S128
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 84: // ['A','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 163
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S129
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 164
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S130
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 79: // ['A','O']
+			return 24
+		case r == 80: // ['P','P']
+			return 165
+		case 81 <= r && r <= 90: // ['Q','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S131
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 166
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S132
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case r == 65: // ['A','A']
+			return 167
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S133
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 168
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S134
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 169
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S135
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 170
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S136
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 171
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S137
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 107: // ['a','k']
+			return 43
+		case r == 108: // ['l','l']
+			return 172
+		case 109 <= r && r <= 122: // ['m','z']
+			return 43
+		}
+		return NoState
+	},
+	// S138
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 173
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S139
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 104: // ['a','h']
+			return 43
+		case r == 105: // ['i','i']
+			return 174
+		case 106 <= r && r <= 122: // ['j','z']
+			return 43
+		}
+		return NoState
+	},
+	// S140
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 175
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S141
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 100: // ['a','d']
+			return 43
+		case r == 101: // ['e','e']
+			return 176
+		case 102 <= r && r <= 122: // ['f','z']
+			return 43
+		}
+		return NoState
+	},
+	// S142
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 177
+		case r == 45: // ['-','-']
+			return 177
+		case 48 <= r && r <= 57: // ['0','9']
+			return 178
+		}
+		return NoState
+	},
+	// S143
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 179
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S144
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 180
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S145
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 67: // ['A','C']
+			return 24
+		case r == 68: // ['D','D']
+			return 181
+		case 69 <= r && r <= 90: // ['E','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S146
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 182
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S147
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case r == 65: // ['A','A']
+			return 183
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S148
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 184
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S149
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 185
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S150
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S151
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 186
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S152
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 187
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S153
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S154
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 71: // ['A','G']
+			return 24
+		case r == 72: // ['H','H']
+			return 188
+		case 73 <= r && r <= 90: // ['I','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S155
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 79: // ['A','O']
+			return 24
+		case r == 80: // ['P','P']
+			return 189
+		case 81 <= r && r <= 90: // ['Q','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S156
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 190
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S157
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 191
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S158
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S159
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 192
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S160
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 193
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S161
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 194
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S162
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 195
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S163
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 196
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S164
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 87: // ['A','W']
+			return 24
+		case r == 88: // ['X','X']
+			return 197
+		case 89 <= r && r <= 90: // ['Y','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S165
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 198
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S166
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 199
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S167
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 200
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S168
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 201
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S169
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 202
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S170
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 203
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S171
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 204
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S172
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case r == 97: // ['a','a']
+			return 205
+		case 98 <= r && r <= 122: // ['b','z']
+			return 43
+		}
+		return NoState
+	},
+	// S173
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
 		case 65 <= r && r <= 72: // ['A','H']
-			return 16
+			return 24
 		case r == 73: // ['I','I']
-			return 45
+			return 206
 		case 74 <= r && r <= 90: // ['J','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S20
+	// S174
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case r == 65: // ['A','A']
-			return 46
-		case 66 <= r && r <= 90: // ['B','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
+		case 97 <= r && r <= 115: // ['a','s']
+			return 43
+		case r == 116: // ['t','t']
+			return 207
+		case 117 <= r && r <= 122: // ['u','z']
+			return 43
+		}
+		return NoState
+	},
+	// S175
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 208
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S21
+	// S176
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 119: // ['a','w']
+			return 43
+		case r == 120: // ['x','x']
+			return 209
+		case 121 <= r && r <= 122: // ['y','z']
+			return 43
+		}
+		return NoState
+	},
+	// S177
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 178
+		}
+		return NoState
+	},
+	// S178
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 178
+		}
+		return NoState
+	},
+	// S179
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S180
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
 		case 65 <= r && r <= 68: // ['A','D']
-			return 16
+			return 24
 		case r == 69: // ['E','E']
-			return 47
+			return 210
 		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S22
+	// S181
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 78: // ['A','N']
-			return 16
-		case r == 79: // ['O','O']
-			return 48
-		case 80 <= r && r <= 90: // ['P','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S23
+	// S182
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 77: // ['A','M']
-			return 16
-		case r == 78: // ['N','N']
-			return 49
-		case 79 <= r && r <= 90: // ['O','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 211
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S24
+	// S183
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
+		case 65 <= r && r <= 72: // ['A','H']
+			return 24
+		case r == 73: // ['I','I']
+			return 212
+		case 74 <= r && r <= 90: // ['J','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S184
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
+		}
+		return NoState
+	},
+	// S185
+	func(r rune) int {
+		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
 		case 65 <= r && r <= 68: // ['A','D']
-			return 16
+			return 24
 		case r == 69: // ['E','E']
-			return 50
+			return 213
 		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S25
+	// S186
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 71: // ['A','G']
-			return 16
-		case r == 72: // ['H','H']
-			return 51
-		case 73 <= r && r <= 90: // ['I','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 214
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S26
+	// S187
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 215
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S27
+	// S188
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
-		}
-		return NoState
-	},
-	// S28
-	func(r rune) int {
-		switch {
-		}
-		return NoState
-	},
-	// S29
-	func(r rune) int {
-		switch {
+			return 43
 		}
 		return NoState
 	},
-	// S30
+	// S189
 	func(r rune) int {
 		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 216
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
 		}
 		return NoState
 	},
-	// S31
+	// S190
 	func(r rune) int {
 		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 217
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
 		}
 		return NoState
 	},
-	// S32
+	// S191
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 52
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 53
+			return 64
+		case r == 58: // [':',':']
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 54
+			return 24
 		case r == 95: // ['_','_']
-			return 52
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 55
+			return 43
 		}
 		return NoState
 	},
-	// S33
+	// S192
 	func(r rune) int {
 		switch {
+		case r == 45: // ['-','-']
+			return 41
+		case 48 <= r && r <= 57: // ['0','9']
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
+		case 97 <= r && r <= 122: // ['a','z']
+			return 43
 		}
 		return NoState
 	},
-	// S34
+	// S193
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 34
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 218
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 34
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 37
+			return 43
 		}
 		return NoState
 	},
-	// S35
+	// S194
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 34
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 219
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 34
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 37
+			return 43
 		}
 		return NoState
 	},
-	// S36
+	// S195
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 34
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
+			return 64
+		case r == 58: // [':',':']
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
+			return 24
 		case r == 95: // ['_','_']
-			return 34
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 37
+			return 43
 		}
 		return NoState
 	},
-	// S37
+	// S196
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 34
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 35
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 36
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 220
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 34
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 37
+			return 43
 		}
 		return NoState
 	},
-	// S38
+	// S197
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 83: // ['A','S']
-			return 16
-		case r == 84: // ['T','T']
-			return 56
-		case 85 <= r && r <= 90: // ['U','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S39
+	// S198
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 68: // ['A','D']
+			return 24
+		case r == 69: // ['E','E']
+			return 221
+		case 70 <= r && r <= 90: // ['F','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S40
+	// S199
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 69: // ['A','E']
-			return 16
-		case r == 70: // ['F','F']
-			return 57
-		case 71 <= r && r <= 90: // ['G','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 222
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S41
+	// S200
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 84: // ['A','T']
-			return 16
-		case r == 85: // ['U','U']
-			return 58
-		case 86 <= r && r <= 90: // ['V','Z']
-			return 16
+			return 52
+		case r == 65: // ['A','A']
+			return 223
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S42
+	// S201
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
-		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 16
-		case r == 82: // ['R','R']
-			return 59
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 16
+			return 64
+		case r == 58: // [':',':']
+			return 52
+		case r == 65: // ['A','A']
+			return 224
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S43
+	// S202
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 78: // ['A','N']
-			return 16
-		case r == 79: // ['O','O']
-			return 60
-		case 80 <= r && r <= 90: // ['P','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S44
+	// S203
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 82: // ['A','R']
-			return 16
-		case r == 83: // ['S','S']
-			return 61
-		case 84 <= r && r <= 90: // ['T','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 225
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S45
+	// S204
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 76: // ['A','L']
-			return 16
-		case r == 77: // ['M','M']
-			return 62
-		case 78 <= r && r <= 82: // ['N','R']
-			return 16
-		case r == 83: // ['S','S']
-			return 63
-		case 84 <= r && r <= 90: // ['T','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S46
+	// S205
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
-		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 76: // ['A','L']
-			return 16
-		case r == 77: // ['M','M']
 			return 64
-		case 78 <= r && r <= 90: // ['N','Z']
-			return 16
+		case r == 58: // [':',':']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 41
+		case 97 <= r && r <= 109: // ['a','m']
+			return 43
+		case r == 110: // ['n','n']
+			return 226
+		case 111 <= r && r <= 122: // ['o','z']
+			return 43
 		}
 		return NoState
 	},
-	// S47
+	// S206
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 75: // ['A','K']
-			return 16
-		case r == 76: // ['L','L']
-			return 65
-		case 77 <= r && r <= 90: // ['M','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S48
+	// S207
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 41
+		case 97 <= r && r <= 100: // ['a','d']
+			return 43
+		case r == 101: // ['e','e']
+			return 227
+		case 102 <= r && r <= 122: // ['f','z']
+			return 43
 		}
 		return NoState
 	},
-	// S49
+	// S208
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 72: // ['A','H']
-			return 16
-		case r == 73: // ['I','I']
-			return 66
-		case 74 <= r && r <= 90: // ['J','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S50
+	// S209
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 16
-		case r == 82: // ['R','R']
-			return 67
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S51
+	// S210
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 68: // ['A','D']
-			return 16
-		case r == 69: // ['E','E']
-			return 68
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S52
+	// S211
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 52
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 53
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 54
-		case r == 95: // ['_','_']
+			return 64
+		case r == 58: // [':',':']
 			return 52
+		case 65 <= r && r <= 84: // ['A','T']
+			return 24
+		case r == 85: // ['U','U']
+			return 228
+		case 86 <= r && r <= 90: // ['V','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 55
+			return 43
 		}
 		return NoState
 	},
-	// S53
+	// S212
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 52
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 53
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 54
-		case r == 95: // ['_','_']
+			return 64
+		case r == 58: // [':',':']
 			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 229
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 55
+			return 43
 		}
 		return NoState
 	},
-	// S54
+	// S213
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 52
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 53
+			return 64
+		case r == 58: // [':',':']
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 54
+			return 24
 		case r == 95: // ['_','_']
-			return 52
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 55
+			return 43
 		}
 		return NoState
 	},
-	// S55
+	// S214
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 52
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 53
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 54
-		case r == 95: // ['_','_']
+			return 64
+		case r == 58: // [':',':']
 			return 52
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 230
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 55
+			return 43
 		}
 		return NoState
 	},
-	// S56
+	// S215
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 68: // ['A','D']
-			return 16
-		case r == 69: // ['E','E']
-			return 69
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S57
+	// S216
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 78: // ['A','N']
-			return 16
-		case r == 79: // ['O','O']
-			return 70
-		case 80 <= r && r <= 90: // ['P','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 231
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S58
+	// S217
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 77: // ['A','M']
-			return 16
-		case r == 78: // ['N','N']
-			return 71
-		case 79 <= r && r <= 90: // ['O','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S59
+	// S218
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S60
+	// S219
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 76: // ['A','L']
-			return 16
-		case r == 77: // ['M','M']
-			return 72
-		case 78 <= r && r <= 90: // ['N','Z']
-			return 16
+			return 52
+		case r == 65: // ['A','A']
+			return 232
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S61
+	// S220
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 68: // ['A','D']
-			return 16
-		case r == 69: // ['E','E']
-			return 73
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 67: // ['A','C']
+			return 24
+		case r == 68: // ['D','D']
+			return 233
+		case 69 <= r && r <= 90: // ['E','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S62
+	// S221
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 72: // ['A','H']
-			return 16
-		case r == 73: // ['I','I']
-			return 74
-		case 74 <= r && r <= 90: // ['J','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S63
+	// S222
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 83: // ['A','S']
-			return 16
-		case r == 84: // ['T','T']
-			return 75
-		case 85 <= r && r <= 90: // ['U','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S64
+	// S223
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 68: // ['A','D']
-			return 16
-		case r == 69: // ['E','E']
-			return 76
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 234
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S65
+	// S224
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 68: // ['A','D']
-			return 16
-		case r == 69: // ['E','E']
-			return 77
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 235
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S66
+	// S225
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 78: // ['A','N']
-			return 16
-		case r == 79: // ['O','O']
-			return 78
-		case 80 <= r && r <= 90: // ['P','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 236
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S67
+	// S226
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 82: // ['A','R']
-			return 16
-		case r == 83: // ['S','S']
-			return 79
-		case 84 <= r && r <= 90: // ['T','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 41
+		case 97 <= r && r <= 106: // ['a','j']
+			return 43
+		case r == 107: // ['k','k']
+			return 237
+		case 108 <= r && r <= 122: // ['l','z']
+			return 43
 		}
 		return NoState
 	},
-	// S68
+	// S227
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 16
-		case r == 82: // ['R','R']
-			return 80
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 41
+		case 97 <= r && r <= 113: // ['a','q']
+			return 43
+		case r == 114: // ['r','r']
+			return 238
+		case 115 <= r && r <= 122: // ['s','z']
+			return 43
 		}
 		return NoState
 	},
-	// S69
+	// S228
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 16
-		case r == 82: // ['R','R']
-			return 81
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 239
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S70
+	// S229
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 16
-		case r == 82: // ['R','R']
-			return 82
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 240
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S71
+	// S230
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 83: // ['A','S']
-			return 16
+			return 24
 		case r == 84: // ['T','T']
-			return 83
+			return 241
 		case 85 <= r && r <= 90: // ['U','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S72
+	// S231
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 242
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S73
+	// S232
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 81: // ['A','Q']
-			return 16
-		case r == 82: // ['R','R']
-			return 84
-		case 83 <= r && r <= 90: // ['S','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 75: // ['A','K']
+			return 24
+		case r == 76: // ['L','L']
+			return 243
+		case 77 <= r && r <= 90: // ['M','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S74
+	// S233
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 83: // ['A','S']
-			return 16
-		case r == 84: // ['T','T']
-			return 85
-		case 85 <= r && r <= 90: // ['U','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S75
+	// S234
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 78: // ['A','N']
+			return 24
+		case r == 79: // ['O','O']
+			return 244
+		case 80 <= r && r <= 90: // ['P','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S76
+	// S235
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 82: // ['A','R']
-			return 16
-		case r == 83: // ['S','S']
-			return 86
-		case 84 <= r && r <= 90: // ['T','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 245
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S77
+	// S236
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 66: // ['A','B']
-			return 16
-		case r == 67: // ['C','C']
-			return 87
-		case 68 <= r && r <= 90: // ['D','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 246
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S78
+	// S237
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 77: // ['A','M']
-			return 16
-		case r == 78: // ['N','N']
-			return 88
-		case 79 <= r && r <= 90: // ['O','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S79
+	// S238
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 72: // ['A','H']
-			return 16
-		case r == 73: // ['I','I']
-			return 89
-		case 74 <= r && r <= 90: // ['J','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 41
+		case r == 97: // ['a','a']
+			return 247
+		case 98 <= r && r <= 122: // ['b','z']
+			return 43
 		}
 		return NoState
 	},
-	// S80
+	// S239
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 68: // ['A','D']
-			return 16
-		case r == 69: // ['E','E']
-			return 90
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 248
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S81
+	// S240
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S82
+	// S241
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 68: // ['A','D']
-			return 16
-		case r == 69: // ['E','E']
-			return 91
-		case 70 <= r && r <= 90: // ['F','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S83
+	// S242
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 77: // ['A','M']
+			return 24
+		case r == 78: // ['N','N']
+			return 249
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S84
+	// S243
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 83: // ['A','S']
-			return 16
-		case r == 84: // ['T','T']
-			return 92
-		case 85 <= r && r <= 90: // ['U','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S85
+	// S244
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 24
+		case r == 82: // ['R','R']
+			return 250
+		case 83 <= r && r <= 90: // ['S','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S86
+	// S245
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 82: // ['A','R']
+			return 24
+		case r == 83: // ['S','S']
+			return 251
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S87
+	// S246
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 83: // ['A','S']
-			return 16
-		case r == 84: // ['T','T']
-			return 93
-		case 85 <= r && r <= 90: // ['U','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S88
+	// S247
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
-		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 41
+		case 97 <= r && r <= 107: // ['a','k']
+			return 43
+		case r == 108: // ['l','l']
+			return 252
+		case 109 <= r && r <= 122: // ['m','z']
+			return 43
 		}
 		return NoState
 	},
-	// S89
+	// S248
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 78: // ['A','N']
-			return 16
-		case r == 79: // ['O','O']
-			return 94
-		case 80 <= r && r <= 90: // ['P','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S90
+	// S249
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 66: // ['A','B']
+			return 24
+		case r == 67: // ['C','C']
+			return 253
+		case 68 <= r && r <= 90: // ['D','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S91
+	// S250
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S92
+	// S251
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S93
+	// S252
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S94
+	// S253
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 77: // ['A','M']
-			return 16
-		case r == 78: // ['N','N']
-			return 95
-		case 79 <= r && r <= 90: // ['O','Z']
-			return 16
+			return 52
+		case r == 65: // ['A','A']
+			return 254
+		case 66 <= r && r <= 90: // ['B','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S95
+	// S254
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
-		case 65 <= r && r <= 82: // ['A','R']
-			return 16
-		case r == 83: // ['S','S']
-			return 96
-		case 84 <= r && r <= 90: // ['T','Z']
-			return 16
+			return 52
+		case 65 <= r && r <= 83: // ['A','S']
+			return 24
+		case r == 84: // ['T','T']
+			return 255
+		case 85 <= r && r <= 90: // ['U','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
-	// S96
+	// S255
 	func(r rune) int {
 		switch {
 		case r == 45: // ['-','-']
-			return 7
+			return 41
 		case 48 <= r && r <= 57: // ['0','9']
-			return 10
+			return 64
 		case r == 58: // [':',':']
-			return 32
+			return 52
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 24
 		case r == 95: // ['_','_']
-			return 7
+			return 41
 		case 97 <= r && r <= 122: // ['a','z']
-			return 27
+			return 43
 		}
 		return NoState
 	},
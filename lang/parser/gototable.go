@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 35
+const numNTSymbols = 61
 
 type (
 	gotoTable [numStates]gotoRow
@@ -16,12 +16,26 @@ var gotoTab = gotoTable{
 		2,  // SelectQuery
 		3,  // CountQuery
 		4,  // UpdateQuery
-		5,  // VersionsQuery
+		5,  // DeleteQuery
+		6,  // ConstructQuery
+		7,  // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
-		6,  // SelectClause
-		8,  // InsertClause
-		7,  // CountClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		8,  // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		10, // InsertClause
+		11, // DeleteClause
+		12, // ConstructClause
+		9,  // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
@@ -34,7 +48,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -44,6 +61,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -53,11 +79,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -71,7 +111,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -81,6 +124,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -90,11 +142,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -108,7 +174,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -118,6 +187,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -127,11 +205,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -145,7 +237,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -155,6 +250,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -164,11 +268,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -182,7 +300,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -192,6 +313,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -201,11 +331,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -219,7 +363,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -229,6 +376,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -238,25 +394,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
 		-1, // Var
 		-1, // Number
-		13, // DatasetClause
+		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -266,6 +439,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -275,25 +457,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
 		-1, // Var
 		-1, // Number
-		15, // DatasetClause
+		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -303,6 +502,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -312,25 +520,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
 		-1, // Var
 		-1, // Number
-		-1, // DatasetClause
-		16, // DatasetClauseInsert
+		19, // DatasetClause
+		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -340,6 +565,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -349,25 +583,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
 		-1, // Var
 		-1, // Number
-		-1, // DatasetClause
+		21, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -377,6 +628,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -386,25 +646,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
-		21, // Varlist
+		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		22, // Var
+		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
-		-1, // DatasetClauseInsert
+		23, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -414,6 +691,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -423,25 +709,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
 		-1, // Var
 		-1, // Number
-		-1, // DatasetClause
+		25, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -451,6 +754,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -460,25 +772,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
-		26, // Varlist
+		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		22, // Var
+		-1, // Var
 		-1, // Number
-		-1, // DatasetClause
+		27, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -488,6 +817,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -497,11 +835,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -511,11 +863,14 @@ var gotoTab = gotoTable{
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
-		27, // WhereClause
+		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -525,6 +880,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -534,16 +898,30 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		32, // SelectProjection
+		35, // SelectList
+		36, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
-		29, // DBlist
-		31, // String
-		-1, // Var
+		-1, // DBlist
+		-1, // String
+		31, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
@@ -552,7 +930,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -562,6 +943,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -571,25 +961,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
-		-1, // Varlist
+		40, // Varlist
 		-1, // DBlist
 		-1, // String
-		-1, // Var
+		41, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
-		33, // WhereClause
+		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -599,6 +1006,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -608,11 +1024,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -622,11 +1052,14 @@ var gotoTab = gotoTable{
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
-		34, // WhereClause
+		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -636,6 +1069,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -645,15 +1087,29 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
-		36, // DBlist
-		38, // String
+		-1, // DBlist
+		-1, // String
 		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
@@ -663,7 +1119,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -673,6 +1132,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -682,11 +1150,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -700,7 +1182,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -710,6 +1195,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -719,11 +1213,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -732,12 +1240,15 @@ var gotoTab = gotoTable{
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
-		40, // TimeClause
-		-1, // WhereClause
+		-1, // TimeClause
+		46, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -747,6 +1258,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -756,15 +1276,29 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
-		-1, // DBlist
-		-1, // String
+		48, // DBlist
+		50, // String
 		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
@@ -774,7 +1308,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -784,6 +1321,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -793,25 +1339,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		44, // Var
+		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
-		-1, // WhereClause
+		52, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -821,6 +1384,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -830,15 +1402,29 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
-		-1, // DBlist
-		-1, // String
+		54, // DBlist
+		56, // String
 		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
@@ -848,7 +1434,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -858,6 +1447,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -867,11 +1465,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -881,11 +1493,14 @@ var gotoTab = gotoTable{
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
-		-1, // WhereClause
+		58, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -895,6 +1510,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -904,25 +1528,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
-		-1, // DBlist
-		-1, // String
-		46, // Var
+		60, // DBlist
+		62, // String
+		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
-		45, // TriplesBlock
-		48, // Triple
-		49, // VarOrTerm
-		50, // GraphTerm
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -932,6 +1573,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -941,11 +1591,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -955,11 +1619,14 @@ var gotoTab = gotoTable{
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
-		-1, // WhereClause
+		64, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -969,6 +1636,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -978,16 +1654,30 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
-		-1, // DBlist
-		-1, // String
-		44, // Var
+		65, // DBlist
+		62, // String
+		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
@@ -996,7 +1686,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1006,6 +1699,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1015,11 +1717,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1028,12 +1744,15 @@ var gotoTab = gotoTable{
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
-		54, // TimeClause
-		-1, // WhereClause
+		-1, // TimeClause
+		67, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1043,6 +1762,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1052,11 +1780,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1070,7 +1812,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1080,6 +1825,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1089,25 +1843,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
-		59, // String
+		-1, // String
 		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
-		-1, // TimeClause
+		68, // TimeClause
 		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1117,6 +1888,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1126,11 +1906,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1144,7 +1938,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1154,6 +1951,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1163,11 +1969,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1181,7 +2001,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1191,6 +2014,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1200,11 +2032,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1218,7 +2064,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1228,6 +2077,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1237,25 +2095,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		72, // SelectProjection
+		35, // SelectList
+		36, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		-1, // Var
+		31, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
-		60, // TimeClause
+		-1, // TimeClause
 		-1, // WhereClause
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1265,6 +2140,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1274,16 +2158,30 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		73, // SelectProjection
+		35, // SelectList
+		36, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		-1, // Var
+		31, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
@@ -1292,7 +2190,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1302,6 +2203,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1311,16 +2221,30 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		74, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		-1, // Var
+		31, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
@@ -1329,7 +2253,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1339,6 +2266,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1348,15 +2284,29 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
-		62, // String
+		-1, // String
 		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
@@ -1366,7 +2316,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1376,6 +2329,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1385,11 +2347,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		75, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1403,7 +2379,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1413,6 +2392,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1422,11 +2410,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1440,7 +2442,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1450,6 +2455,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1459,11 +2473,25 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1477,7 +2505,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1487,6 +2518,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1496,16 +2536,30 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
-		63, // VersionGraphSelection
+		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		-1, // Var
+		81, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
@@ -1514,7 +2568,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1524,6 +2581,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1533,15 +2599,29 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
-		65, // String
+		-1, // String
 		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
@@ -1551,7 +2631,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1561,6 +2644,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1570,15 +2662,29 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
-		67, // String
+		-1, // String
 		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
@@ -1588,7 +2694,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1598,6 +2707,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1607,25 +2725,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
-		68, // String
-		-1, // Var
+		-1, // String
+		82, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
-		-1, // TriplesBlock
-		-1, // Triple
-		-1, // VarOrTerm
-		-1, // GraphTerm
+		84, // TriplesBlock
+		87, // Triple
+		88, // VarOrTerm
+		90, // BlankNode
+		-1, // PropertyList
+		89, // GraphTerm
+		94, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1635,6 +2770,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1644,25 +2788,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		-1, // Var
+		82, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
-		-1, // TriplesBlock
-		-1, // Triple
-		-1, // VarOrTerm
-		-1, // GraphTerm
+		96, // TriplesBlock
+		87, // Triple
+		88, // VarOrTerm
+		90, // BlankNode
+		-1, // PropertyList
+		89, // GraphTerm
+		94, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1672,6 +2833,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -1681,25 +2851,42 @@ var gotoTab = gotoTable{
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		-1, // Var
+		82, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
-		-1, // TriplesBlock
-		-1, // Triple
-		-1, // VarOrTerm
-		-1, // GraphTerm
+		97, // TriplesBlock
+		87, // Triple
+		88, // VarOrTerm
+		90, // BlankNode
+		-1, // PropertyList
+		89, // GraphTerm
+		94, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1709,20 +2896,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
 	gotoRow{ // S46
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		100, // Limit
+		102, // Offset
+		99,  // SolutionModifier
+		105, // OrderModifier
+		106, // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		98,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S47
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1736,7 +3009,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1746,20 +3022,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S47
+	gotoRow{ // S48
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		112, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S49
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1773,7 +3135,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1783,20 +3148,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S48
+	gotoRow{ // S50
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1810,7 +3198,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1820,25 +3211,48 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S49
+	gotoRow{ // S51
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		71, // Var
+		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
@@ -1847,30 +3261,119 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
-		73, // Path
-		76, // PathSequence
-		77, // PathElt
-		78, // PathPrimary
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
 		-1, // PathMod
 		-1, // RestOfWhereList
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S50
+	gotoRow{ // S52
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		113, // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S53
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1884,7 +3387,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1894,20 +3400,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S51
+	gotoRow{ // S54
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		115, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S55
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1921,7 +3513,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1931,20 +3526,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S52
+	gotoRow{ // S56
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1958,7 +3576,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -1968,20 +3589,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S53
+	gotoRow{ // S57
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -1995,7 +3639,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2005,20 +3652,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S54
+	gotoRow{ // S58
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2032,7 +3702,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2042,24 +3715,47 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S55
+	gotoRow{ // S59
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
-		81, // String
+		-1, // String
 		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
@@ -2069,7 +3765,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2079,61 +3778,110 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S56
-		-1, // S'
-		-1, // QueryUnit
-		-1, // SelectQuery
-		-1, // CountQuery
-		-1, // UpdateQuery
-		-1, // VersionsQuery
-		-1, // VersionGraphSelection
-		-1, // LimitClause
-		-1, // SelectClause
-		-1, // InsertClause
-		-1, // CountClause
-		-1, // Varlist
-		-1, // DBlist
-		83, // String
-		-1, // Var
-		-1, // Number
-		-1, // DatasetClause
-		-1, // DatasetClauseInsert
-		-1, // TimeClause
-		-1, // WhereClause
-		-1, // TriplesBlock
-		-1, // Triple
-		-1, // VarOrTerm
-		-1, // GraphTerm
-		-1, // Path
-		-1, // PathSequence
-		-1, // PathElt
-		-1, // PathPrimary
-		-1, // PathMod
-		-1, // RestOfWhereList
-		-1, // RestOfWhere
-		-1, // Joiner
-		-1, // GraphPatternNotTriples
-		-1, // GroupGraphPattern
-		-1, // GroupGraphPatternSub
+	gotoRow{ // S60
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		117, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S57
+	gotoRow{ // S61
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
-		84, // String
+		-1, // String
 		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
@@ -2143,7 +3891,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2153,57 +3904,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S58
+	gotoRow{ // S62
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
 		-1, // String
-		46, // Var
+		-1, // Var
 		-1, // Number
 		-1, // DatasetClause
 		-1, // DatasetClauseInsert
 		-1, // TimeClause
 		-1, // WhereClause
-		86, // TriplesBlock
-		89, // Triple
-		90, // VarOrTerm
-		50, // GraphTerm
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
 		-1, // PathPrimary
 		-1, // PathMod
-		88, // RestOfWhereList
-		91, // RestOfWhere
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
 		-1, // Joiner
-		92, // GraphPatternNotTriples
-		93, // GroupGraphPattern
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S59
+	gotoRow{ // S63
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2217,7 +4017,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2227,20 +4030,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S60
+	gotoRow{ // S64
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2254,7 +4080,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2264,57 +4093,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S61
-		-1, // S'
-		-1, // QueryUnit
-		-1, // SelectQuery
-		-1, // CountQuery
-		-1, // UpdateQuery
-		-1, // VersionsQuery
-		-1, // VersionGraphSelection
-		-1, // LimitClause
-		-1, // SelectClause
-		-1, // InsertClause
-		-1, // CountClause
-		-1, // Varlist
-		-1, // DBlist
-		-1, // String
-		46, // Var
-		-1, // Number
-		-1, // DatasetClause
-		-1, // DatasetClauseInsert
-		-1, // TimeClause
-		-1, // WhereClause
-		94, // TriplesBlock
-		89, // Triple
-		90, // VarOrTerm
-		50, // GraphTerm
-		-1, // Path
-		-1, // PathSequence
-		-1, // PathElt
-		-1, // PathPrimary
-		-1, // PathMod
-		96, // RestOfWhereList
-		91, // RestOfWhere
-		-1, // Joiner
-		92, // GraphPatternNotTriples
-		93, // GroupGraphPattern
-		-1, // GroupGraphPatternSub
+	gotoRow{ // S65
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		117, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S62
+	gotoRow{ // S66
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2328,7 +4206,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2338,20 +4219,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S63
+	gotoRow{ // S67
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
-		97, // LimitClause
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2365,7 +4269,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2375,24 +4282,110 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S64
+	gotoRow{ // S68
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		118, // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S69
 		-1,  // S'
 		-1,  // QueryUnit
 		-1,  // SelectQuery
 		-1,  // CountQuery
 		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
 		-1,  // VersionsQuery
 		-1,  // VersionGraphSelection
 		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
 		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
 		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
 		-1,  // CountClause
 		-1,  // Varlist
-		99,  // DBlist
-		101, // String
+		-1,  // DBlist
+		120, // String
 		-1,  // Var
 		-1,  // Number
 		-1,  // DatasetClause
@@ -2402,7 +4395,10 @@ var gotoTab = gotoTable{
 		-1,  // TriplesBlock
 		-1,  // Triple
 		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
 		-1,  // GraphTerm
+		-1,  // Literal
 		-1,  // Path
 		-1,  // PathSequence
 		-1,  // PathElt
@@ -2412,20 +4408,169 @@ var gotoTab = gotoTable{
 		-1,  // RestOfWhere
 		-1,  // Joiner
 		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
 		-1,  // GroupGraphPattern
 		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S65
+	gotoRow{ // S70
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		122, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S71
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		123, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S72
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2439,7 +4584,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2449,20 +4597,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S66
+	gotoRow{ // S73
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2476,7 +4647,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2486,20 +4660,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S67
+	gotoRow{ // S74
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2513,7 +4710,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2523,20 +4723,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S68
+	gotoRow{ // S75
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2550,7 +4773,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2560,20 +4786,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S69
+	gotoRow{ // S76
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2587,7 +4836,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2597,57 +4849,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S70
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		46,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		104, // Triple
-		49,  // VarOrTerm
-		50,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S71
+	gotoRow{ // S77
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2661,7 +4899,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2671,20 +4912,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S72
+	gotoRow{ // S78
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2698,7 +4962,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2708,57 +4975,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S73
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		105, // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		107, // VarOrTerm
-		108, // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S74
+	gotoRow{ // S79
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2772,7 +5025,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2782,20 +5038,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S75
+	gotoRow{ // S80
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2809,7 +5088,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2819,20 +5101,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S76
+	gotoRow{ // S81
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2846,7 +5151,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2856,20 +5164,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S77
+	gotoRow{ // S82
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2883,7 +5214,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2893,57 +5227,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S78
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		115, // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S79
+	gotoRow{ // S83
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -2957,7 +5277,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -2967,57 +5290,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S80
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		118, // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		120, // Path
-		123, // PathSequence
-		124, // PathElt
-		125, // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S81
+	gotoRow{ // S84
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3031,7 +5340,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3041,20 +5353,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S82
+	gotoRow{ // S85
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3068,7 +5403,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3078,20 +5416,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S83
+	gotoRow{ // S86
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3105,7 +5466,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3115,20 +5479,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S84
+	gotoRow{ // S87
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3142,7 +5529,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3152,62 +5542,48 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S85
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		46,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		129, // TriplesBlock
-		89,  // Triple
-		90,  // VarOrTerm
-		50,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		130, // GraphPatternNotTriples
-		131, // GroupGraphPattern
-		132, // GroupGraphPatternSub
-	},
-	gotoRow{ // S86
+	gotoRow{ // S88
 		-1,  // S'
 		-1,  // QueryUnit
 		-1,  // SelectQuery
 		-1,  // CountQuery
 		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
 		-1,  // VersionsQuery
 		-1,  // VersionGraphSelection
 		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
 		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
 		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
 		-1,  // CountClause
 		-1,  // Varlist
 		-1,  // DBlist
 		-1,  // String
-		-1,  // Var
+		134, // Var
 		-1,  // Number
 		-1,  // DatasetClause
 		-1,  // DatasetClauseInsert
@@ -3216,30 +5592,56 @@ var gotoTab = gotoTable{
 		-1,  // TriplesBlock
 		-1,  // Triple
 		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
 		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
+		-1,  // Literal
+		137, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
 		-1,  // PathMod
-		135, // RestOfWhereList
-		91,  // RestOfWhere
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
 		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
 		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S87
+	gotoRow{ // S89
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3253,7 +5655,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3263,57 +5668,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S88
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		137, // RestOfWhere
-		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S89
+	gotoRow{ // S90
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3327,7 +5718,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3337,25 +5731,48 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S90
+	gotoRow{ // S91
 		-1,  // S'
 		-1,  // QueryUnit
 		-1,  // SelectQuery
 		-1,  // CountQuery
 		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
 		-1,  // VersionsQuery
 		-1,  // VersionGraphSelection
 		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
 		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
 		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
 		-1,  // CountClause
 		-1,  // Varlist
 		-1,  // DBlist
 		-1,  // String
-		71,  // Var
+		134, // Var
 		-1,  // Number
 		-1,  // DatasetClause
 		-1,  // DatasetClauseInsert
@@ -3364,30 +5781,56 @@ var gotoTab = gotoTable{
 		-1,  // TriplesBlock
 		-1,  // Triple
 		-1,  // VarOrTerm
+		-1,  // BlankNode
+		146, // PropertyList
 		-1,  // GraphTerm
-		138, // Path
-		76,  // PathSequence
-		77,  // PathElt
-		78,  // PathPrimary
+		-1,  // Literal
+		144, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
 		-1,  // PathMod
 		-1,  // RestOfWhereList
 		-1,  // RestOfWhere
 		-1,  // Joiner
 		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
 		-1,  // GroupGraphPattern
 		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S91
+	gotoRow{ // S92
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3401,7 +5844,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3411,57 +5857,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S92
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		140, // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
 	gotoRow{ // S93
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3475,7 +5907,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3485,57 +5920,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
 	gotoRow{ // S94
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		144, // RestOfWhereList
-		91,  // RestOfWhere
-		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S95
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3549,7 +5970,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3559,57 +5983,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S96
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		137, // RestOfWhere
-		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S97
+	gotoRow{ // S95
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3623,7 +6033,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3633,94 +6046,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S98
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		146, // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S99
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		148, // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S100
+	gotoRow{ // S96
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3734,7 +6096,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3744,20 +6109,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S101
+	gotoRow{ // S97
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3771,7 +6159,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3781,20 +6172,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S102
+	gotoRow{ // S98
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3808,7 +6222,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3818,59 +6235,26001 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S103
-		-1, // S'
-		-1, // QueryUnit
-		-1, // SelectQuery
-		-1, // CountQuery
-		-1, // UpdateQuery
-		-1, // VersionsQuery
-		-1, // VersionGraphSelection
-		-1, // LimitClause
-		-1, // SelectClause
-		-1, // InsertClause
-		-1, // CountClause
-		-1, // Varlist
-		-1, // DBlist
-		-1, // String
-		-1, // Var
-		-1, // Number
-		-1, // DatasetClause
-		-1, // DatasetClauseInsert
-		-1, // TimeClause
-		-1, // WhereClause
-		-1, // TriplesBlock
-		-1, // Triple
-		-1, // VarOrTerm
-		-1, // GraphTerm
-		-1, // Path
-		-1, // PathSequence
-		-1, // PathElt
-		-1, // PathPrimary
-		-1, // PathMod
-		-1, // RestOfWhereList
-		-1, // RestOfWhere
-		-1, // Joiner
-		-1, // GraphPatternNotTriples
-		-1, // GroupGraphPattern
-		-1, // GroupGraphPatternSub
+	gotoRow{ // S99
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		151, // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S104
-		-1, // S'
-		-1, // QueryUnit
-		-1, // SelectQuery
-		-1, // CountQuery
-		-1, // UpdateQuery
-		-1, // VersionsQuery
-		-1, // VersionGraphSelection
-		-1, // LimitClause
-		-1, // SelectClause
-		-1, // InsertClause
-		-1, // CountClause
-		-1, // Varlist
+	gotoRow{ // S100
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		152, // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S101
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		154, // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S102
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		156, // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S103
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		158, // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S104
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S105
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S106
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		161, // Limit
+		162, // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		163, // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		164, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S107
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S108
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		167, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S109
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		169, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S110
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		170, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S111
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		172, // TriplesBlock
+		175, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		174, // RestOfWhereList
+		177, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S112
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S113
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S114
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		183, // TriplesBlock
+		175, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		185, // RestOfWhereList
+		177, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S115
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S116
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		186, // TriplesBlock
+		175, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		188, // RestOfWhereList
+		177, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S117
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S118
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		189, // LimitClause
+		190, // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S119
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		192, // DBlist
+		194, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S120
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S121
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S122
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S123
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S124
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		196, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S125
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		199, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S126
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		201, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S127
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		202, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S128
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		203, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S129
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		204, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S130
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S131
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S132
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S133
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		210, // Triple
+		88,  // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S134
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S135
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		211, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		214, // Path
+		217, // PathSequence
+		218, // PathElt
+		219, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S136
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S137
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		221, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		225, // VarOrTerm
+		227, // BlankNode
+		-1,  // PropertyList
+		226, // GraphTerm
+		231, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S138
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S139
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S140
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S141
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S142
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		236, // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S143
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S144
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		239, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		243, // VarOrTerm
+		245, // BlankNode
+		-1,  // PropertyList
+		244, // GraphTerm
+		249, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S145
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S146
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S147
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S148
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		210, // Triple
+		88,  // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S149
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S150
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		210, // Triple
+		88,  // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S151
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S152
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S153
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		255, // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S154
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S155
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S156
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S157
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		257, // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S158
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S159
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S160
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		258, // Varlist
+		-1,  // DBlist
+		-1,  // String
+		259, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S161
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		261, // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S162
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		262, // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S163
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S165
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S166
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		265, // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		164, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S167
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S168
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S169
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S170
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S171
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		267, // TriplesBlock
+		175, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		268, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		269, // GroupGraphPattern
+		273, // GroupGraphPatternSub
+	},
+	gotoRow{ // S172
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		276, // RestOfWhereList
+		177, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S173
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S174
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		278, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S175
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S176
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		134, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		279, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S177
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S178
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		281, // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S179
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S180
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		283, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S181
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		285, // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		286, // BuiltInCall
+		287, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S182
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		298, // String
+		297, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S183
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		304, // RestOfWhereList
+		177, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S184
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S185
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		278, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S186
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		308, // RestOfWhereList
+		177, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S187
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S188
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		278, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S189
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S190
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S191
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		310, // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S192
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		312, // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S193
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S194
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S195
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S196
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S197
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S198
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S199
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S200
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		316, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S201
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S202
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S203
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S204
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S205
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		322, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S206
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S207
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S208
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S209
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S210
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S211
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S212
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		211, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		323, // Path
+		217, // PathSequence
+		218, // PathElt
+		219, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S213
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S214
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S215
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S216
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S217
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S218
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S219
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		328, // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S220
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S221
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S222
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S223
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S224
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S225
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S226
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S227
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S228
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		134, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		334, // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		144, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S229
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S230
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S231
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S232
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S233
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		335, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S234
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		336, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S235
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S236
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S237
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S238
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S239
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S240
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S241
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S242
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S243
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S244
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S245
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S246
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		134, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		340, // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		144, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S247
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S248
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S249
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S250
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S251
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		134, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		341, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S252
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S253
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S254
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S255
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S256
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S257
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S258
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		100, // Limit
+		102, // Offset
+		-1,  // SolutionModifier
+		343, // OrderModifier
+		106, // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		344, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S259
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S260
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S261
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S262
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S263
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S264
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S265
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S266
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		267, // TriplesBlock
+		175, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		268, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		269, // GroupGraphPattern
+		345, // GroupGraphPatternSub
+	},
+	gotoRow{ // S267
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S268
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S269
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S270
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		349, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S271
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		351, // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		352, // BuiltInCall
+		353, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S272
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		355, // String
+		354, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S273
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		360, // Joiner
+		359, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		361, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S274
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S275
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		367, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		366, // RestOfWhereList
+		177, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S276
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		278, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S277
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S278
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S279
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		369, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		373, // VarOrTerm
+		375, // BlankNode
+		-1,  // PropertyList
+		374, // GraphTerm
+		379, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S280
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S281
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		381, // TriplesBlock
+		175, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S282
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		382, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S283
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S284
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		383, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		390, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		392, // Expression
+		394, // ConditionalAndExpression
+		395, // RelationalExpression
+		396, // UnaryExpression
+		397, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S285
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S286
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S287
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S288
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S289
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S290
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S291
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S292
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S293
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S294
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S295
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S296
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S297
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		401, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S298
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		402, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S299
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S300
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S301
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		403, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S302
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S303
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		367, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		405, // RestOfWhereList
+		177, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S304
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		278, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S305
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S306
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S307
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		367, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		408, // RestOfWhereList
+		177, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S308
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		278, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S309
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S310
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S311
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S312
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S313
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S314
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S315
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S316
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S317
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S318
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S319
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S320
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S321
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S322
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S323
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S324
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S325
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		415, // PathSequence
+		218, // PathElt
+		219, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S326
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		416, // PathElt
+		219, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S327
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S328
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S329
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S330
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S331
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S332
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S333
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S334
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S335
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S336
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S337
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S338
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S339
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S340
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S341
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		239, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		425, // VarOrTerm
+		245, // BlankNode
+		-1,  // PropertyList
+		244, // GraphTerm
+		249, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S342
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S343
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S344
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S345
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		426, // Joiner
+		359, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		361, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S346
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		367, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S347
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		427, // TriplesBlock
+		175, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S348
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		428, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S349
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S350
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		383, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		390, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		429, // Expression
+		394, // ConditionalAndExpression
+		395, // RelationalExpression
+		396, // UnaryExpression
+		397, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S351
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S352
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S353
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S354
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		431, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S355
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		432, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S356
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		433, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S357
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		267, // TriplesBlock
+		175, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		268, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		269, // GroupGraphPattern
+		434, // GroupGraphPatternSub
+	},
+	gotoRow{ // S358
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S359
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S360
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S361
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S362
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		438, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S363
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		440, // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		441, // BuiltInCall
+		442, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S364
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		444, // String
+		443, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S365
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S366
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		278, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S367
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S368
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S369
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S370
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S371
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S372
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S373
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S374
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S375
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S376
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		134, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		450, // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		144, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S377
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S378
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S379
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S380
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S381
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		452, // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S382
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S383
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S384
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		383, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		390, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		453, // Expression
+		394, // ConditionalAndExpression
+		395, // RelationalExpression
+		396, // UnaryExpression
+		397, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S385
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S386
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S387
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S388
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S389
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S390
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S391
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S392
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S393
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S394
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S395
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S396
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S397
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S398
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		383, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		390, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		465, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S399
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S400
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		467, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		474, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		476, // Expression
+		478, // ConditionalAndExpression
+		479, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		484, // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S401
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S402
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S403
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S404
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S405
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		278, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S406
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S407
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S408
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		278, // RestOfWhere
+		-1,  // Joiner
+		178, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		179, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S409
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S410
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S411
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S412
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S413
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S414
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S415
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S416
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S417
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S418
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S419
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		134, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		341, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S420
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S421
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S422
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S423
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		134, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		341, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S424
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S425
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S426
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S427
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S428
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S429
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S430
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		467, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		474, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		476, // Expression
+		478, // ConditionalAndExpression
+		479, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		493, // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S431
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S432
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S433
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S434
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		494, // Joiner
+		359, // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		361, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S435
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		495, // TriplesBlock
+		175, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S436
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		496, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S437
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S438
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S439
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		383, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		390, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		497, // Expression
+		394, // ConditionalAndExpression
+		395, // RelationalExpression
+		396, // UnaryExpression
+		397, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S440
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S441
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S442
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S443
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		499, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S444
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		500, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S445
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		-1,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		501, // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S446
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S447
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S448
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S449
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S450
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S451
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		82,  // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		367, // Triple
+		176, // VarOrTerm
+		90,  // BlankNode
+		-1,  // PropertyList
+		89,  // GraphTerm
+		94,  // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S452
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S453
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S454
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S455
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S456
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S457
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		383, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		390, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		509, // ConditionalAndExpression
+		395, // RelationalExpression
+		396, // UnaryExpression
+		397, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S458
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		383, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		390, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		510, // RelationalExpression
+		396, // UnaryExpression
+		397, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S459
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		511, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		518, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		521, // UnaryExpression
+		522, // PrimaryExpression
+		520, // BuiltInCall
+		524, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S460
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		511, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		518, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		525, // UnaryExpression
+		522, // PrimaryExpression
+		520, // BuiltInCall
+		524, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S461
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		511, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		518, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		526, // UnaryExpression
+		522, // PrimaryExpression
+		520, // BuiltInCall
+		524, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S462
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		511, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		518, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		527, // UnaryExpression
+		522, // PrimaryExpression
+		520, // BuiltInCall
+		524, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S463
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		511, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		518, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		528, // UnaryExpression
+		522, // PrimaryExpression
+		520, // BuiltInCall
+		524, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S464
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		511, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		518, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		529, // UnaryExpression
+		522, // PrimaryExpression
+		520, // BuiltInCall
+		524, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S465
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S466
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		467, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		474, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		476, // Expression
+		478, // ConditionalAndExpression
+		479, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		530, // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S467
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S468
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		383, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		390, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		531, // Expression
+		394, // ConditionalAndExpression
+		395, // RelationalExpression
+		396, // UnaryExpression
+		397, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S469
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S470
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S471
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S472
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S473
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S474
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S475
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S476
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S477
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S478
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S479
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S480
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S481
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S482
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		467, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		474, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		542, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S483
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S484
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S485
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S486
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S487
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S488
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S489
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S490
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S491
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S492
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S493
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S494
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S495
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S496
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S497
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S498
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		467, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		474, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		476, // Expression
+		478, // ConditionalAndExpression
+		479, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		551, // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S499
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S500
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S501
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S502
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S503
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S504
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		134, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		-1,  // Literal
+		341, // Path
+		140, // PathSequence
+		141, // PathElt
+		142, // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		-1,  // PrimaryExpression
+		-1,  // BuiltInCall
+		-1,  // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S505
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S506
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S507
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S508
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S509
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S510
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S511
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
 		-1, // DBlist
 		-1, // String
 		-1, // Var
@@ -3882,7 +32241,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3892,20 +32254,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S105
+	gotoRow{ // S512
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		383, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		390, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		553, // Expression
+		394, // ConditionalAndExpression
+		395, // RelationalExpression
+		396, // UnaryExpression
+		397, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S513
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3919,7 +32367,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3929,20 +32380,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S106
+	gotoRow{ // S514
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3956,7 +32430,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -3966,20 +32443,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S107
+	gotoRow{ // S515
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -3993,7 +32493,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4003,20 +32506,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S108
+	gotoRow{ // S516
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4030,7 +32556,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4040,20 +32569,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S109
+	gotoRow{ // S517
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4067,7 +32619,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4077,20 +32632,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S110
+	gotoRow{ // S518
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4104,7 +32682,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4114,20 +32695,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S111
+	gotoRow{ // S519
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4141,7 +32745,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4151,62 +32758,237 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S112
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		149, // PathSequence
-		77,  // PathElt
-		78,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
+	gotoRow{ // S520
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S113
+	gotoRow{ // S521
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S522
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S523
 		-1,  // S'
 		-1,  // QueryUnit
 		-1,  // SelectQuery
 		-1,  // CountQuery
 		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
 		-1,  // VersionsQuery
 		-1,  // VersionGraphSelection
 		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
 		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
 		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
 		-1,  // CountClause
 		-1,  // Varlist
 		-1,  // DBlist
 		-1,  // String
-		-1,  // Var
+		511, // Var
 		-1,  // Number
 		-1,  // DatasetClause
 		-1,  // DatasetClauseInsert
@@ -4215,30 +32997,182 @@ var gotoTab = gotoTable{
 		-1,  // TriplesBlock
 		-1,  // Triple
 		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
 		-1,  // GraphTerm
+		518, // Literal
 		-1,  // Path
 		-1,  // PathSequence
-		150, // PathElt
-		78,  // PathPrimary
+		-1,  // PathElt
+		-1,  // PathPrimary
 		-1,  // PathMod
 		-1,  // RestOfWhereList
 		-1,  // RestOfWhere
 		-1,  // Joiner
 		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		556, // PrimaryExpression
+		520, // BuiltInCall
+		524, // FunctionName
+		-1,  // ArgList
 		-1,  // GroupGraphPattern
 		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S114
+	gotoRow{ // S524
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S525
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S526
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4252,7 +33186,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4262,20 +33199,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S115
+	gotoRow{ // S527
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4289,7 +33249,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4299,20 +33262,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S116
+	gotoRow{ // S528
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4326,7 +33312,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4336,20 +33325,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S117
+	gotoRow{ // S529
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4363,7 +33375,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4373,20 +33388,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S118
+	gotoRow{ // S530
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4400,7 +33438,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4410,20 +33451,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S119
+	gotoRow{ // S531
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4437,7 +33501,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4447,20 +33514,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S120
+	gotoRow{ // S532
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S533
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4474,7 +33627,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4484,94 +33640,547 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S121
-		-1, // S'
-		-1, // QueryUnit
-		-1, // SelectQuery
-		-1, // CountQuery
-		-1, // UpdateQuery
-		-1, // VersionsQuery
-		-1, // VersionGraphSelection
-		-1, // LimitClause
-		-1, // SelectClause
-		-1, // InsertClause
-		-1, // CountClause
-		-1, // Varlist
-		-1, // DBlist
-		-1, // String
-		-1, // Var
-		-1, // Number
-		-1, // DatasetClause
-		-1, // DatasetClauseInsert
-		-1, // TimeClause
-		-1, // WhereClause
-		-1, // TriplesBlock
-		-1, // Triple
-		-1, // VarOrTerm
-		-1, // GraphTerm
-		-1, // Path
-		-1, // PathSequence
-		-1, // PathElt
-		-1, // PathPrimary
-		-1, // PathMod
-		-1, // RestOfWhereList
-		-1, // RestOfWhere
-		-1, // Joiner
-		-1, // GraphPatternNotTriples
-		-1, // GroupGraphPattern
-		-1, // GroupGraphPatternSub
+	gotoRow{ // S534
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		467, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		474, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		562, // ConditionalAndExpression
+		479, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S535
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		467, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		474, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		563, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S536
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		564, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		571, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		574, // UnaryExpression
+		575, // PrimaryExpression
+		573, // BuiltInCall
+		577, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S537
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		564, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		571, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		578, // UnaryExpression
+		575, // PrimaryExpression
+		573, // BuiltInCall
+		577, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S538
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		564, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		571, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		579, // UnaryExpression
+		575, // PrimaryExpression
+		573, // BuiltInCall
+		577, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S539
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		564, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		571, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		580, // UnaryExpression
+		575, // PrimaryExpression
+		573, // BuiltInCall
+		577, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S540
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		564, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		571, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		581, // UnaryExpression
+		575, // PrimaryExpression
+		573, // BuiltInCall
+		577, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S122
-		-1, // S'
-		-1, // QueryUnit
-		-1, // SelectQuery
-		-1, // CountQuery
-		-1, // UpdateQuery
-		-1, // VersionsQuery
-		-1, // VersionGraphSelection
-		-1, // LimitClause
-		-1, // SelectClause
-		-1, // InsertClause
-		-1, // CountClause
-		-1, // Varlist
-		-1, // DBlist
-		-1, // String
-		-1, // Var
-		-1, // Number
-		-1, // DatasetClause
-		-1, // DatasetClauseInsert
-		-1, // TimeClause
-		-1, // WhereClause
-		-1, // TriplesBlock
-		-1, // Triple
-		-1, // VarOrTerm
-		-1, // GraphTerm
-		-1, // Path
-		-1, // PathSequence
-		-1, // PathElt
-		-1, // PathPrimary
-		-1, // PathMod
-		-1, // RestOfWhereList
-		-1, // RestOfWhere
-		-1, // Joiner
-		-1, // GraphPatternNotTriples
-		-1, // GroupGraphPattern
-		-1, // GroupGraphPatternSub
+	gotoRow{ // S541
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		564, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		571, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		582, // UnaryExpression
+		575, // PrimaryExpression
+		573, // BuiltInCall
+		577, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S123
+	gotoRow{ // S542
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4585,7 +34194,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4595,20 +34207,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S124
+	gotoRow{ // S543
+		-1,  // S'
+		-1,  // QueryUnit
+		-1,  // SelectQuery
+		-1,  // CountQuery
+		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
+		-1,  // VersionsQuery
+		-1,  // VersionGraphSelection
+		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
+		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
+		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
+		-1,  // CountClause
+		-1,  // Varlist
+		-1,  // DBlist
+		-1,  // String
+		467, // Var
+		-1,  // Number
+		-1,  // DatasetClause
+		-1,  // DatasetClauseInsert
+		-1,  // TimeClause
+		-1,  // WhereClause
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		474, // Literal
+		-1,  // Path
+		-1,  // PathSequence
+		-1,  // PathElt
+		-1,  // PathPrimary
+		-1,  // PathMod
+		-1,  // RestOfWhereList
+		-1,  // RestOfWhere
+		-1,  // Joiner
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		476, // Expression
+		478, // ConditionalAndExpression
+		479, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		583, // ArgList
+		-1,  // GroupGraphPattern
+		-1,  // GroupGraphPatternSub
+	},
+	gotoRow{ // S544
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4622,7 +34320,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4632,25 +34333,48 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S125
+	gotoRow{ // S545
 		-1,  // S'
 		-1,  // QueryUnit
 		-1,  // SelectQuery
 		-1,  // CountQuery
 		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
 		-1,  // VersionsQuery
 		-1,  // VersionGraphSelection
 		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
 		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
 		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
 		-1,  // CountClause
 		-1,  // Varlist
 		-1,  // DBlist
 		-1,  // String
-		-1,  // Var
+		467, // Var
 		-1,  // Number
 		-1,  // DatasetClause
 		-1,  // DatasetClauseInsert
@@ -4659,30 +34383,56 @@ var gotoTab = gotoTable{
 		-1,  // TriplesBlock
 		-1,  // Triple
 		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
 		-1,  // GraphTerm
+		474, // Literal
 		-1,  // Path
 		-1,  // PathSequence
 		-1,  // PathElt
 		-1,  // PathPrimary
-		155, // PathMod
+		-1,  // PathMod
 		-1,  // RestOfWhereList
 		-1,  // RestOfWhere
 		-1,  // Joiner
 		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		584, // Expression
+		478, // ConditionalAndExpression
+		479, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		-1,  // ArgList
 		-1,  // GroupGraphPattern
 		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S126
+	gotoRow{ // S546
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4696,7 +34446,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4706,94 +34459,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S127
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		118, // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		158, // Path
-		123, // PathSequence
-		124, // PathElt
-		125, // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S128
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		46,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		129, // TriplesBlock
-		89,  // Triple
-		90,  // VarOrTerm
-		50,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		130, // GraphPatternNotTriples
-		131, // GroupGraphPattern
-		159, // GroupGraphPatternSub
+	gotoRow{ // S547
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S129
+	gotoRow{ // S548
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4807,7 +34572,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4817,20 +34585,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S130
+	gotoRow{ // S549
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4844,7 +34635,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4854,20 +34648,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S131
+	gotoRow{ // S550
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4881,7 +34698,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4891,57 +34711,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S132
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		165, // Joiner
-		164, // GraphPatternNotTriples
-		131, // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S133
+	gotoRow{ // S551
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -4955,7 +34761,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -4965,94 +34774,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S134
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		46,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		168, // Triple
-		90,  // VarOrTerm
-		50,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		167, // RestOfWhereList
-		91,  // RestOfWhere
-		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S135
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		137, // RestOfWhere
-		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S136
+	gotoRow{ // S552
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5066,7 +34824,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5076,20 +34837,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S137
+	gotoRow{ // S553
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5103,7 +34887,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5113,57 +34900,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S138
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		170, // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		172, // VarOrTerm
-		173, // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S139
+	gotoRow{ // S554
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5177,7 +34950,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5187,94 +34963,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S140
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		46,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		177, // TriplesBlock
-		89,  // Triple
-		90,  // VarOrTerm
-		50,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S141
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		178, // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
+	gotoRow{ // S555
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S142
+	gotoRow{ // S556
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5288,7 +35076,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5298,62 +35089,48 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S143
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		46,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		168, // Triple
-		90,  // VarOrTerm
-		50,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		180, // RestOfWhereList
-		91,  // RestOfWhere
-		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S144
+	gotoRow{ // S557
 		-1,  // S'
 		-1,  // QueryUnit
 		-1,  // SelectQuery
 		-1,  // CountQuery
 		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
 		-1,  // VersionsQuery
 		-1,  // VersionGraphSelection
 		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
 		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
 		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
 		-1,  // CountClause
 		-1,  // Varlist
 		-1,  // DBlist
 		-1,  // String
-		-1,  // Var
+		467, // Var
 		-1,  // Number
 		-1,  // DatasetClause
 		-1,  // DatasetClauseInsert
@@ -5362,30 +35139,56 @@ var gotoTab = gotoTable{
 		-1,  // TriplesBlock
 		-1,  // Triple
 		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
 		-1,  // GraphTerm
+		474, // Literal
 		-1,  // Path
 		-1,  // PathSequence
 		-1,  // PathElt
 		-1,  // PathPrimary
 		-1,  // PathMod
 		-1,  // RestOfWhereList
-		137, // RestOfWhere
+		-1,  // RestOfWhere
 		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		476, // Expression
+		478, // ConditionalAndExpression
+		479, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		591, // ArgList
+		-1,  // GroupGraphPattern
 		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S145
+	gotoRow{ // S558
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5399,7 +35202,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5409,20 +35215,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S146
+	gotoRow{ // S559
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5436,7 +35265,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5446,20 +35278,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S147
+	gotoRow{ // S560
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5473,7 +35328,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5483,20 +35341,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S148
+	gotoRow{ // S561
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5510,7 +35391,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5520,20 +35404,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S149
+	gotoRow{ // S562
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5547,7 +35454,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5557,20 +35467,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S150
+	gotoRow{ // S563
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5584,7 +35517,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5594,57 +35530,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S151
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		182, // PathSequence
-		124, // PathElt
-		125, // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S152
+	gotoRow{ // S564
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5658,7 +35580,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5668,25 +35593,48 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S153
+	gotoRow{ // S565
 		-1,  // S'
 		-1,  // QueryUnit
 		-1,  // SelectQuery
 		-1,  // CountQuery
 		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
 		-1,  // VersionsQuery
 		-1,  // VersionGraphSelection
 		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
 		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
 		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
 		-1,  // CountClause
 		-1,  // Varlist
 		-1,  // DBlist
 		-1,  // String
-		-1,  // Var
+		383, // Var
 		-1,  // Number
 		-1,  // DatasetClause
 		-1,  // DatasetClauseInsert
@@ -5695,30 +35643,56 @@ var gotoTab = gotoTable{
 		-1,  // TriplesBlock
 		-1,  // Triple
 		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
 		-1,  // GraphTerm
+		390, // Literal
 		-1,  // Path
 		-1,  // PathSequence
-		183, // PathElt
-		125, // PathPrimary
+		-1,  // PathElt
+		-1,  // PathPrimary
 		-1,  // PathMod
 		-1,  // RestOfWhereList
 		-1,  // RestOfWhere
 		-1,  // Joiner
 		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		592, // Expression
+		394, // ConditionalAndExpression
+		395, // RelationalExpression
+		396, // UnaryExpression
+		397, // PrimaryExpression
+		393, // BuiltInCall
+		399, // FunctionName
+		-1,  // ArgList
 		-1,  // GroupGraphPattern
 		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S154
+	gotoRow{ // S566
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5732,7 +35706,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5742,20 +35719,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S155
+	gotoRow{ // S567
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5769,7 +35769,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5779,20 +35782,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S156
+	gotoRow{ // S568
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5806,7 +35832,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5816,20 +35845,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S157
+	gotoRow{ // S569
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5843,7 +35895,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5853,20 +35908,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S158
+	gotoRow{ // S570
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -5880,7 +35958,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -5890,168 +35971,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S159
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		185, // Joiner
-		164, // GraphPatternNotTriples
-		131, // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S160
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		46,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		168, // Triple
-		90,  // VarOrTerm
-		50,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S161
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		46,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		186, // TriplesBlock
-		89,  // Triple
-		90,  // VarOrTerm
-		50,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S162
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		187, // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
+	gotoRow{ // S571
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S163
+	gotoRow{ // S572
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6065,7 +36084,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6075,20 +36097,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S164
+	gotoRow{ // S573
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6102,7 +36147,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6112,20 +36160,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S165
+	gotoRow{ // S574
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6139,7 +36210,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6149,20 +36223,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S166
+	gotoRow{ // S575
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6176,7 +36273,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6186,25 +36286,48 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S167
+	gotoRow{ // S576
 		-1,  // S'
 		-1,  // QueryUnit
 		-1,  // SelectQuery
 		-1,  // CountQuery
 		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
 		-1,  // VersionsQuery
 		-1,  // VersionGraphSelection
 		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
 		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
 		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
 		-1,  // CountClause
 		-1,  // Varlist
 		-1,  // DBlist
 		-1,  // String
-		-1,  // Var
+		564, // Var
 		-1,  // Number
 		-1,  // DatasetClause
 		-1,  // DatasetClauseInsert
@@ -6213,30 +36336,56 @@ var gotoTab = gotoTable{
 		-1,  // TriplesBlock
 		-1,  // Triple
 		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
 		-1,  // GraphTerm
+		571, // Literal
 		-1,  // Path
 		-1,  // PathSequence
 		-1,  // PathElt
 		-1,  // PathPrimary
 		-1,  // PathMod
 		-1,  // RestOfWhereList
-		137, // RestOfWhere
+		-1,  // RestOfWhere
 		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
+		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		-1,  // Expression
+		-1,  // ConditionalAndExpression
+		-1,  // RelationalExpression
+		-1,  // UnaryExpression
+		595, // PrimaryExpression
+		573, // BuiltInCall
+		577, // FunctionName
+		-1,  // ArgList
+		-1,  // GroupGraphPattern
 		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S168
+	gotoRow{ // S577
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6250,7 +36399,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6260,20 +36412,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S169
+	gotoRow{ // S578
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6287,7 +36462,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6297,20 +36475,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S170
+	gotoRow{ // S579
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6324,7 +36525,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6334,20 +36538,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S171
+	gotoRow{ // S580
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6361,7 +36588,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6371,20 +36601,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S172
+	gotoRow{ // S581
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6398,7 +36651,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6408,20 +36664,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S173
+	gotoRow{ // S582
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6435,7 +36714,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6445,20 +36727,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S174
+	gotoRow{ // S583
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6472,7 +36777,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6482,20 +36790,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S175
+	gotoRow{ // S584
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6509,7 +36840,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6519,20 +36853,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S176
+	gotoRow{ // S585
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6546,7 +36903,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6556,57 +36916,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S177
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		192, // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
-	},
-	gotoRow{ // S178
+	gotoRow{ // S586
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6620,7 +36966,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6630,20 +36979,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S179
+	gotoRow{ // S587
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6657,7 +37029,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6667,57 +37042,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S180
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		-1,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		-1,  // Triple
-		-1,  // VarOrTerm
-		-1,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		137, // RestOfWhere
-		-1,  // Joiner
-		92,  // GraphPatternNotTriples
-		93,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
+	gotoRow{ // S588
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S181
+	gotoRow{ // S589
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6731,7 +37155,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6741,20 +37168,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S182
+	gotoRow{ // S590
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6768,7 +37218,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6778,20 +37231,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S183
+	gotoRow{ // S591
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6805,7 +37281,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6815,20 +37294,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S184
+	gotoRow{ // S592
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6842,7 +37344,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6852,20 +37357,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S185
+	gotoRow{ // S593
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6879,7 +37407,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6889,20 +37420,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S186
+	gotoRow{ // S594
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6916,7 +37470,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6926,20 +37483,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S187
+	gotoRow{ // S595
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -6953,7 +37533,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -6963,34 +37546,60 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S188
+	gotoRow{ // S596
 		-1,  // S'
 		-1,  // QueryUnit
 		-1,  // SelectQuery
 		-1,  // CountQuery
 		-1,  // UpdateQuery
+		-1,  // DeleteQuery
+		-1,  // ConstructQuery
 		-1,  // VersionsQuery
 		-1,  // VersionGraphSelection
 		-1,  // LimitClause
+		-1,  // Limit
+		-1,  // Offset
+		-1,  // SolutionModifier
+		-1,  // OrderModifier
+		-1,  // OrderClause
+		-1,  // OrderCondition
 		-1,  // SelectClause
+		-1,  // SelectProjection
+		-1,  // SelectList
+		-1,  // SelectItem
+		-1,  // Aggregate
 		-1,  // InsertClause
+		-1,  // DeleteClause
+		-1,  // ConstructClause
 		-1,  // CountClause
 		-1,  // Varlist
 		-1,  // DBlist
 		-1,  // String
-		46,  // Var
+		467, // Var
 		-1,  // Number
 		-1,  // DatasetClause
 		-1,  // DatasetClauseInsert
 		-1,  // TimeClause
 		-1,  // WhereClause
-		195, // TriplesBlock
-		89,  // Triple
-		90,  // VarOrTerm
-		50,  // GraphTerm
+		-1,  // TriplesBlock
+		-1,  // Triple
+		-1,  // VarOrTerm
+		-1,  // BlankNode
+		-1,  // PropertyList
+		-1,  // GraphTerm
+		474, // Literal
 		-1,  // Path
 		-1,  // PathSequence
 		-1,  // PathElt
@@ -7000,20 +37609,43 @@ var gotoTab = gotoTable{
 		-1,  // RestOfWhere
 		-1,  // Joiner
 		-1,  // GraphPatternNotTriples
+		-1,  // Constraint
+		476, // Expression
+		478, // ConditionalAndExpression
+		479, // RelationalExpression
+		480, // UnaryExpression
+		481, // PrimaryExpression
+		477, // BuiltInCall
+		483, // FunctionName
+		603, // ArgList
 		-1,  // GroupGraphPattern
 		-1,  // GroupGraphPatternSub
 	},
-	gotoRow{ // S189
+	gotoRow{ // S597
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -7027,7 +37659,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -7037,20 +37672,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S190
+	gotoRow{ // S598
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -7064,7 +37722,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -7074,57 +37735,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S191
-		-1,  // S'
-		-1,  // QueryUnit
-		-1,  // SelectQuery
-		-1,  // CountQuery
-		-1,  // UpdateQuery
-		-1,  // VersionsQuery
-		-1,  // VersionGraphSelection
-		-1,  // LimitClause
-		-1,  // SelectClause
-		-1,  // InsertClause
-		-1,  // CountClause
-		-1,  // Varlist
-		-1,  // DBlist
-		-1,  // String
-		46,  // Var
-		-1,  // Number
-		-1,  // DatasetClause
-		-1,  // DatasetClauseInsert
-		-1,  // TimeClause
-		-1,  // WhereClause
-		-1,  // TriplesBlock
-		168, // Triple
-		90,  // VarOrTerm
-		50,  // GraphTerm
-		-1,  // Path
-		-1,  // PathSequence
-		-1,  // PathElt
-		-1,  // PathPrimary
-		-1,  // PathMod
-		-1,  // RestOfWhereList
-		-1,  // RestOfWhere
-		-1,  // Joiner
-		-1,  // GraphPatternNotTriples
-		-1,  // GroupGraphPattern
-		-1,  // GroupGraphPatternSub
+	gotoRow{ // S599
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S192
+	gotoRow{ // S600
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -7138,7 +37848,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -7148,20 +37861,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S193
+	gotoRow{ // S601
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -7175,7 +37911,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -7185,20 +37924,43 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S194
+	gotoRow{ // S602
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -7212,7 +37974,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -7222,20 +37987,106 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
-	gotoRow{ // S195
+	gotoRow{ // S603
+		-1, // S'
+		-1, // QueryUnit
+		-1, // SelectQuery
+		-1, // CountQuery
+		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
+		-1, // VersionsQuery
+		-1, // VersionGraphSelection
+		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
+		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
+		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
+		-1, // CountClause
+		-1, // Varlist
+		-1, // DBlist
+		-1, // String
+		-1, // Var
+		-1, // Number
+		-1, // DatasetClause
+		-1, // DatasetClauseInsert
+		-1, // TimeClause
+		-1, // WhereClause
+		-1, // TriplesBlock
+		-1, // Triple
+		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
+		-1, // GraphTerm
+		-1, // Literal
+		-1, // Path
+		-1, // PathSequence
+		-1, // PathElt
+		-1, // PathPrimary
+		-1, // PathMod
+		-1, // RestOfWhereList
+		-1, // RestOfWhere
+		-1, // Joiner
+		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
+		-1, // GroupGraphPattern
+		-1, // GroupGraphPatternSub
+	},
+	gotoRow{ // S604
 		-1, // S'
 		-1, // QueryUnit
 		-1, // SelectQuery
 		-1, // CountQuery
 		-1, // UpdateQuery
+		-1, // DeleteQuery
+		-1, // ConstructQuery
 		-1, // VersionsQuery
 		-1, // VersionGraphSelection
 		-1, // LimitClause
+		-1, // Limit
+		-1, // Offset
+		-1, // SolutionModifier
+		-1, // OrderModifier
+		-1, // OrderClause
+		-1, // OrderCondition
 		-1, // SelectClause
+		-1, // SelectProjection
+		-1, // SelectList
+		-1, // SelectItem
+		-1, // Aggregate
 		-1, // InsertClause
+		-1, // DeleteClause
+		-1, // ConstructClause
 		-1, // CountClause
 		-1, // Varlist
 		-1, // DBlist
@@ -7249,7 +38100,10 @@ var gotoTab = gotoTable{
 		-1, // TriplesBlock
 		-1, // Triple
 		-1, // VarOrTerm
+		-1, // BlankNode
+		-1, // PropertyList
 		-1, // GraphTerm
+		-1, // Literal
 		-1, // Path
 		-1, // PathSequence
 		-1, // PathElt
@@ -7259,6 +38113,15 @@ var gotoTab = gotoTable{
 		-1, // RestOfWhere
 		-1, // Joiner
 		-1, // GraphPatternNotTriples
+		-1, // Constraint
+		-1, // Expression
+		-1, // ConditionalAndExpression
+		-1, // RelationalExpression
+		-1, // UnaryExpression
+		-1, // PrimaryExpression
+		-1, // BuiltInCall
+		-1, // FunctionName
+		-1, // ArgList
 		-1, // GroupGraphPattern
 		-1, // GroupGraphPatternSub
 	},
@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 81
-	numStates      = 196
-	numSymbols     = 69
+	numProductions = 178
+	numStates      = 605
+	numSymbols     = 139
 )
 
 // Stack
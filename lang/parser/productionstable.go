@@ -61,7 +61,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `QueryUnit : VersionsQuery	<< X[0], nil >>`,
+		String: `QueryUnit : DeleteQuery	<< X[0], nil >>`,
 		Id:         "QueryUnit",
 		NTType:     1,
 		Index:      4,
@@ -70,11 +70,31 @@ var productionsTable = ProdTab{
 			return X[0], nil
 		},
 	},
+	ProdTabEntry{
+		String: `QueryUnit : ConstructQuery	<< X[0], nil >>`,
+		Id:         "QueryUnit",
+		NTType:     1,
+		Index:      5,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `QueryUnit : VersionsQuery	<< X[0], nil >>`,
+		Id:         "QueryUnit",
+		NTType:     1,
+		Index:      6,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `SelectQuery : SelectClause DatasetClause WhereClause TimeClause	<< ast.NewQueryMulti(X[0], X[1], X[2], X[3], false) >>`,
 		Id:         "SelectQuery",
 		NTType:     2,
-		Index:      5,
+		Index:      7,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewQueryMulti(X[0], X[1], X[2], X[3], false)
@@ -84,17 +104,27 @@ var productionsTable = ProdTab{
 		String: `SelectQuery : SelectClause DatasetClause WhereClause	<< ast.NewQueryMulti(X[0], X[1], X[2], nil, false) >>`,
 		Id:         "SelectQuery",
 		NTType:     2,
-		Index:      6,
+		Index:      8,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewQueryMulti(X[0], X[1], X[2], nil, false)
 		},
 	},
+	ProdTabEntry{
+		String: `SelectQuery : SelectClause DatasetClause WhereClause SolutionModifier TimeClause	<< ast.NewModifiedQuery(X[0], X[1], X[2], X[3], X[4]) >>`,
+		Id:         "SelectQuery",
+		NTType:     2,
+		Index:      9,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewModifiedQuery(X[0], X[1], X[2], X[3], X[4])
+		},
+	},
 	ProdTabEntry{
 		String: `CountQuery : CountClause DatasetClause WhereClause TimeClause	<< ast.NewQueryMulti(X[0], X[1], X[2], X[3], true) >>`,
 		Id:         "CountQuery",
 		NTType:     3,
-		Index:      7,
+		Index:      10,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewQueryMulti(X[0], X[1], X[2], X[3], true)
@@ -104,7 +134,7 @@ var productionsTable = ProdTab{
 		String: `CountQuery : CountClause DatasetClause WhereClause	<< ast.NewQueryMulti(X[0], X[1], X[2], nil, true) >>`,
 		Id:         "CountQuery",
 		NTType:     3,
-		Index:      8,
+		Index:      11,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewQueryMulti(X[0], X[1], X[2], nil, true)
@@ -114,687 +144,1617 @@ var productionsTable = ProdTab{
 		String: `UpdateQuery : InsertClause DatasetClauseInsert WhereClause	<< ast.NewInsertQueryMulti(X[0], X[1], X[2], false) >>`,
 		Id:         "UpdateQuery",
 		NTType:     4,
-		Index:      9,
+		Index:      12,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewInsertQueryMulti(X[0], X[1], X[2], false)
 		},
 	},
 	ProdTabEntry{
-		String: `VersionsQuery : "LIST" "NAMES"	<< ast.NewVersionQueryNames() >>`,
-		Id:         "VersionsQuery",
-		NTType:     5,
-		Index:      10,
-		NumSymbols: 2,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewVersionQueryNames()
-		},
-	},
-	ProdTabEntry{
-		String: `VersionsQuery : "LIST" "VERSIONS" TimeClause VersionGraphSelection LimitClause	<< ast.NewVersionQuery(X[2], X[3], X[4]) >>`,
-		Id:         "VersionsQuery",
+		String: `DeleteQuery : DeleteClause DatasetClause WhereClause	<< ast.NewDeleteQueryMulti(X[0], X[1], X[2]) >>`,
+		Id:         "DeleteQuery",
 		NTType:     5,
-		Index:      11,
-		NumSymbols: 5,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewVersionQuery(X[2], X[3], X[4])
-		},
-	},
-	ProdTabEntry{
-		String: `VersionGraphSelection : "FOR" DBlist	<< ast.NewFromClause(X[1]) >>`,
-		Id:         "VersionGraphSelection",
-		NTType:     6,
-		Index:      12,
-		NumSymbols: 2,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewFromClause(X[1])
-		},
-	},
-	ProdTabEntry{
-		String: `VersionGraphSelection : "FOR" "*"	<< ast.NewAllFromClause() >>`,
-		Id:         "VersionGraphSelection",
-		NTType:     6,
 		Index:      13,
-		NumSymbols: 2,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewAllFromClause()
+			return ast.NewDeleteQueryMulti(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `VersionGraphSelection : empty	<< ast.NewAllFromClause() >>`,
-		Id:         "VersionGraphSelection",
+		String: `ConstructQuery : ConstructClause DatasetClause WhereClause	<< ast.NewConstructQueryMulti(X[0], X[1], X[2]) >>`,
+		Id:         "ConstructQuery",
 		NTType:     6,
 		Index:      14,
-		NumSymbols: 0,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewAllFromClause()
+			return ast.NewConstructQueryMulti(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `LimitClause : "LIMIT" Number	<< X[1], nil >>`,
-		Id:         "LimitClause",
+		String: `VersionsQuery : "LIST" "NAMES"	<< ast.NewVersionQueryNames() >>`,
+		Id:         "VersionsQuery",
 		NTType:     7,
 		Index:      15,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[1], nil
+			return ast.NewVersionQueryNames()
 		},
 	},
 	ProdTabEntry{
-		String: `LimitClause : empty	<< -1, nil >>`,
-		Id:         "LimitClause",
+		String: `VersionsQuery : "LIST" "VERSIONS" TimeClause VersionGraphSelection LimitClause	<< ast.NewVersionQuery(X[2], X[3], X[4]) >>`,
+		Id:         "VersionsQuery",
 		NTType:     7,
 		Index:      16,
-		NumSymbols: 0,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return -1, nil
+			return ast.NewVersionQuery(X[2], X[3], X[4])
 		},
 	},
 	ProdTabEntry{
-		String: `SelectClause : "SELECT" "*"	<< ast.NewAllSelectClause() >>`,
-		Id:         "SelectClause",
+		String: `VersionGraphSelection : "FOR" DBlist	<< ast.NewFromClause(X[1]) >>`,
+		Id:         "VersionGraphSelection",
 		NTType:     8,
 		Index:      17,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewAllSelectClause()
+			return ast.NewFromClause(X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `SelectClause : "SELECT" Varlist	<< ast.NewSelectClause(X[1]) >>`,
-		Id:         "SelectClause",
+		String: `VersionGraphSelection : "FOR" "*"	<< ast.NewAllFromClause() >>`,
+		Id:         "VersionGraphSelection",
 		NTType:     8,
 		Index:      18,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewSelectClause(X[1])
+			return ast.NewAllFromClause()
 		},
 	},
 	ProdTabEntry{
-		String: `InsertClause : "INSERT" "{" TriplesBlock "}"	<< ast.NewInsertClause(X[2]) >>`,
-		Id:         "InsertClause",
-		NTType:     9,
+		String: `VersionGraphSelection : empty	<< ast.NewAllFromClause() >>`,
+		Id:         "VersionGraphSelection",
+		NTType:     8,
 		Index:      19,
-		NumSymbols: 4,
+		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewInsertClause(X[2])
+			return ast.NewAllFromClause()
 		},
 	},
 	ProdTabEntry{
-		String: `InsertClause : "INSERT" "{" TriplesBlock "." "}"	<< ast.NewInsertClause(X[2]) >>`,
-		Id:         "InsertClause",
+		String: `LimitClause : Limit	<< X[0], nil >>`,
+		Id:         "LimitClause",
 		NTType:     9,
 		Index:      20,
-		NumSymbols: 5,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewInsertClause(X[2])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `CountClause : "COUNT" "*"	<< ast.NewAllSelectClause() >>`,
-		Id:         "CountClause",
-		NTType:     10,
+		String: `LimitClause : empty	<< -1, nil >>`,
+		Id:         "LimitClause",
+		NTType:     9,
 		Index:      21,
-		NumSymbols: 2,
+		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewAllSelectClause()
+			return -1, nil
 		},
 	},
 	ProdTabEntry{
-		String: `CountClause : "COUNT" Varlist	<< ast.NewSelectClause(X[1]) >>`,
-		Id:         "CountClause",
+		String: `Limit : "LIMIT" Number	<< X[1], nil >>`,
+		Id:         "Limit",
 		NTType:     10,
 		Index:      22,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewSelectClause(X[1])
+			return X[1], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Varlist : Var	<< ast.NewVarList(X[0]) >>`,
-		Id:         "Varlist",
+		String: `Offset : "OFFSET" Number	<< X[1], nil >>`,
+		Id:         "Offset",
 		NTType:     11,
 		Index:      23,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewVarList(X[0])
+			return X[1], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Varlist : Varlist Var	<< ast.AppendVar(X[0], X[1]) >>`,
-		Id:         "Varlist",
-		NTType:     11,
+		String: `SolutionModifier : "GROUP" "BY" Varlist	<< ast.NewGroupModifier(X[2], nil) >>`,
+		Id:         "SolutionModifier",
+		NTType:     12,
 		Index:      24,
-		NumSymbols: 2,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AppendVar(X[0], X[1])
+			return ast.NewGroupModifier(X[2], nil)
 		},
 	},
 	ProdTabEntry{
-		String: `DBlist : String	<< ast.NewStringList(X[0]) >>`,
-		Id:         "DBlist",
+		String: `SolutionModifier : "GROUP" "BY" Varlist OrderModifier	<< ast.NewGroupModifier(X[2], X[3]) >>`,
+		Id:         "SolutionModifier",
 		NTType:     12,
 		Index:      25,
-		NumSymbols: 1,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewStringList(X[0])
+			return ast.NewGroupModifier(X[2], X[3])
 		},
 	},
 	ProdTabEntry{
-		String: `DBlist : DBlist String	<< ast.AppendString(X[0], X[1]) >>`,
-		Id:         "DBlist",
+		String: `SolutionModifier : OrderModifier	<< X[0], nil >>`,
+		Id:         "SolutionModifier",
 		NTType:     12,
 		Index:      26,
-		NumSymbols: 2,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AppendString(X[0], X[1])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `String : string	<< ast.ParseString(X[0]) >>`,
-		Id:         "String",
+		String: `OrderModifier : OrderClause	<< ast.NewSolutionModifier(X[0], -1, 0) >>`,
+		Id:         "OrderModifier",
 		NTType:     13,
 		Index:      27,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.ParseString(X[0])
+			return ast.NewSolutionModifier(X[0], -1, 0)
 		},
 	},
 	ProdTabEntry{
-		String: `Var : var	<< ast.ParseString(X[0]) >>`,
-		Id:         "Var",
-		NTType:     14,
+		String: `OrderModifier : OrderClause Limit	<< ast.NewSolutionModifier(X[0], X[1], 0) >>`,
+		Id:         "OrderModifier",
+		NTType:     13,
 		Index:      28,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.ParseString(X[0])
+			return ast.NewSolutionModifier(X[0], X[1], 0)
 		},
 	},
 	ProdTabEntry{
-		String: `Number : string	<< ast.ParseNumber(X[0]) >>`,
-		Id:         "Number",
-		NTType:     15,
+		String: `OrderModifier : OrderClause Offset	<< ast.NewSolutionModifier(X[0], -1, X[1]) >>`,
+		Id:         "OrderModifier",
+		NTType:     13,
 		Index:      29,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.ParseNumber(X[0])
+			return ast.NewSolutionModifier(X[0], -1, X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `DatasetClause : "FROM" DBlist	<< ast.NewFromClause(X[1]) >>`,
-		Id:         "DatasetClause",
-		NTType:     16,
+		String: `OrderModifier : OrderClause Limit Offset	<< ast.NewSolutionModifier(X[0], X[1], X[2]) >>`,
+		Id:         "OrderModifier",
+		NTType:     13,
 		Index:      30,
-		NumSymbols: 2,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewFromClause(X[1])
+			return ast.NewSolutionModifier(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `DatasetClause : "FROM" "*"	<< ast.NewAllFromClause() >>`,
-		Id:         "DatasetClause",
-		NTType:     16,
+		String: `OrderModifier : OrderClause Offset Limit	<< ast.NewSolutionModifier(X[0], X[2], X[1]) >>`,
+		Id:         "OrderModifier",
+		NTType:     13,
 		Index:      31,
-		NumSymbols: 2,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewAllFromClause()
+			return ast.NewSolutionModifier(X[0], X[2], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `DatasetClause : empty	<< ast.NewAllFromClause() >>`,
-		Id:         "DatasetClause",
-		NTType:     16,
+		String: `OrderModifier : Limit	<< ast.NewSolutionModifier(nil, X[0], 0) >>`,
+		Id:         "OrderModifier",
+		NTType:     13,
 		Index:      32,
-		NumSymbols: 0,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewAllFromClause()
+			return ast.NewSolutionModifier(nil, X[0], 0)
 		},
 	},
 	ProdTabEntry{
-		String: `DatasetClauseInsert : "TO" DBlist	<< ast.NewFromClause(X[1]) >>`,
-		Id:         "DatasetClauseInsert",
-		NTType:     17,
+		String: `OrderModifier : Offset	<< ast.NewSolutionModifier(nil, -1, X[0]) >>`,
+		Id:         "OrderModifier",
+		NTType:     13,
 		Index:      33,
-		NumSymbols: 2,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewFromClause(X[1])
+			return ast.NewSolutionModifier(nil, -1, X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `DatasetClauseInsert : "TO" "*"	<< ast.NewAllFromClause() >>`,
-		Id:         "DatasetClauseInsert",
-		NTType:     17,
+		String: `OrderModifier : Limit Offset	<< ast.NewSolutionModifier(nil, X[0], X[1]) >>`,
+		Id:         "OrderModifier",
+		NTType:     13,
 		Index:      34,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewAllFromClause()
+			return ast.NewSolutionModifier(nil, X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `DatasetClauseInsert : empty	<< ast.NewAllFromClause() >>`,
-		Id:         "DatasetClauseInsert",
-		NTType:     17,
+		String: `OrderModifier : Offset Limit	<< ast.NewSolutionModifier(nil, X[1], X[0]) >>`,
+		Id:         "OrderModifier",
+		NTType:     13,
 		Index:      35,
-		NumSymbols: 0,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewAllFromClause()
+			return ast.NewSolutionModifier(nil, X[1], X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `TimeClause : "AT" String	<< ast.NewTimeClause(ast.AT, X[1]) >>`,
-		Id:         "TimeClause",
-		NTType:     18,
+		String: `OrderClause : "ORDER" "BY" OrderCondition	<< ast.NewOrderConditions(X[2]) >>`,
+		Id:         "OrderClause",
+		NTType:     14,
 		Index:      36,
-		NumSymbols: 2,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewTimeClause(ast.AT, X[1])
+			return ast.NewOrderConditions(X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `TimeClause : "BEFORE" String	<< ast.NewTimeClause(ast.BEFORE, X[1]) >>`,
-		Id:         "TimeClause",
-		NTType:     18,
+		String: `OrderClause : OrderClause OrderCondition	<< ast.AppendOrderCondition(X[0], X[1]) >>`,
+		Id:         "OrderClause",
+		NTType:     14,
 		Index:      37,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewTimeClause(ast.BEFORE, X[1])
+			return ast.AppendOrderCondition(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `TimeClause : "AFTER" String	<< ast.NewTimeClause(ast.AFTER, X[1]) >>`,
-		Id:         "TimeClause",
-		NTType:     18,
+		String: `OrderCondition : Var	<< ast.NewOrderCondition(X[0], false) >>`,
+		Id:         "OrderCondition",
+		NTType:     15,
 		Index:      38,
-		NumSymbols: 2,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewTimeClause(ast.AFTER, X[1])
+			return ast.NewOrderCondition(X[0], false)
 		},
 	},
 	ProdTabEntry{
-		String: `TimeClause : empty	<< ast.NewTimeClause(ast.AT, "now") >>`,
-		Id:         "TimeClause",
-		NTType:     18,
+		String: `OrderCondition : Var "ASC"	<< ast.NewOrderCondition(X[0], false) >>`,
+		Id:         "OrderCondition",
+		NTType:     15,
 		Index:      39,
-		NumSymbols: 0,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewTimeClause(ast.AT, "now")
+			return ast.NewOrderCondition(X[0], false)
 		},
 	},
 	ProdTabEntry{
-		String: `WhereClause : "WHERE" "{" "}"	<< ast.NewWhereClause([]ast.Triple{}) >>`,
-		Id:         "WhereClause",
-		NTType:     19,
+		String: `OrderCondition : Var "DESC"	<< ast.NewOrderCondition(X[0], true) >>`,
+		Id:         "OrderCondition",
+		NTType:     15,
 		Index:      40,
-		NumSymbols: 3,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewWhereClause([]ast.Triple{})
+			return ast.NewOrderCondition(X[0], true)
 		},
 	},
 	ProdTabEntry{
-		String: `WhereClause : "WHERE" "{" TriplesBlock "}"	<< ast.NewWhereClause(X[2]) >>`,
-		Id:         "WhereClause",
-		NTType:     19,
+		String: `SelectClause : "SELECT" SelectProjection	<< X[1], nil >>`,
+		Id:         "SelectClause",
+		NTType:     16,
 		Index:      41,
-		NumSymbols: 4,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewWhereClause(X[2])
+			return X[1], nil
 		},
 	},
 	ProdTabEntry{
-		String: `WhereClause : "WHERE" "{" TriplesBlock "." "}"	<< ast.NewWhereClause(X[2]) >>`,
-		Id:         "WhereClause",
-		NTType:     19,
+		String: `SelectClause : "SELECT" "DISTINCT" SelectProjection	<< ast.NewDistinctSelectClause(X[2], false) >>`,
+		Id:         "SelectClause",
+		NTType:     16,
 		Index:      42,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewDistinctSelectClause(X[2], false)
+		},
+	},
+	ProdTabEntry{
+		String: `SelectClause : "SELECT" "REDUCED" SelectProjection	<< ast.NewDistinctSelectClause(X[2], true) >>`,
+		Id:         "SelectClause",
+		NTType:     16,
+		Index:      43,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewDistinctSelectClause(X[2], true)
+		},
+	},
+	ProdTabEntry{
+		String: `SelectProjection : "*"	<< ast.NewAllSelectClause() >>`,
+		Id:         "SelectProjection",
+		NTType:     17,
+		Index:      44,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAllSelectClause()
+		},
+	},
+	ProdTabEntry{
+		String: `SelectProjection : SelectList	<< ast.NewSelectClauseFromList(X[0]) >>`,
+		Id:         "SelectProjection",
+		NTType:     17,
+		Index:      45,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewSelectClauseFromList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `SelectList : SelectItem	<< ast.NewSelectList(X[0]) >>`,
+		Id:         "SelectList",
+		NTType:     18,
+		Index:      46,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewSelectList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `SelectList : SelectList SelectItem	<< ast.AppendSelectList(X[0], X[1]) >>`,
+		Id:         "SelectList",
+		NTType:     18,
+		Index:      47,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendSelectList(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `SelectItem : Var	<< X[0], nil >>`,
+		Id:         "SelectItem",
+		NTType:     19,
+		Index:      48,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `SelectItem : "(" Aggregate "AS" Var ")"	<< ast.NameAggregate(X[1], X[3]) >>`,
+		Id:         "SelectItem",
+		NTType:     19,
+		Index:      49,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NameAggregate(X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "COUNT" "(" "*" ")"	<< ast.NewAggregate("count", "*", false, nil) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      50,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("count", "*", false, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "COUNT" "(" Var ")"	<< ast.NewAggregate("count", X[2], false, nil) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      51,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("count", X[2], false, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "COUNT" "(" "DISTINCT" Var ")"	<< ast.NewAggregate("count", X[3], true, nil) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      52,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("count", X[3], true, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "MIN" "(" Var ")"	<< ast.NewAggregate("min", X[2], false, nil) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      53,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("min", X[2], false, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "MAX" "(" Var ")"	<< ast.NewAggregate("max", X[2], false, nil) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      54,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("max", X[2], false, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "SAMPLE" "(" Var ")"	<< ast.NewAggregate("sample", X[2], false, nil) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      55,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("sample", X[2], false, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "GROUP_CONCAT" "(" Var ")"	<< ast.NewAggregate("group_concat", X[2], false, nil) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      56,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("group_concat", X[2], false, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "GROUP_CONCAT" "(" "DISTINCT" Var ")"	<< ast.NewAggregate("group_concat", X[3], true, nil) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      57,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("group_concat", X[3], true, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "GROUP_CONCAT" "(" Var ";" "SEPARATOR" "=" quotedstring ")"	<< ast.NewAggregate("group_concat", X[2], false, X[6]) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      58,
+		NumSymbols: 8,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("group_concat", X[2], false, X[6])
+		},
+	},
+	ProdTabEntry{
+		String: `Aggregate : "GROUP_CONCAT" "(" "DISTINCT" Var ";" "SEPARATOR" "=" quotedstring ")"	<< ast.NewAggregate("group_concat", X[3], true, X[7]) >>`,
+		Id:         "Aggregate",
+		NTType:     20,
+		Index:      59,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAggregate("group_concat", X[3], true, X[7])
+		},
+	},
+	ProdTabEntry{
+		String: `InsertClause : "INSERT" "{" TriplesBlock "}"	<< ast.NewInsertClause(X[2]) >>`,
+		Id:         "InsertClause",
+		NTType:     21,
+		Index:      60,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewInsertClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `InsertClause : "INSERT" "{" TriplesBlock "." "}"	<< ast.NewInsertClause(X[2]) >>`,
+		Id:         "InsertClause",
+		NTType:     21,
+		Index:      61,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewInsertClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `DeleteClause : "DELETE" "{" TriplesBlock "}"	<< ast.NewDeleteClause(X[2]) >>`,
+		Id:         "DeleteClause",
+		NTType:     22,
+		Index:      62,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewDeleteClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `DeleteClause : "DELETE" "{" TriplesBlock "." "}"	<< ast.NewDeleteClause(X[2]) >>`,
+		Id:         "DeleteClause",
+		NTType:     22,
+		Index:      63,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewDeleteClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ConstructClause : "CONSTRUCT" "{" TriplesBlock "}"	<< ast.NewConstructClause(X[2]) >>`,
+		Id:         "ConstructClause",
+		NTType:     23,
+		Index:      64,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewConstructClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ConstructClause : "CONSTRUCT" "{" TriplesBlock "." "}"	<< ast.NewConstructClause(X[2]) >>`,
+		Id:         "ConstructClause",
+		NTType:     23,
+		Index:      65,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewWhereClause(X[2])
+			return ast.NewConstructClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `CountClause : "COUNT" "*"	<< ast.NewAllSelectClause() >>`,
+		Id:         "CountClause",
+		NTType:     24,
+		Index:      66,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAllSelectClause()
+		},
+	},
+	ProdTabEntry{
+		String: `CountClause : "COUNT" Varlist	<< ast.NewSelectClause(X[1]) >>`,
+		Id:         "CountClause",
+		NTType:     24,
+		Index:      67,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewSelectClause(X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Varlist : Var	<< ast.NewVarList(X[0]) >>`,
+		Id:         "Varlist",
+		NTType:     25,
+		Index:      68,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewVarList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Varlist : Varlist Var	<< ast.AppendVar(X[0], X[1]) >>`,
+		Id:         "Varlist",
+		NTType:     25,
+		Index:      69,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendVar(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `DBlist : String	<< ast.NewStringList(X[0]) >>`,
+		Id:         "DBlist",
+		NTType:     26,
+		Index:      70,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewStringList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `DBlist : DBlist String	<< ast.AppendString(X[0], X[1]) >>`,
+		Id:         "DBlist",
+		NTType:     26,
+		Index:      71,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendString(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `String : string	<< ast.ParseString(X[0]) >>`,
+		Id:         "String",
+		NTType:     27,
+		Index:      72,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.ParseString(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Var : var	<< ast.ParseString(X[0]) >>`,
+		Id:         "Var",
+		NTType:     28,
+		Index:      73,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.ParseString(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Number : string	<< ast.ParseNumber(X[0]) >>`,
+		Id:         "Number",
+		NTType:     29,
+		Index:      74,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.ParseNumber(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `DatasetClause : "FROM" DBlist	<< ast.NewFromClause(X[1]) >>`,
+		Id:         "DatasetClause",
+		NTType:     30,
+		Index:      75,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewFromClause(X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `DatasetClause : "FROM" "*"	<< ast.NewAllFromClause() >>`,
+		Id:         "DatasetClause",
+		NTType:     30,
+		Index:      76,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAllFromClause()
+		},
+	},
+	ProdTabEntry{
+		String: `DatasetClause : empty	<< ast.NewAllFromClause() >>`,
+		Id:         "DatasetClause",
+		NTType:     30,
+		Index:      77,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAllFromClause()
+		},
+	},
+	ProdTabEntry{
+		String: `DatasetClauseInsert : "TO" DBlist	<< ast.NewFromClause(X[1]) >>`,
+		Id:         "DatasetClauseInsert",
+		NTType:     31,
+		Index:      78,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewFromClause(X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `DatasetClauseInsert : "TO" "*"	<< ast.NewAllFromClause() >>`,
+		Id:         "DatasetClauseInsert",
+		NTType:     31,
+		Index:      79,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAllFromClause()
+		},
+	},
+	ProdTabEntry{
+		String: `DatasetClauseInsert : empty	<< ast.NewAllFromClause() >>`,
+		Id:         "DatasetClauseInsert",
+		NTType:     31,
+		Index:      80,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewAllFromClause()
+		},
+	},
+	ProdTabEntry{
+		String: `TimeClause : "AT" String	<< ast.NewTimeClause(ast.AT, X[1]) >>`,
+		Id:         "TimeClause",
+		NTType:     32,
+		Index:      81,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewTimeClause(ast.AT, X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TimeClause : "BEFORE" String	<< ast.NewTimeClause(ast.BEFORE, X[1]) >>`,
+		Id:         "TimeClause",
+		NTType:     32,
+		Index:      82,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewTimeClause(ast.BEFORE, X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TimeClause : "AFTER" String	<< ast.NewTimeClause(ast.AFTER, X[1]) >>`,
+		Id:         "TimeClause",
+		NTType:     32,
+		Index:      83,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewTimeClause(ast.AFTER, X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TimeClause : empty	<< ast.NewTimeClause(ast.AT, "now") >>`,
+		Id:         "TimeClause",
+		NTType:     32,
+		Index:      84,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewTimeClause(ast.AT, "now")
+		},
+	},
+	ProdTabEntry{
+		String: `WhereClause : "WHERE" "{" "}"	<< ast.NewWhereClause([]ast.Triple{}) >>`,
+		Id:         "WhereClause",
+		NTType:     33,
+		Index:      85,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewWhereClause([]ast.Triple{})
+		},
+	},
+	ProdTabEntry{
+		String: `WhereClause : "WHERE" "{" TriplesBlock "}"	<< ast.NewWhereClause(X[2]) >>`,
+		Id:         "WhereClause",
+		NTType:     33,
+		Index:      86,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewWhereClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `WhereClause : "WHERE" "{" TriplesBlock "." "}"	<< ast.NewWhereClause(X[2]) >>`,
+		Id:         "WhereClause",
+		NTType:     33,
+		Index:      87,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewWhereClause(X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `WhereClause : "WHERE" "{" TriplesBlock RestOfWhereList "}"	<< ast.NewWhereClauseWithGraphGroup(X[2], X[3]) >>`,
+		Id:         "WhereClause",
+		NTType:     33,
+		Index:      88,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewWhereClauseWithGraphGroup(X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `WhereClause : "WHERE" "{" TriplesBlock "." RestOfWhereList "}"	<< ast.NewWhereClauseWithGraphGroup(X[2], X[4]) >>`,
+		Id:         "WhereClause",
+		NTType:     33,
+		Index:      89,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewWhereClauseWithGraphGroup(X[2], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `WhereClause : "WHERE" "{" RestOfWhereList "}"	<< ast.NewWhereClauseGraphGroup(X[2]) >>`,
+		Id:         "WhereClause",
+		NTType:     33,
+		Index:      90,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewWhereClauseGraphGroup(X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `WhereClause : empty	<< ast.NewWhereClause([]ast.Triple{}) >>`,
+		Id:         "WhereClause",
+		NTType:     33,
+		Index:      91,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewWhereClause([]ast.Triple{})
+		},
+	},
+	ProdTabEntry{
+		String: `TriplesBlock : Triple	<< ast.NewTripleBlock(X[0]) >>`,
+		Id:         "TriplesBlock",
+		NTType:     34,
+		Index:      92,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewTripleBlock(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `TriplesBlock : TriplesBlock "." Triple	<< ast.AppendTripleBlock(X[0], X[2]) >>`,
+		Id:         "TriplesBlock",
+		NTType:     34,
+		Index:      93,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendTripleBlock(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Triple : VarOrTerm Path VarOrTerm	<< ast.NewTriple(X[0], X[1], X[2]) >>`,
+		Id:         "Triple",
+		NTType:     35,
+		Index:      94,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewTriple(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `VarOrTerm : Var	<< ast.NewURI(X[0]) >>`,
+		Id:         "VarOrTerm",
+		NTType:     36,
+		Index:      95,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewURI(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `VarOrTerm : GraphTerm	<< X[0], nil >>`,
+		Id:         "VarOrTerm",
+		NTType:     36,
+		Index:      96,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `VarOrTerm : BlankNode	<< X[0], nil >>`,
+		Id:         "VarOrTerm",
+		NTType:     36,
+		Index:      97,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BlankNode : "[" "]"	<< ast.NewBlankNode(nil) >>`,
+		Id:         "BlankNode",
+		NTType:     37,
+		Index:      98,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewBlankNode(nil)
+		},
+	},
+	ProdTabEntry{
+		String: `BlankNode : "[" PropertyList "]"	<< ast.NewBlankNode(X[1]) >>`,
+		Id:         "BlankNode",
+		NTType:     37,
+		Index:      99,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewBlankNode(X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `BlankNode : "[" PropertyList ";" "]"	<< ast.NewBlankNode(X[1]) >>`,
+		Id:         "BlankNode",
+		NTType:     37,
+		Index:      100,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewBlankNode(X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `PropertyList : Path VarOrTerm	<< ast.NewPropertyList(X[0], X[1]) >>`,
+		Id:         "PropertyList",
+		NTType:     38,
+		Index:      101,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewPropertyList(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `PropertyList : PropertyList ";" Path VarOrTerm	<< ast.AppendPropertyList(X[0], X[2], X[3]) >>`,
+		Id:         "PropertyList",
+		NTType:     38,
+		Index:      102,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendPropertyList(X[0], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `GraphTerm : uri	<< ast.NewIRI(X[0]) >>`,
+		Id:         "GraphTerm",
+		NTType:     39,
+		Index:      103,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewIRI(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `GraphTerm : url	<< ast.NewIRI(X[0]) >>`,
+		Id:         "GraphTerm",
+		NTType:     39,
+		Index:      104,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewIRI(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `GraphTerm : Literal	<< X[0], nil >>`,
+		Id:         "GraphTerm",
+		NTType:     39,
+		Index:      105,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `GraphTerm : string	<< ast.NewNumber(X[0]) >>`,
+		Id:         "GraphTerm",
+		NTType:     39,
+		Index:      106,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewNumber(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Literal : quotedstring	<< ast.NewLiteral(X[0], nil, nil) >>`,
+		Id:         "Literal",
+		NTType:     40,
+		Index:      107,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewLiteral(X[0], nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Literal : quotedstring langtag	<< ast.NewLiteral(X[0], X[1], nil) >>`,
+		Id:         "Literal",
+		NTType:     40,
+		Index:      108,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewLiteral(X[0], X[1], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Literal : quotedstring "^^" uri	<< ast.NewLiteral(X[0], nil, X[2]) >>`,
+		Id:         "Literal",
+		NTType:     40,
+		Index:      109,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewLiteral(X[0], nil, X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Literal : quotedstring "^^" url	<< ast.NewLiteral(X[0], nil, X[2]) >>`,
+		Id:         "Literal",
+		NTType:     40,
+		Index:      110,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewLiteral(X[0], nil, X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Literal : decimal	<< ast.NewNumber(X[0]) >>`,
+		Id:         "Literal",
+		NTType:     40,
+		Index:      111,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewNumber(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Path : PathSequence	<< X[0], nil >>`,
+		Id:         "Path",
+		NTType:     41,
+		Index:      112,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Path : Path "|" PathSequence	<<  >>`,
+		Id:         "Path",
+		NTType:     41,
+		Index:      113,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Path : Var	<< ast.PathFromVar(X[0]) >>`,
+		Id:         "Path",
+		NTType:     41,
+		Index:      114,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.PathFromVar(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `PathSequence : PathElt	<< ast.NewPathSequence(X[0]) >>`,
+		Id:         "PathSequence",
+		NTType:     42,
+		Index:      115,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewPathSequence(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `PathSequence : PathSequence "/" PathElt	<< ast.AppendPathSequence(X[0], X[2]) >>`,
+		Id:         "PathSequence",
+		NTType:     42,
+		Index:      116,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendPathSequence(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `PathElt : PathPrimary PathMod	<< ast.AddPathMod(X[0], X[1]) >>`,
+		Id:         "PathElt",
+		NTType:     43,
+		Index:      117,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AddPathMod(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `PathElt : PathPrimary	<< X[0], nil >>`,
+		Id:         "PathElt",
+		NTType:     43,
+		Index:      118,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `PathPrimary : uri	<< ast.NewPathPattern(X[0]) >>`,
+		Id:         "PathPrimary",
+		NTType:     44,
+		Index:      119,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewPathPattern(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `PathPrimary : "a"	<< ast.NewPathPattern(X[0]) >>`,
+		Id:         "PathPrimary",
+		NTType:     44,
+		Index:      120,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewPathPattern(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `PathPrimary : url	<< ast.NewPathPattern(X[0]) >>`,
+		Id:         "PathPrimary",
+		NTType:     44,
+		Index:      121,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewPathPattern(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `PathPrimary : "(" Path ")"	<< X[1], nil >>`,
+		Id:         "PathPrimary",
+		NTType:     44,
+		Index:      122,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `PathMod : "?"	<< ast.Pattern(ast.PATTERN_ZERO_ONE), nil >>`,
+		Id:         "PathMod",
+		NTType:     45,
+		Index:      123,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.Pattern(ast.PATTERN_ZERO_ONE), nil
+		},
+	},
+	ProdTabEntry{
+		String: `PathMod : "*"	<< ast.Pattern(ast.PATTERN_ZERO_PLUS), nil >>`,
+		Id:         "PathMod",
+		NTType:     45,
+		Index:      124,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.Pattern(ast.PATTERN_ZERO_PLUS), nil
+		},
+	},
+	ProdTabEntry{
+		String: `PathMod : "+"	<< ast.Pattern(ast.PATTERN_ONE_PLUS), nil >>`,
+		Id:         "PathMod",
+		NTType:     45,
+		Index:      125,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.Pattern(ast.PATTERN_ONE_PLUS), nil
+		},
+	},
+	ProdTabEntry{
+		String: `RestOfWhereList : RestOfWhere	<< X[0], nil >>`,
+		Id:         "RestOfWhereList",
+		NTType:     46,
+		Index:      126,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `RestOfWhereList : RestOfWhereList RestOfWhere	<< ast.MergeGraphGroups(X[0], X[1]) >>`,
+		Id:         "RestOfWhereList",
+		NTType:     46,
+		Index:      127,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.MergeGraphGroups(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `RestOfWhere : GraphPatternNotTriples Joiner TriplesBlock Joiner	<< ast.AddTriplesToGraphGroup(X[0], X[2]) >>`,
+		Id:         "RestOfWhere",
+		NTType:     47,
+		Index:      128,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AddTriplesToGraphGroup(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `RestOfWhere : GraphPatternNotTriples Joiner	<< X[0], nil >>`,
+		Id:         "RestOfWhere",
+		NTType:     47,
+		Index:      129,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Joiner : "."	<<  >>`,
+		Id:         "Joiner",
+		NTType:     48,
+		Index:      130,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Joiner : empty	<<  >>`,
+		Id:         "Joiner",
+		NTType:     48,
+		Index:      131,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `GraphPatternNotTriples : GroupGraphPattern	<< X[0], nil >>`,
+		Id:         "GraphPatternNotTriples",
+		NTType:     49,
+		Index:      132,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `WhereClause : "WHERE" "{" TriplesBlock RestOfWhereList "}"	<< ast.NewWhereClauseWithGraphGroup(X[2], X[3]) >>`,
-		Id:         "WhereClause",
-		NTType:     19,
-		Index:      43,
-		NumSymbols: 5,
+		String: `GraphPatternNotTriples : GraphPatternNotTriples "UNION" GroupGraphPattern	<< ast.GraphGroupUnion(X[0], X[2]) >>`,
+		Id:         "GraphPatternNotTriples",
+		NTType:     49,
+		Index:      133,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewWhereClauseWithGraphGroup(X[2], X[3])
+			return ast.GraphGroupUnion(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `WhereClause : "WHERE" "{" TriplesBlock "." RestOfWhereList "}"	<< ast.NewWhereClauseWithGraphGroup(X[2], X[4]) >>`,
-		Id:         "WhereClause",
-		NTType:     19,
-		Index:      44,
-		NumSymbols: 6,
+		String: `GraphPatternNotTriples : "OPTIONAL" GroupGraphPattern	<< ast.NewOptionalGraphGroup(X[1]) >>`,
+		Id:         "GraphPatternNotTriples",
+		NTType:     49,
+		Index:      134,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewWhereClauseWithGraphGroup(X[2], X[4])
+			return ast.NewOptionalGraphGroup(X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `WhereClause : "WHERE" "{" RestOfWhereList "}"	<< ast.NewWhereClauseGraphGroup(X[2]) >>`,
-		Id:         "WhereClause",
-		NTType:     19,
-		Index:      45,
-		NumSymbols: 4,
+		String: `GraphPatternNotTriples : "FILTER" Constraint	<< ast.NewFilterGraphGroup(X[1]) >>`,
+		Id:         "GraphPatternNotTriples",
+		NTType:     49,
+		Index:      135,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewWhereClauseGraphGroup(X[2])
+			return ast.NewFilterGraphGroup(X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `WhereClause : empty	<< ast.NewWhereClause([]ast.Triple{}) >>`,
-		Id:         "WhereClause",
-		NTType:     19,
-		Index:      46,
-		NumSymbols: 0,
+		String: `GraphPatternNotTriples : "GRAPH" Var GroupGraphPattern	<< ast.NewNamedGraphGroup(X[1], X[2]) >>`,
+		Id:         "GraphPatternNotTriples",
+		NTType:     49,
+		Index:      136,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewWhereClause([]ast.Triple{})
+			return ast.NewNamedGraphGroup(X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `TriplesBlock : Triple	<< ast.NewTripleBlock(X[0]) >>`,
-		Id:         "TriplesBlock",
-		NTType:     20,
-		Index:      47,
-		NumSymbols: 1,
+		String: `GraphPatternNotTriples : "GRAPH" String GroupGraphPattern	<< ast.NewNamedGraphGroup(X[1], X[2]) >>`,
+		Id:         "GraphPatternNotTriples",
+		NTType:     49,
+		Index:      137,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewTripleBlock(X[0])
+			return ast.NewNamedGraphGroup(X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `TriplesBlock : TriplesBlock "." Triple	<< ast.AppendTripleBlock(X[0], X[2]) >>`,
-		Id:         "TriplesBlock",
-		NTType:     20,
-		Index:      48,
+		String: `GraphPatternNotTriples : "GRAPH" url GroupGraphPattern	<< ast.NewNamedGraphGroup(X[1], X[2]) >>`,
+		Id:         "GraphPatternNotTriples",
+		NTType:     49,
+		Index:      138,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AppendTripleBlock(X[0], X[2])
+			return ast.NewNamedGraphGroup(X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Triple : VarOrTerm Path VarOrTerm	<< ast.NewTriple(X[0], X[1], X[2]) >>`,
-		Id:         "Triple",
-		NTType:     21,
-		Index:      49,
+		String: `Constraint : "(" Expression ")"	<< X[1], nil >>`,
+		Id:         "Constraint",
+		NTType:     50,
+		Index:      139,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewTriple(X[0], X[1], X[2])
+			return X[1], nil
 		},
 	},
 	ProdTabEntry{
-		String: `VarOrTerm : Var	<< ast.NewURI(X[0]) >>`,
-		Id:         "VarOrTerm",
-		NTType:     22,
-		Index:      50,
+		String: `Constraint : BuiltInCall	<< X[0], nil >>`,
+		Id:         "Constraint",
+		NTType:     50,
+		Index:      140,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewURI(X[0])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `VarOrTerm : GraphTerm	<< ast.NewURI(X[0]) >>`,
-		Id:         "VarOrTerm",
-		NTType:     22,
-		Index:      51,
+		String: `Expression : ConditionalAndExpression	<< X[0], nil >>`,
+		Id:         "Expression",
+		NTType:     51,
+		Index:      141,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewURI(X[0])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `GraphTerm : uri	<< ast.ParseString(X[0]) >>`,
-		Id:         "GraphTerm",
-		NTType:     23,
-		Index:      52,
-		NumSymbols: 1,
+		String: `Expression : Expression "||" ConditionalAndExpression	<< ast.NewFilterExpr("||", X[0], X[2]) >>`,
+		Id:         "Expression",
+		NTType:     51,
+		Index:      142,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.ParseString(X[0])
+			return ast.NewFilterExpr("||", X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `GraphTerm : quotedstring	<< ast.ParseQuotedString(X[0]) >>`,
-		Id:         "GraphTerm",
-		NTType:     23,
-		Index:      53,
+		String: `ConditionalAndExpression : RelationalExpression	<< X[0], nil >>`,
+		Id:         "ConditionalAndExpression",
+		NTType:     52,
+		Index:      143,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.ParseQuotedString(X[0])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `GraphTerm : url	<< ast.ParseString(X[0]) >>`,
-		Id:         "GraphTerm",
-		NTType:     23,
-		Index:      54,
-		NumSymbols: 1,
+		String: `ConditionalAndExpression : ConditionalAndExpression "&&" RelationalExpression	<< ast.NewFilterExpr("&&", X[0], X[2]) >>`,
+		Id:         "ConditionalAndExpression",
+		NTType:     52,
+		Index:      144,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.ParseString(X[0])
+			return ast.NewFilterExpr("&&", X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Path : PathSequence	<< X[0], nil >>`,
-		Id:         "Path",
-		NTType:     24,
-		Index:      55,
+		String: `RelationalExpression : UnaryExpression	<< X[0], nil >>`,
+		Id:         "RelationalExpression",
+		NTType:     53,
+		Index:      145,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Path : Path "|" PathSequence	<<  >>`,
-		Id:         "Path",
-		NTType:     24,
-		Index:      56,
+		String: `RelationalExpression : UnaryExpression "=" UnaryExpression	<< ast.NewFilterExpr("=", X[0], X[2]) >>`,
+		Id:         "RelationalExpression",
+		NTType:     53,
+		Index:      146,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return ast.NewFilterExpr("=", X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Path : Var	<< ast.PathFromVar(X[0]) >>`,
-		Id:         "Path",
-		NTType:     24,
-		Index:      57,
-		NumSymbols: 1,
+		String: `RelationalExpression : UnaryExpression "!=" UnaryExpression	<< ast.NewFilterExpr("!=", X[0], X[2]) >>`,
+		Id:         "RelationalExpression",
+		NTType:     53,
+		Index:      147,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.PathFromVar(X[0])
+			return ast.NewFilterExpr("!=", X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `PathSequence : PathElt	<< ast.NewPathSequence(X[0]) >>`,
-		Id:         "PathSequence",
-		NTType:     25,
-		Index:      58,
-		NumSymbols: 1,
+		String: `RelationalExpression : UnaryExpression "<" UnaryExpression	<< ast.NewFilterExpr("<", X[0], X[2]) >>`,
+		Id:         "RelationalExpression",
+		NTType:     53,
+		Index:      148,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewPathSequence(X[0])
+			return ast.NewFilterExpr("<", X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `PathSequence : PathSequence "/" PathElt	<< ast.AppendPathSequence(X[0], X[2]) >>`,
-		Id:         "PathSequence",
-		NTType:     25,
-		Index:      59,
+		String: `RelationalExpression : UnaryExpression ">" UnaryExpression	<< ast.NewFilterExpr(">", X[0], X[2]) >>`,
+		Id:         "RelationalExpression",
+		NTType:     53,
+		Index:      149,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AppendPathSequence(X[0], X[2])
+			return ast.NewFilterExpr(">", X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `PathElt : PathPrimary PathMod	<< ast.AddPathMod(X[0], X[1]) >>`,
-		Id:         "PathElt",
-		NTType:     26,
-		Index:      60,
+		String: `RelationalExpression : UnaryExpression "<=" UnaryExpression	<< ast.NewFilterExpr("<=", X[0], X[2]) >>`,
+		Id:         "RelationalExpression",
+		NTType:     53,
+		Index:      150,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewFilterExpr("<=", X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `RelationalExpression : UnaryExpression ">=" UnaryExpression	<< ast.NewFilterExpr(">=", X[0], X[2]) >>`,
+		Id:         "RelationalExpression",
+		NTType:     53,
+		Index:      151,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewFilterExpr(">=", X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpression : PrimaryExpression	<< X[0], nil >>`,
+		Id:         "UnaryExpression",
+		NTType:     54,
+		Index:      152,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpression : "!" PrimaryExpression	<< ast.NewFilterExpr("!", X[1]) >>`,
+		Id:         "UnaryExpression",
+		NTType:     54,
+		Index:      153,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AddPathMod(X[0], X[1])
+			return ast.NewFilterExpr("!", X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `PathElt : PathPrimary	<< X[0], nil >>`,
-		Id:         "PathElt",
-		NTType:     26,
-		Index:      61,
+		String: `PrimaryExpression : "(" Expression ")"	<< X[1], nil >>`,
+		Id:         "PrimaryExpression",
+		NTType:     55,
+		Index:      154,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `PrimaryExpression : BuiltInCall	<< X[0], nil >>`,
+		Id:         "PrimaryExpression",
+		NTType:     55,
+		Index:      155,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `PathPrimary : uri	<< ast.NewPathPattern(X[0]) >>`,
-		Id:         "PathPrimary",
-		NTType:     27,
-		Index:      62,
+		String: `PrimaryExpression : Var	<< ast.NewFilterTerm(X[0]) >>`,
+		Id:         "PrimaryExpression",
+		NTType:     55,
+		Index:      156,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewPathPattern(X[0])
+			return ast.NewFilterTerm(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `PathPrimary : "a"	<< ast.NewPathPattern(X[0]) >>`,
-		Id:         "PathPrimary",
-		NTType:     27,
-		Index:      63,
+		String: `PrimaryExpression : uri	<< ast.NewFilterTerm(X[0]) >>`,
+		Id:         "PrimaryExpression",
+		NTType:     55,
+		Index:      157,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewPathPattern(X[0])
+			return ast.NewFilterTerm(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `PathPrimary : url	<< ast.NewPathPattern(X[0]) >>`,
-		Id:         "PathPrimary",
-		NTType:     27,
-		Index:      64,
+		String: `PrimaryExpression : url	<< ast.NewFilterTerm(X[0]) >>`,
+		Id:         "PrimaryExpression",
+		NTType:     55,
+		Index:      158,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewPathPattern(X[0])
+			return ast.NewFilterTerm(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `PathPrimary : "(" Path ")"	<< X[1], nil >>`,
-		Id:         "PathPrimary",
-		NTType:     27,
-		Index:      65,
-		NumSymbols: 3,
+		String: `PrimaryExpression : Literal	<< ast.NewFilterValue(X[0]) >>`,
+		Id:         "PrimaryExpression",
+		NTType:     55,
+		Index:      159,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[1], nil
+			return ast.NewFilterValue(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `PathMod : "?"	<< ast.Pattern(ast.PATTERN_ZERO_ONE), nil >>`,
-		Id:         "PathMod",
-		NTType:     28,
-		Index:      66,
+		String: `PrimaryExpression : string	<< ast.NewFilterLiteral(X[0]) >>`,
+		Id:         "PrimaryExpression",
+		NTType:     55,
+		Index:      160,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.Pattern(ast.PATTERN_ZERO_ONE), nil
+			return ast.NewFilterLiteral(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `PathMod : "*"	<< ast.Pattern(ast.PATTERN_ZERO_PLUS), nil >>`,
-		Id:         "PathMod",
-		NTType:     28,
-		Index:      67,
+		String: `BuiltInCall : FunctionName "(" ArgList ")"	<< ast.NewFilterCall(X[0], X[2]) >>`,
+		Id:         "BuiltInCall",
+		NTType:     56,
+		Index:      161,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewFilterCall(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `FunctionName : "regex"	<< "regex", nil >>`,
+		Id:         "FunctionName",
+		NTType:     57,
+		Index:      162,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.Pattern(ast.PATTERN_ZERO_PLUS), nil
+			return "regex", nil
 		},
 	},
 	ProdTabEntry{
-		String: `PathMod : "+"	<< ast.Pattern(ast.PATTERN_ONE_PLUS), nil >>`,
-		Id:         "PathMod",
-		NTType:     28,
-		Index:      68,
+		String: `FunctionName : "REGEX"	<< "regex", nil >>`,
+		Id:         "FunctionName",
+		NTType:     57,
+		Index:      163,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.Pattern(ast.PATTERN_ONE_PLUS), nil
+			return "regex", nil
 		},
 	},
 	ProdTabEntry{
-		String: `RestOfWhereList : RestOfWhere	<< X[0], nil >>`,
-		Id:         "RestOfWhereList",
-		NTType:     29,
-		Index:      69,
+		String: `FunctionName : "STRSTARTS"	<< "strstarts", nil >>`,
+		Id:         "FunctionName",
+		NTType:     57,
+		Index:      164,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return "strstarts", nil
 		},
 	},
 	ProdTabEntry{
-		String: `RestOfWhereList : RestOfWhereList RestOfWhere	<< ast.MergeGraphGroups(X[0], X[1]) >>`,
-		Id:         "RestOfWhereList",
-		NTType:     29,
-		Index:      70,
-		NumSymbols: 2,
+		String: `FunctionName : "CONTAINS"	<< "contains", nil >>`,
+		Id:         "FunctionName",
+		NTType:     57,
+		Index:      165,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.MergeGraphGroups(X[0], X[1])
+			return "contains", nil
 		},
 	},
 	ProdTabEntry{
-		String: `RestOfWhere : GraphPatternNotTriples Joiner TriplesBlock Joiner	<< ast.AddTriplesToGraphGroup(X[0], X[2]) >>`,
-		Id:         "RestOfWhere",
-		NTType:     30,
-		Index:      71,
-		NumSymbols: 4,
+		String: `FunctionName : "isURI"	<< "isURI", nil >>`,
+		Id:         "FunctionName",
+		NTType:     57,
+		Index:      166,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AddTriplesToGraphGroup(X[0], X[2])
+			return "isURI", nil
 		},
 	},
 	ProdTabEntry{
-		String: `RestOfWhere : GraphPatternNotTriples Joiner	<< X[0], nil >>`,
-		Id:         "RestOfWhere",
-		NTType:     30,
-		Index:      72,
-		NumSymbols: 2,
+		String: `FunctionName : "isIRI"	<< "isIRI", nil >>`,
+		Id:         "FunctionName",
+		NTType:     57,
+		Index:      167,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return "isIRI", nil
 		},
 	},
 	ProdTabEntry{
-		String: `Joiner : "."	<<  >>`,
-		Id:         "Joiner",
-		NTType:     31,
-		Index:      73,
+		String: `FunctionName : "isLiteral"	<< "isLiteral", nil >>`,
+		Id:         "FunctionName",
+		NTType:     57,
+		Index:      168,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return "isLiteral", nil
 		},
 	},
 	ProdTabEntry{
-		String: `Joiner : empty	<<  >>`,
-		Id:         "Joiner",
-		NTType:     31,
-		Index:      74,
-		NumSymbols: 0,
+		String: `FunctionName : "isBlank"	<< "isBlank", nil >>`,
+		Id:         "FunctionName",
+		NTType:     57,
+		Index:      169,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return nil, nil
+			return "isBlank", nil
 		},
 	},
 	ProdTabEntry{
-		String: `GraphPatternNotTriples : GroupGraphPattern	<< X[0], nil >>`,
-		Id:         "GraphPatternNotTriples",
-		NTType:     32,
-		Index:      75,
+		String: `FunctionName : "BOUND"	<< "BOUND", nil >>`,
+		Id:         "FunctionName",
+		NTType:     57,
+		Index:      170,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return "BOUND", nil
 		},
 	},
 	ProdTabEntry{
-		String: `GraphPatternNotTriples : GraphPatternNotTriples "UNION" GroupGraphPattern	<< ast.GraphGroupUnion(X[0], X[2]) >>`,
-		Id:         "GraphPatternNotTriples",
-		NTType:     32,
-		Index:      76,
+		String: `ArgList : Expression	<< ast.NewFilterArgs(X[0]) >>`,
+		Id:         "ArgList",
+		NTType:     58,
+		Index:      171,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewFilterArgs(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ArgList : ArgList "," Expression	<< ast.AppendFilterArg(X[0], X[2]) >>`,
+		Id:         "ArgList",
+		NTType:     58,
+		Index:      172,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.GraphGroupUnion(X[0], X[2])
+			return ast.AppendFilterArg(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `GroupGraphPattern : "{" GroupGraphPatternSub Joiner "}"	<< X[1], nil >>`,
 		Id:         "GroupGraphPattern",
-		NTType:     33,
-		Index:      77,
+		NTType:     59,
+		Index:      173,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[1], nil
@@ -803,8 +1763,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `GroupGraphPatternSub : TriplesBlock	<< ast.GraphGroupFromTriples(X[0]) >>`,
 		Id:         "GroupGraphPatternSub",
-		NTType:     34,
-		Index:      78,
+		NTType:     60,
+		Index:      174,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.GraphGroupFromTriples(X[0])
@@ -813,21 +1773,31 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `GroupGraphPatternSub : GraphPatternNotTriples "." TriplesBlock	<< ast.AddTriplesToGraphGroup(X[0], X[2]) >>`,
 		Id:         "GroupGraphPatternSub",
-		NTType:     34,
-		Index:      79,
+		NTType:     60,
+		Index:      175,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AddTriplesToGraphGroup(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `GroupGraphPatternSub : GroupGraphPatternSub GraphPatternNotTriples "." TriplesBlock	<<  >>`,
+		String: `GroupGraphPatternSub : GroupGraphPatternSub GraphPatternNotTriples "." TriplesBlock	<< ast.JoinGraphGroups(X[0], X[1], X[3]) >>`,
 		Id:         "GroupGraphPatternSub",
-		NTType:     34,
-		Index:      80,
+		NTType:     60,
+		Index:      176,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return ast.JoinGraphGroups(X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `GroupGraphPatternSub : GroupGraphPatternSub GraphPatternNotTriples	<< ast.MergeGraphGroups(X[0], X[1]) >>`,
+		Id:         "GroupGraphPatternSub",
+		NTType:     60,
+		Index:      177,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.MergeGraphGroups(X[0], X[1])
 		},
 	},
 }
@@ -3,7 +3,11 @@ package sparql
 import (
 	//"fmt"
 	//"github.com/kr/pretty"
+	"reflect"
+	"sort"
 	"testing"
+
+	"github.com/gtfierro/hoddb/turtle"
 )
 
 func TestQueryParse(t *testing.T) {
@@ -46,6 +50,99 @@ func TestQueryParse(t *testing.T) {
 		{
 			"SELECT ?x ?y ?z WHERE { ?y rdf:type brick:VAV { ?y bf:isFedBy ?x . ?y bf:hasPoint ?z } UNION { ?y bf:feeds ?x } }",
 		},
+		{
+			"SELECT ?x WHERE { { ?x rdf:type brick:AHU } UNION { ?x rdf:type brick:VAV } . { ?x bf:feeds ?y } UNION { ?y bf:isFedBy ?x } }",
+		},
+		{
+			"SELECT ?x WHERE { { { ?x bf:feeds ?y } . ?x rdf:type brick:AHU { ?y bf:feeds ?z } . ?y rdf:type brick:VAV } }",
+		},
+		{
+			"SELECT ?x ?y WHERE { ?x rdf:type brick:Room . OPTIONAL { ?x bf:feeds ?y } }",
+		},
+		{
+			"SELECT ?x ?y ?z WHERE { ?x rdf:type brick:AHU OPTIONAL { ?x bf:feeds ?y OPTIONAL { ?y bf:feeds ?z } } }",
+		},
+		{
+			"SELECT ?x ?y WHERE { { ?x rdf:type brick:AHU } UNION { ?x rdf:type brick:VAV } OPTIONAL { ?x bf:feeds ?y } }",
+		},
+		{
+			"SELECT ?x ?l WHERE { ?x rdfs:label ?l FILTER(regex(?l, \"ZNT\")) }",
+		},
+		{
+			"SELECT ?x ?l WHERE { ?x rdfs:label ?l . FILTER(REGEX(?l, \"znt\", \"i\") || STRSTARTS(?l, \"Zone\")) }",
+		},
+		{
+			"SELECT ?x ?y WHERE { ?x rdf:type brick:VAV OPTIONAL { ?x bf:feeds ?y } FILTER(!BOUND(?y) && isURI(?x)) }",
+		},
+		{
+			"SELECT ?x ?v WHERE { ?x bf:hasValue ?v FILTER((?v >= 10 && ?v <= 20) || ?v = \"0\") . ?x rdf:type brick:Point }",
+		},
+		{
+			"SELECT ?x WHERE { ?x rdf:type ?t FILTER(?t != brick:Room && ?t != <http://buildsys.org/ontologies/Brick#Floor>) }",
+		},
+		{
+			"SELECT ?x WHERE { { ?x rdf:type brick:AHU FILTER(CONTAINS(?x, \"AHU\")) } UNION { ?x rdf:type brick:VAV } }",
+		},
+		{
+			"SELECT ?x WHERE { ?x rdfs:label ?l FILTER isLiteral(?l) }",
+		},
+		{
+			"SELECT ?x WHERE { ?x rdf:type brick:Room } LIMIT 10",
+		},
+		{
+			"SELECT ?x WHERE { ?x rdf:type brick:Room } OFFSET 20 LIMIT 10",
+		},
+		{
+			"SELECT ?x ?l WHERE { ?x rdfs:label ?l } ORDER BY ?l DESC ?x LIMIT 10 OFFSET 20",
+		},
+		{
+			"SELECT ?x FROM ciee WHERE { ?x rdfs:label ?l } ORDER BY ?l ASC AT 1571234567890123456",
+		},
+		{
+			"SELECT ?t (COUNT(?x) AS ?n) WHERE { ?x rdf:type ?t } GROUP BY ?t",
+		},
+		{
+			"SELECT ?b ?t (COUNT(DISTINCT ?v) AS ?n) FROM ciee WHERE { ?v rdf:type ?t . ?v bf:isPartOf ?b } GROUP BY ?b ?t ORDER BY ?n DESC LIMIT 10",
+		},
+		{
+			"SELECT (COUNT(*) AS ?n) (MIN(?x) AS ?first) (MAX(?x) AS ?last) (SAMPLE(?x) AS ?any) WHERE { ?x rdf:type brick:Room }",
+		},
+		{
+			"SELECT ?t (GROUP_CONCAT(?x) AS ?all) (GROUP_CONCAT(DISTINCT ?x ; SEPARATOR=\", \") AS ?names) WHERE { ?x rdf:type ?t } GROUP BY ?t",
+		},
+		{
+			"SELECT ?x WHERE { ?x rdf:type brick:Room } AT 1571234567890123456",
+		},
+		{
+			"SELECT ?x FROM ciee WHERE { ?x rdf:type brick:Room } BEFORE now",
+		},
+		{
+			"SELECT ?x WHERE { ?x rdf:type brick:Room } AFTER 1571234567890123456",
+		},
+		{
+			"SELECT ?x WHERE { ?x bf:hasValue \"72.5\"^^xsd:double . ?x rdfs:label \"Room 101\"@en }",
+		},
+		{
+			"SELECT ?x WHERE { ?x bf:hasValue 72 . ?x bf:area -12.5e1 FILTER(?x > 3.5 && ?x != \"a\"^^<http://www.w3.org/2001/XMLSchema#string>) }",
+		},
+		{
+			"SELECT ?x WHERE { ?x bf:hasPoint [ rdf:type brick:Sensor ; bf:hasTag [ bf:name ?n ] ; ] . ?x bf:feeds [] . _:b bf:feeds ?x FILTER(isBlank(?x)) }",
+		},
+		{
+			"SELECT DISTINCT ?x ?y FROM ciee WHERE { ?x bf:feeds ?y } ORDER BY ?y LIMIT 5",
+		},
+		{
+			"SELECT REDUCED * WHERE { ?x bf:feeds ?y }",
+		},
+		{
+			"SELECT ?g ?x ?m FROM bldg WHERE { ?x rdf:type brick:AHU . GRAPH catalog { ?x cat:model ?m } . GRAPH ?g { ?m cat:maker ?mk } FILTER(?g != \"bldg\") }",
+		},
+		{
+			"SELECT ?x WHERE { { ?x rdf:type brick:AHU } UNION { ?x rdf:type brick:VAV GRAPH <catalog> { ?x cat:model ?m } } }",
+		},
+		{
+			"SELECT DISTINCT ?t (COUNT(?x) AS ?n) WHERE { ?x rdf:type ?t } GROUP BY ?t",
+		},
 	} {
 		q, err := Parse(test.str)
 		if err != nil {
@@ -56,6 +153,32 @@ func TestQueryParse(t *testing.T) {
 	}
 }
 
+func TestFilterParseErrors(t *testing.T) {
+	for _, test := range []struct {
+		str string
+	}{
+		{
+			"SELECT ?x WHERE { ?x rdf:type ?t FILTER(regex(?x)) }",
+		},
+		{
+			"SELECT ?x WHERE { ?x rdf:type ?t FILTER(BOUND(\"x\")) }",
+		},
+		{
+			"SELECT ?x WHERE { ?x rdf:type ?t FILTER(isURI(?x, ?t)) }",
+		},
+		{
+			"SELECT ?x (COUNT(?t) AS ?x) WHERE { ?x rdf:type ?t }",
+		},
+		{
+			"SELECT ?x WHERE { ?x bf:hasValue value }",
+		},
+	} {
+		if _, err := Parse(test.str); err == nil {
+			t.Errorf("expected error parsing %s", test.str)
+		}
+	}
+}
+
 func TestInsertQueryParse(t *testing.T) {
 	for _, test := range []struct {
 		str string
@@ -72,6 +195,12 @@ func TestInsertQueryParse(t *testing.T) {
 		{
 			"INSERT { ?x rdf:type ?y } WHERE { ?x rdf:type/rdfs:subClassOf* ?y }",
 		},
+		{
+			"DELETE { ?x rdf:type brick:Room } WHERE { ?x rdf:type brick:Room }",
+		},
+		{
+			"DELETE { ?x bf:feeds ?y . } FROM ciee WHERE { ?x bf:feeds ?y . ?y rdf:type brick:VAV }",
+		},
 	} {
 		q, err := Parse(test.str)
 		if err != nil {
@@ -81,3 +210,121 @@ func TestInsertQueryParse(t *testing.T) {
 		_ = q
 	}
 }
+
+func TestConstructQueryParse(t *testing.T) {
+	for _, test := range []struct {
+		str    string
+		terms  int
+		graphs []string
+	}{
+		{"CONSTRUCT { ?vav bf:feeds ?zone } WHERE { ?vav rdf:type brick:VAV . ?vav bf:controls ?zone }", 1, nil},
+		{"CONSTRUCT { ?x rdf:type brick:Location . ?x bf:isPartOf ?f . } FROM ciee soda WHERE { ?x bf:isPartOf+ ?f }", 2, []string{"ciee", "soda"}},
+	} {
+		q, err := Parse(test.str)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if !q.IsConstruct() || q.IsInsert() || q.IsSelect() {
+			t.Errorf("expected a CONSTRUCT query for %s, got type %v", test.str, q.Type)
+		}
+		if len(q.Construct.Terms) != test.terms {
+			t.Errorf("expected %d template triples, got %v", test.terms, q.Construct.Terms)
+		}
+		if !reflect.DeepEqual(test.graphs, q.From.Databases) {
+			t.Errorf("expected graphs %v, got %v", test.graphs, q.From.Databases)
+		}
+	}
+}
+
+func TestLiteralParse(t *testing.T) {
+	q, err := Parse("SELECT ?x WHERE { ?x bf:hasValue \"72.5\"^^xsd:double . ?x rdfs:label \"Room 101\"@en . ?x bf:area 20 . ?x bf:name \"a:b\" FILTER(?x > -1.5e3) }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	xsd := "http://www.w3.org/2001/XMLSchema#"
+	for idx, expected := range []turtle.URI{
+		{Value: "72.5", Datatype: "xsd:double"},
+		{Value: "Room 101", Lang: "en"},
+		{Value: "20", Datatype: xsd + "integer"},
+		{Value: "a:b"},
+	} {
+		if object := q.Where.Terms[idx].Object; object != expected {
+			t.Errorf("expected %#v, got %#v", expected, object)
+		}
+	}
+	expected := turtle.URI{Value: "-1.5e3", Datatype: xsd + "double"}
+	if value := q.Where.GraphGroup.Filters[0].Args[1].Value; value != expected {
+		t.Errorf("expected %#v, got %#v", expected, value)
+	}
+}
+
+func TestBlankNodeParse(t *testing.T) {
+	q, err := Parse("SELECT * WHERE { ?x bf:hasPoint [ rdf:type brick:Sensor ; bf:hasTag ?t ] . _:b bf:feeds ?x }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(q.Where.Terms) != 4 {
+		t.Fatalf("expected 4 triples, got %v", q.Where.Terms)
+	}
+	point := q.Where.Terms[0].Object
+	if !point.IsVariable() || q.Where.Terms[1].Subject != point || q.Where.Terms[2].Subject != point {
+		t.Errorf("expected the property list to be about the blank node, got %v", q.Where.Terms)
+	}
+	if subject := q.Where.Terms[3].Subject; !subject.IsVariable() || subject == point {
+		t.Errorf("expected a variable for _:b, got %v", subject)
+	}
+	if len(q.Select.Vars) != 2 {
+		t.Errorf("expected blank nodes not to be selected, got %v", q.Select.Vars)
+	}
+}
+
+func TestSelectModifierParse(t *testing.T) {
+	for _, test := range []struct {
+		str      string
+		distinct bool
+		reduced  bool
+		vars     []string
+	}{
+		{"SELECT ?x WHERE { ?x bf:feeds ?y }", false, false, []string{"?x"}},
+		{"SELECT DISTINCT ?x WHERE { ?x bf:feeds ?y }", true, false, []string{"?x"}},
+		{"SELECT REDUCED ?x ?y WHERE { ?x bf:feeds ?y }", false, true, []string{"?x", "?y"}},
+		{"SELECT DISTINCT * WHERE { ?x bf:feeds ?y }", true, false, []string{"?x", "?y"}},
+	} {
+		q, err := Parse(test.str)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Select.Distinct != test.distinct || q.Select.Reduced != test.reduced {
+			t.Errorf("%s: expected distinct %v and reduced %v, got %v and %v", test.str, test.distinct, test.reduced, q.Select.Distinct, q.Select.Reduced)
+		}
+		// the variables selected by * are in no particular order
+		vars := append([]string{}, q.Select.Vars...)
+		sort.Strings(vars)
+		if !reflect.DeepEqual(vars, test.vars) {
+			t.Errorf("%s: expected vars %v, got %v", test.str, test.vars, q.Select.Vars)
+		}
+	}
+	if _, err := Parse("SELECT DISTINCT WHERE { ?x bf:feeds ?y }"); err == nil {
+		t.Error("expected an error for DISTINCT without variables")
+	}
+}
+
+func TestGraphPatternParse(t *testing.T) {
+	q, err := Parse("SELECT * FROM bldg WHERE { ?x rdf:type brick:AHU . GRAPH catalog { ?x cat:model ?m } GRAPH <other-graph> { ?m cat:maker ?mk } GRAPH ?g { ?x rdfs:label ?l } }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	graphs := q.Where.GraphGroup.Graphs
+	if len(graphs) != 3 {
+		t.Fatalf("expected 3 GRAPH patterns, got %v", graphs)
+	}
+	for idx, expected := range []string{"catalog", "other-graph", "?g"} {
+		if graphs[idx].Graph != expected || len(graphs[idx].Group.Terms) != 1 {
+			t.Errorf("expected GRAPH %s with one term, got %v", expected, graphs[idx])
+		}
+	}
+	if len(q.Select.Vars) != 5 {
+		t.Errorf("expected the variables of the GRAPH patterns to be selected, got %v", q.Select.Vars)
+	}
+}
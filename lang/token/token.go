@@ -128,12 +128,33 @@ var TokMap = TokenMap{
 		"*",
 		"empty",
 		"LIMIT",
+		"OFFSET",
+		"GROUP",
+		"BY",
+		"ORDER",
+		"ASC",
+		"DESC",
 		"SELECT",
+		"DISTINCT",
+		"REDUCED",
+		"(",
+		"AS",
+		")",
+		"COUNT",
+		"MIN",
+		"MAX",
+		"SAMPLE",
+		"GROUP_CONCAT",
+		";",
+		"SEPARATOR",
+		"=",
+		"quotedstring",
 		"INSERT",
 		"{",
 		"}",
 		".",
-		"COUNT",
+		"DELETE",
+		"CONSTRUCT",
 		"string",
 		"var",
 		"FROM",
@@ -142,17 +163,40 @@ var TokMap = TokenMap{
 		"BEFORE",
 		"AFTER",
 		"WHERE",
+		"[",
+		"]",
 		"uri",
-		"quotedstring",
 		"url",
+		"langtag",
+		"^^",
+		"decimal",
 		"|",
 		"/",
 		"a",
-		"(",
-		")",
 		"?",
 		"+",
 		"UNION",
+		"OPTIONAL",
+		"FILTER",
+		"GRAPH",
+		"||",
+		"&&",
+		"!=",
+		"<",
+		">",
+		"<=",
+		">=",
+		"!",
+		"regex",
+		"REGEX",
+		"STRSTARTS",
+		"CONTAINS",
+		"isURI",
+		"isIRI",
+		"isLiteral",
+		"isBlank",
+		"BOUND",
+		",",
 	},
 
 	idMap: map[string]Type{
@@ -165,30 +209,74 @@ var TokMap = TokenMap{
 		"*":            6,
 		"empty":        7,
 		"LIMIT":        8,
-		"SELECT":       9,
-		"INSERT":       10,
-		"{":            11,
-		"}":            12,
-		".":            13,
-		"COUNT":        14,
-		"string":       15,
-		"var":          16,
-		"FROM":         17,
-		"TO":           18,
-		"AT":           19,
-		"BEFORE":       20,
-		"AFTER":        21,
-		"WHERE":        22,
-		"uri":          23,
-		"quotedstring": 24,
-		"url":          25,
-		"|":            26,
-		"/":            27,
-		"a":            28,
-		"(":            29,
-		")":            30,
-		"?":            31,
-		"+":            32,
-		"UNION":        33,
+		"OFFSET":       9,
+		"GROUP":        10,
+		"BY":           11,
+		"ORDER":        12,
+		"ASC":          13,
+		"DESC":         14,
+		"SELECT":       15,
+		"DISTINCT":     16,
+		"REDUCED":      17,
+		"(":            18,
+		"AS":           19,
+		")":            20,
+		"COUNT":        21,
+		"MIN":          22,
+		"MAX":          23,
+		"SAMPLE":       24,
+		"GROUP_CONCAT": 25,
+		";":            26,
+		"SEPARATOR":    27,
+		"=":            28,
+		"quotedstring": 29,
+		"INSERT":       30,
+		"{":            31,
+		"}":            32,
+		".":            33,
+		"DELETE":       34,
+		"CONSTRUCT":    35,
+		"string":       36,
+		"var":          37,
+		"FROM":         38,
+		"TO":           39,
+		"AT":           40,
+		"BEFORE":       41,
+		"AFTER":        42,
+		"WHERE":        43,
+		"[":            44,
+		"]":            45,
+		"uri":          46,
+		"url":          47,
+		"langtag":      48,
+		"^^":           49,
+		"decimal":      50,
+		"|":            51,
+		"/":            52,
+		"a":            53,
+		"?":            54,
+		"+":            55,
+		"UNION":        56,
+		"OPTIONAL":     57,
+		"FILTER":       58,
+		"GRAPH":        59,
+		"||":           60,
+		"&&":           61,
+		"!=":           62,
+		"<":            63,
+		">":            64,
+		"<=":           65,
+		">=":           66,
+		"!":            67,
+		"regex":        68,
+		"REGEX":        69,
+		"STRSTARTS":    70,
+		"CONTAINS":     71,
+		"isURI":        72,
+		"isIRI":        73,
+		"isLiteral":    74,
+		"isBlank":      75,
+		"BOUND":        76,
+		",":            77,
 	},
 }
@@ -55,22 +55,21 @@ func NewNode(cfg *Config) (*Node, error) {
 	//}
 	n.db, err = hod.MakeHodDB(cfg.HodConfig)
 	if err != nil {
-		log.Fatal(errors.Wrap(err, "open log"))
+		return nil, errors.Wrap(err, "open log")
 	}
-	if n.db == nil {
-		panic("null db")
-	}
-	if cfg.HodConfig.Grpc.Enable {
-		go log.Fatal(n.db.ServeGRPC())
+	if cfg.HodConfig.Grpc.Enable || cfg.HodConfig.Http.Enable {
+		go func() {
+			log.Error(errors.Wrap(n.db.ServeGRPC(), "API server stopped"))
+		}()
 	}
 
 	// set up views
-	if err := n.db.NewGraph("public"); err != nil {
-		panic(err)
+	if err := n.db.CreateGraph("public"); err != nil {
+		return nil, err
 	}
 	for _, policy := range cfg.PublicPolicy {
 		if _, err := n.updateView("public", policy); err != nil {
-			panic(err)
+			return nil, errors.Wrap(err, "Could not create public view")
 		}
 	}
 
@@ -85,7 +84,7 @@ func NewNode(cfg *Config) (*Node, error) {
 	params.Port = uint16(n.listenPort)
 	n.node, err = noise.NewNode(params)
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrap(err, "Could not create p2p node")
 	}
 	protocol.New().
 		Register(ecdh.New()).
@@ -149,40 +148,19 @@ func (n *Node) updateView(name string, v View) (bool, error) {
 }
 
 func (n *Node) Request(req *pb.TupleRequest, srv pb.P2P_RequestServer) error {
-
-	res, err := n.db.Select(context.Background(), req.Definition)
-	if err != nil {
-		return err
-	}
-	count := len(res.Rows)
-	chunksize := 400
-	lower := 0
-	upper := chunksize
-	if upper > count {
-		upper = count
-	}
-	for upper <= count {
-		if upper > count {
-			upper = count
-		}
+	// rows are sent in batches as the query produces them
+	return n.db.SelectBatches(srv.Context(), req.Definition, func(res *pb.Response) error {
 		response := tupleUpdate{
 			Header: header{
 				Timestamp: time.Now(),
 				From:      []byte("put something better here"),
 			},
-			Rows:       res.Rows[lower:upper],
+			Rows:       res.Rows,
 			Vars:       res.Variables,
 			Definition: *req.Definition,
 		}
-
-		if err = srv.Send(response.ToProto()); err != nil {
-			return errors.Wrap(err, "Could not send response")
-		}
-
-		lower += chunksize
-		upper += chunksize
-	}
-	return nil
+		return errors.Wrap(srv.Send(response.ToProto()), "Could not send response")
+	})
 }
 
 func (n *Node) dialPeers() {
@@ -220,41 +198,25 @@ func (n *Node) handleRequests(peer *noise.Peer) {
 		msg := _msg.(tupleRequest)
 
 		log.Infof("Got request %v from %s:%d", msg, peer.RemoteIP(), peer.RemotePort())
-		// evaluate a request for tuples
-		res, err := n.db.Select(context.Background(), &msg.Definition)
-		if err != nil {
-			log.Error(err)
-			continue
-		}
-
-		// loop through results and send to the peer
-		count := len(res.Rows)
-		chunksize := 400
-		lower := 0
-		upper := chunksize
-		if upper > count {
-			upper = count
-		}
-		for upper <= count {
-			if upper > count {
-				upper = count
-			}
+		// evaluate a request for tuples, sending the results to the peer in batches
+		err := n.db.SelectBatches(context.Background(), &msg.Definition, func(res *pb.Response) error {
 			response := tupleUpdate{
 				Header: header{
 					Timestamp: time.Now(),
 					From:      []byte("put something better here"),
 				},
-				Rows:       res.Rows[lower:upper],
+				Rows:       res.Rows,
 				Vars:       res.Variables,
 				Definition: msg.Definition,
 			}
-			if err = peer.SendMessage(response); err != nil {
+			if err := peer.SendMessage(response); err != nil {
 				log.Error(errors.Wrap(err, "Could not send response"))
-				continue
 			}
-
-			lower += chunksize
-			upper += chunksize
+			return nil
+		})
+		if err != nil {
+			log.Error(err)
+			continue
 		}
 		n.updatePeerState(peeraddr, SYNCED)
 	}
@@ -281,21 +243,21 @@ func expandTriples(where []*pb.Triple, rows []*pb.Row, vars []string) turtle.Dat
 		for _, row := range rows {
 			triple := turtle.Triple{}
 			if subIdx >= 0 {
-				triple.Subject = turtle.URI{Namespace: row.Values[subIdx].Namespace, Value: row.Values[subIdx].Value}
+				triple.Subject = turtle.URIFromProto(row.Values[subIdx])
 			} else {
-				triple.Subject = turtle.URI{Namespace: term.Subject.Namespace, Value: term.Subject.Value}
+				triple.Subject = turtle.URIFromProto(term.Subject)
 			}
 
 			if predIdx >= 0 {
-				triple.Predicate = turtle.URI{Namespace: row.Values[predIdx].Namespace, Value: row.Values[predIdx].Value}
+				triple.Predicate = turtle.URIFromProto(row.Values[predIdx])
 			} else {
-				triple.Predicate = turtle.URI{Namespace: term.Predicate[0].Namespace, Value: term.Predicate[0].Value}
+				triple.Predicate = turtle.URIFromProto(term.Predicate[0])
 			}
 
 			if objIdx >= 0 {
-				triple.Object = turtle.URI{Namespace: row.Values[objIdx].Namespace, Value: row.Values[objIdx].Value}
+				triple.Object = turtle.URIFromProto(row.Values[objIdx])
 			} else {
-				triple.Object = turtle.URI{Namespace: term.Object.Namespace, Value: term.Object.Value}
+				triple.Object = turtle.URIFromProto(term.Object)
 			}
 
 			//log.Debug("generated> ", triple)
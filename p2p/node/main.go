@@ -22,7 +22,9 @@ func main() {
 		log.Fatal(err)
 	}
 
-	p2p.NewNode(&cfg)
+	if _, err := p2p.NewNode(&cfg); err != nil {
+		log.Fatal(err)
+	}
 	// Register message type to Noise.
 
 	select {}
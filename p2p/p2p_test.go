@@ -69,6 +69,18 @@ func run_query(node *Node, graph, qstr string) ([]*pb.Row, error) {
 	return resp.Rows, nil
 }
 
+// runs the query until it returns the number of rows or the timeout passes
+func wait_for_rows(node *Node, graph, qstr string, rows int, timeout time.Duration) ([]*pb.Row, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		res, err := run_query(node, graph, qstr)
+		if err != nil || len(res) == rows || time.Now().After(deadline) {
+			return res, err
+		}
+		time.Sleep(1 * time.Second)
+	}
+}
+
 func TestChangesPropagate(t *testing.T) {
 	require := require.New(t)
 	node, err := setup_node(3000, "../example.ttl", []View{public_policy_all}, nil)
@@ -89,12 +101,12 @@ func TestChangesPropagate(t *testing.T) {
 
 	res, err := run_query(node2, "test", "SELECT ?s ?p ?o WHERE { ?s ?p ?o }")
 	require.NoError(err, "query node2")
-	require.Equal(8289, len(res), "results node2")
+	require.Equal(17961, len(res), "results node2")
 
 	time.Sleep(30 * time.Second)
 	res2, err := run_query(node2, "test", "SELECT ?s ?p ?o WHERE { ?s ?p ?o }")
 	require.NoError(err, "query node2")
-	require.Equal(8310, len(res2), "results node2")
+	require.Equal(18010, len(res2), "results node2")
 }
 
 func TestChangesPropagate2(t *testing.T) {
@@ -117,12 +129,13 @@ func TestChangesPropagate2(t *testing.T) {
 
 	res, err := run_query(node2, "test", "SELECT ?s ?p ?o WHERE { ?s ?p ?o }")
 	require.NoError(err, "query node2")
-	require.Equal(8289, len(res), "results node2")
+	require.Equal(17961, len(res), "results node2")
 
 	time.Sleep(30 * time.Second)
 	res2, err := run_query(node2, "test", "SELECT ?s ?p ?o WHERE { ?s ?p ?o }")
 	require.NoError(err, "query node2")
-	require.Equal(8304, len(res2), "results node2")
+	// ztemp_1 is a brick:Point, and so a bf:TagSet
+	require.Equal(17963, len(res2), "results node2")
 }
 
 // root node gets populated with data from the leaf nodes
@@ -161,9 +174,10 @@ func TestStarTopo(t *testing.T) {
 	defer root.Shutdown()
 
 	time.Sleep(30 * time.Second)
+	// ts1 and ts2 from leaf2, and the root's own zone temperature sensor
 	res, err := run_query(root, "test", "SELECT ?s WHERE { ?s rdf:type brick:Temperature_Sensor }")
 	require.NoError(err, "query root")
-	require.Equal(2, len(res), "results root")
+	require.Equal(3, len(res), "results root")
 }
 
 func TestTransitive(t *testing.T) {
@@ -193,8 +207,9 @@ func TestTransitive(t *testing.T) {
 	require.NoError(err, "setup node1")
 	defer node3.Shutdown()
 
-	time.Sleep(40 * time.Second)
-	res, err := run_query(node3, "test", "SELECT ?s WHERE { ?s rdf:type brick:Temperature_Sensor }")
+	// the sensors are sent after the ontology, so how long they take depends
+	// on the order of the peers' entity keys
+	res, err := wait_for_rows(node3, "test", "SELECT ?s WHERE { ?s rdf:type brick:Temperature_Sensor }", 2, 120*time.Second)
 	require.NoError(err, "query")
 	require.Equal(2, len(res), "results")
 }
@@ -25,6 +25,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type GraphFormat int32
+
+const (
+	GraphFormat_Turtle   GraphFormat = 0
+	GraphFormat_NTriples GraphFormat = 1
+	GraphFormat_RDFXML   GraphFormat = 2
+)
+
+var GraphFormat_name = map[int32]string{
+	0: "Turtle",
+	1: "NTriples",
+	2: "RDFXML",
+}
+
+var GraphFormat_value = map[string]int32{
+	"Turtle":   0,
+	"NTriples": 1,
+	"RDFXML":   2,
+}
+
+func (x GraphFormat) String() string {
+	return proto.EnumName(GraphFormat_name, int32(x))
+}
+
+func (GraphFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{0}
+}
+
 type TimeFilter int32
 
 const (
@@ -50,7 +78,7 @@ func (x TimeFilter) String() string {
 }
 
 func (TimeFilter) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{0}
+	return fileDescriptor_a153da538f858886, []int{1}
 }
 
 type Pattern int32
@@ -81,9 +109,321 @@ func (x Pattern) String() string {
 }
 
 func (Pattern) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{2}
+}
+
+type AggregateFunction int32
+
+const (
+	AggregateFunction_Count       AggregateFunction = 0
+	AggregateFunction_Min         AggregateFunction = 1
+	AggregateFunction_Max         AggregateFunction = 2
+	AggregateFunction_Sample      AggregateFunction = 3
+	AggregateFunction_GroupConcat AggregateFunction = 4
+)
+
+var AggregateFunction_name = map[int32]string{
+	0: "Count",
+	1: "Min",
+	2: "Max",
+	3: "Sample",
+	4: "GroupConcat",
+}
+
+var AggregateFunction_value = map[string]int32{
+	"Count":       0,
+	"Min":         1,
+	"Max":         2,
+	"Sample":      3,
+	"GroupConcat": 4,
+}
+
+func (x AggregateFunction) String() string {
+	return proto.EnumName(AggregateFunction_name, int32(x))
+}
+
+func (AggregateFunction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{3}
+}
+
+type NewGraphRequest struct {
+	Graph string `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	// ontology files to load into the graph; these must be among the ontologies
+	// in the server's config. If empty, all of the configured ontologies are loaded
+	OntologyFiles        []string `protobuf:"bytes,2,rep,name=ontology_files,json=ontologyFiles,proto3" json:"ontology_files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NewGraphRequest) Reset()         { *m = NewGraphRequest{} }
+func (m *NewGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*NewGraphRequest) ProtoMessage()    {}
+func (*NewGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{0}
+}
+
+func (m *NewGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NewGraphRequest.Unmarshal(m, b)
+}
+func (m *NewGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NewGraphRequest.Marshal(b, m, deterministic)
+}
+func (m *NewGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewGraphRequest.Merge(m, src)
+}
+func (m *NewGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_NewGraphRequest.Size(m)
+}
+func (m *NewGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewGraphRequest proto.InternalMessageInfo
+
+func (m *NewGraphRequest) GetGraph() string {
+	if m != nil {
+		return m.Graph
+	}
+	return ""
+}
+
+func (m *NewGraphRequest) GetOntologyFiles() []string {
+	if m != nil {
+		return m.OntologyFiles
+	}
+	return nil
+}
+
+type LoadGraphRequest struct {
+	// the graph to load the document into; it is created if it does not exist
+	Graph string `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	// the RDF document
+	Document []byte      `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	Format   GraphFormat `protobuf:"varint,3,opt,name=format,proto3,enum=proto.GraphFormat" json:"format,omitempty"`
+	// ontology files to load with the document if the graph is created, as in NewGraphRequest
+	OntologyFiles        []string `protobuf:"bytes,4,rep,name=ontology_files,json=ontologyFiles,proto3" json:"ontology_files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadGraphRequest) Reset()         { *m = LoadGraphRequest{} }
+func (m *LoadGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadGraphRequest) ProtoMessage()    {}
+func (*LoadGraphRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a153da538f858886, []int{1}
 }
 
+func (m *LoadGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadGraphRequest.Unmarshal(m, b)
+}
+func (m *LoadGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadGraphRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadGraphRequest.Merge(m, src)
+}
+func (m *LoadGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadGraphRequest.Size(m)
+}
+func (m *LoadGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadGraphRequest proto.InternalMessageInfo
+
+func (m *LoadGraphRequest) GetGraph() string {
+	if m != nil {
+		return m.Graph
+	}
+	return ""
+}
+
+func (m *LoadGraphRequest) GetDocument() []byte {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (m *LoadGraphRequest) GetFormat() GraphFormat {
+	if m != nil {
+		return m.Format
+	}
+	return GraphFormat_Turtle
+}
+
+func (m *LoadGraphRequest) GetOntologyFiles() []string {
+	if m != nil {
+		return m.OntologyFiles
+	}
+	return nil
+}
+
+type DropGraphRequest struct {
+	Graph                string   `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropGraphRequest) Reset()         { *m = DropGraphRequest{} }
+func (m *DropGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*DropGraphRequest) ProtoMessage()    {}
+func (*DropGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{2}
+}
+
+func (m *DropGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropGraphRequest.Unmarshal(m, b)
+}
+func (m *DropGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropGraphRequest.Marshal(b, m, deterministic)
+}
+func (m *DropGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropGraphRequest.Merge(m, src)
+}
+func (m *DropGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_DropGraphRequest.Size(m)
+}
+func (m *DropGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropGraphRequest proto.InternalMessageInfo
+
+func (m *DropGraphRequest) GetGraph() string {
+	if m != nil {
+		return m.Graph
+	}
+	return ""
+}
+
+type RenameGraphRequest struct {
+	Graph string `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	// the graph's new name; no graph may have it already
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameGraphRequest) Reset()         { *m = RenameGraphRequest{} }
+func (m *RenameGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameGraphRequest) ProtoMessage()    {}
+func (*RenameGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{3}
+}
+
+func (m *RenameGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameGraphRequest.Unmarshal(m, b)
+}
+func (m *RenameGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameGraphRequest.Marshal(b, m, deterministic)
+}
+func (m *RenameGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameGraphRequest.Merge(m, src)
+}
+func (m *RenameGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameGraphRequest.Size(m)
+}
+func (m *RenameGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameGraphRequest proto.InternalMessageInfo
+
+func (m *RenameGraphRequest) GetGraph() string {
+	if m != nil {
+		return m.Graph
+	}
+	return ""
+}
+
+func (m *RenameGraphRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+// reports the progress of a change to a graph. The last message has done set
+// and the final counts
+type GraphProgress struct {
+	Graph string `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	// what has just finished, e.g. "parsed" or "loaded"
+	Stage string `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	// number of triples parsed or in the graph
+	Triples int64 `protobuf:"varint,3,opt,name=triples,proto3" json:"triples,omitempty"`
+	// number of entities in the graph
+	Entities             int64    `protobuf:"varint,4,opt,name=entities,proto3" json:"entities,omitempty"`
+	Done                 bool     `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphProgress) Reset()         { *m = GraphProgress{} }
+func (m *GraphProgress) String() string { return proto.CompactTextString(m) }
+func (*GraphProgress) ProtoMessage()    {}
+func (*GraphProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{4}
+}
+
+func (m *GraphProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphProgress.Unmarshal(m, b)
+}
+func (m *GraphProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphProgress.Marshal(b, m, deterministic)
+}
+func (m *GraphProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphProgress.Merge(m, src)
+}
+func (m *GraphProgress) XXX_Size() int {
+	return xxx_messageInfo_GraphProgress.Size(m)
+}
+func (m *GraphProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphProgress proto.InternalMessageInfo
+
+func (m *GraphProgress) GetGraph() string {
+	if m != nil {
+		return m.Graph
+	}
+	return ""
+}
+
+func (m *GraphProgress) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *GraphProgress) GetTriples() int64 {
+	if m != nil {
+		return m.Triples
+	}
+	return 0
+}
+
+func (m *GraphProgress) GetEntities() int64 {
+	if m != nil {
+		return m.Entities
+	}
+	return 0
+}
+
+func (m *GraphProgress) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
 type ParseRequest struct {
 	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -95,7 +435,7 @@ func (m *ParseRequest) Reset()         { *m = ParseRequest{} }
 func (m *ParseRequest) String() string { return proto.CompactTextString(m) }
 func (*ParseRequest) ProtoMessage()    {}
 func (*ParseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{0}
+	return fileDescriptor_a153da538f858886, []int{5}
 }
 
 func (m *ParseRequest) XXX_Unmarshal(b []byte) error {
@@ -138,7 +478,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{1}
+	return fileDescriptor_a153da538f858886, []int{6}
 }
 
 func (m *Response) XXX_Unmarshal(b []byte) error {
@@ -199,8 +539,11 @@ type URI struct {
 	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Value     string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	// variable
-	Variable             string   `protobuf:"bytes,3,opt,name=variable,proto3" json:"variable,omitempty"`
-	Pattern              Pattern  `protobuf:"varint,4,opt,name=pattern,proto3,enum=proto.Pattern" json:"pattern,omitempty"`
+	Variable string  `protobuf:"bytes,3,opt,name=variable,proto3" json:"variable,omitempty"`
+	Pattern  Pattern `protobuf:"varint,4,opt,name=pattern,proto3,enum=proto.Pattern" json:"pattern,omitempty"`
+	// literals: the datatype IRI (empty for plain strings) and language tag
+	Datatype             string   `protobuf:"bytes,5,opt,name=datatype,proto3" json:"datatype,omitempty"`
+	Lang                 string   `protobuf:"bytes,6,opt,name=lang,proto3" json:"lang,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -210,7 +553,7 @@ func (m *URI) Reset()         { *m = URI{} }
 func (m *URI) String() string { return proto.CompactTextString(m) }
 func (*URI) ProtoMessage()    {}
 func (*URI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{2}
+	return fileDescriptor_a153da538f858886, []int{7}
 }
 
 func (m *URI) XXX_Unmarshal(b []byte) error {
@@ -259,6 +602,20 @@ func (m *URI) GetPattern() Pattern {
 	return Pattern_Single
 }
 
+func (m *URI) GetDatatype() string {
+	if m != nil {
+		return m.Datatype
+	}
+	return ""
+}
+
+func (m *URI) GetLang() string {
+	if m != nil {
+		return m.Lang
+	}
+	return ""
+}
+
 type Triple struct {
 	Subject              *URI     `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
 	Predicate            []*URI   `protobuf:"bytes,2,rep,name=predicate,proto3" json:"predicate,omitempty"`
@@ -272,7 +629,7 @@ func (m *Triple) Reset()         { *m = Triple{} }
 func (m *Triple) String() string { return proto.CompactTextString(m) }
 func (*Triple) ProtoMessage()    {}
 func (*Triple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{3}
+	return fileDescriptor_a153da538f858886, []int{8}
 }
 
 func (m *Triple) XXX_Unmarshal(b []byte) error {
@@ -323,17 +680,45 @@ type SelectQuery struct {
 	Filter    TimeFilter `protobuf:"varint,3,opt,name=filter,proto3,enum=proto.TimeFilter" json:"filter,omitempty"`
 	Timestamp int64      `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	//where clause
-	Where                []*Triple `protobuf:"bytes,5,rep,name=where,proto3" json:"where,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Where []*Triple `protobuf:"bytes,5,rep,name=where,proto3" json:"where,omitempty"`
+	// alternative groups of terms (UNION); each is joined with the where
+	// clause and the results of all of them are merged
+	Unions []*TripleGroup `protobuf:"bytes,6,rep,name=unions,proto3" json:"unions,omitempty"`
+	// groups of terms (OPTIONAL) that extend the results of the where clause
+	// when they match; their variables are empty otherwise
+	Optional []*TripleGroup `protobuf:"bytes,7,rep,name=optional,proto3" json:"optional,omitempty"`
+	// constraints (FILTER) on the rows of the where clause; rows for which
+	// any of them is not true are dropped
+	Filters []*FilterExpr `protobuf:"bytes,8,rep,name=filters,proto3" json:"filters,omitempty"`
+	// sort order of the rows (ORDER BY); variables that are not selected can be used
+	Order []*OrderCondition `protobuf:"bytes,9,rep,name=order,proto3" json:"order,omitempty"`
+	// maximum number of rows to return (LIMIT); 0 returns all rows
+	Limit int64 `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	// number of rows to skip before returning rows (OFFSET)
+	Offset int64 `protobuf:"varint,11,opt,name=offset,proto3" json:"offset,omitempty"`
+	// variables the rows are grouped on (GROUP BY)
+	GroupBy []string `protobuf:"bytes,12,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	// aggregates computed over the rows of each group, e.g. (COUNT(?p) AS ?n).
+	// Their names are listed in vars
+	Aggregates []*Aggregate `protobuf:"bytes,13,rep,name=aggregates,proto3" json:"aggregates,omitempty"`
+	// remove duplicate rows (SELECT DISTINCT); otherwise every solution of the
+	// where clause is returned, even if the selected values are the same
+	Distinct bool `protobuf:"varint,14,opt,name=distinct,proto3" json:"distinct,omitempty"`
+	// duplicate rows may be removed (SELECT REDUCED); they are removed as for distinct
+	Reduced bool `protobuf:"varint,15,opt,name=reduced,proto3" json:"reduced,omitempty"`
+	// groups of terms (GRAPH) that are matched against other graphs and joined
+	// with the where clause
+	GraphPatterns        []*GraphPattern `protobuf:"bytes,16,rep,name=graph_patterns,json=graphPatterns,proto3" json:"graph_patterns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *SelectQuery) Reset()         { *m = SelectQuery{} }
 func (m *SelectQuery) String() string { return proto.CompactTextString(m) }
 func (*SelectQuery) ProtoMessage()    {}
 func (*SelectQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{4}
+	return fileDescriptor_a153da538f858886, []int{9}
 }
 
 func (m *SelectQuery) XXX_Unmarshal(b []byte) error {
@@ -389,75 +774,568 @@ func (m *SelectQuery) GetWhere() []*Triple {
 	return nil
 }
 
-type InsertQuery struct {
-	// insert terms
-	Insert []*Triple `protobuf:"bytes,1,rep,name=insert,proto3" json:"insert,omitempty"`
-	// list of graphs to insert into, including '*'
-	Graphs []string `protobuf:"bytes,2,rep,name=graphs,proto3" json:"graphs,omitempty"`
-	//where clause
-	Where []*Triple `protobuf:"bytes,3,rep,name=where,proto3" json:"where,omitempty"`
-	// whether or not to wait for flush to disk
-	Blocking             bool     `protobuf:"varint,4,opt,name=blocking,proto3" json:"blocking,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SelectQuery) GetUnions() []*TripleGroup {
+	if m != nil {
+		return m.Unions
+	}
+	return nil
 }
 
-func (m *InsertQuery) Reset()         { *m = InsertQuery{} }
-func (m *InsertQuery) String() string { return proto.CompactTextString(m) }
-func (*InsertQuery) ProtoMessage()    {}
-func (*InsertQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{5}
+func (m *SelectQuery) GetOptional() []*TripleGroup {
+	if m != nil {
+		return m.Optional
+	}
+	return nil
 }
 
-func (m *InsertQuery) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InsertQuery.Unmarshal(m, b)
-}
-func (m *InsertQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InsertQuery.Marshal(b, m, deterministic)
-}
-func (m *InsertQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertQuery.Merge(m, src)
-}
-func (m *InsertQuery) XXX_Size() int {
-	return xxx_messageInfo_InsertQuery.Size(m)
+func (m *SelectQuery) GetFilters() []*FilterExpr {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
 }
-func (m *InsertQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertQuery.DiscardUnknown(m)
+
+func (m *SelectQuery) GetOrder() []*OrderCondition {
+	if m != nil {
+		return m.Order
+	}
+	return nil
 }
 
-var xxx_messageInfo_InsertQuery proto.InternalMessageInfo
+func (m *SelectQuery) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
 
-func (m *InsertQuery) GetInsert() []*Triple {
+func (m *SelectQuery) GetOffset() int64 {
 	if m != nil {
-		return m.Insert
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-func (m *InsertQuery) GetGraphs() []string {
+func (m *SelectQuery) GetGroupBy() []string {
 	if m != nil {
-		return m.Graphs
+		return m.GroupBy
 	}
 	return nil
 }
 
-func (m *InsertQuery) GetWhere() []*Triple {
+func (m *SelectQuery) GetAggregates() []*Aggregate {
 	if m != nil {
-		return m.Where
+		return m.Aggregates
 	}
 	return nil
 }
 
-func (m *InsertQuery) GetBlocking() bool {
+func (m *SelectQuery) GetDistinct() bool {
 	if m != nil {
-		return m.Blocking
+		return m.Distinct
 	}
 	return false
 }
 
-type VersionQuery struct {
-	Filter               TimeFilter `protobuf:"varint,1,opt,name=filter,proto3,enum=proto.TimeFilter" json:"filter,omitempty"`
+func (m *SelectQuery) GetReduced() bool {
+	if m != nil {
+		return m.Reduced
+	}
+	return false
+}
+
+func (m *SelectQuery) GetGraphPatterns() []*GraphPattern {
+	if m != nil {
+		return m.GraphPatterns
+	}
+	return nil
+}
+
+type Aggregate struct {
+	Function AggregateFunction `protobuf:"varint,1,opt,name=function,proto3,enum=proto.AggregateFunction" json:"function,omitempty"`
+	// variable the aggregate is computed over; * for COUNT(*)
+	Var      string `protobuf:"bytes,2,opt,name=var,proto3" json:"var,omitempty"`
+	Distinct bool   `protobuf:"varint,3,opt,name=distinct,proto3" json:"distinct,omitempty"`
+	// separator for GroupConcat
+	Separator string `protobuf:"bytes,4,opt,name=separator,proto3" json:"separator,omitempty"`
+	// name of the variable holding the result
+	Name                 string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Aggregate) Reset()         { *m = Aggregate{} }
+func (m *Aggregate) String() string { return proto.CompactTextString(m) }
+func (*Aggregate) ProtoMessage()    {}
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{10}
+}
+
+func (m *Aggregate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Aggregate.Unmarshal(m, b)
+}
+func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Aggregate.Marshal(b, m, deterministic)
+}
+func (m *Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Aggregate.Merge(m, src)
+}
+func (m *Aggregate) XXX_Size() int {
+	return xxx_messageInfo_Aggregate.Size(m)
+}
+func (m *Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Aggregate proto.InternalMessageInfo
+
+func (m *Aggregate) GetFunction() AggregateFunction {
+	if m != nil {
+		return m.Function
+	}
+	return AggregateFunction_Count
+}
+
+func (m *Aggregate) GetVar() string {
+	if m != nil {
+		return m.Var
+	}
+	return ""
+}
+
+func (m *Aggregate) GetDistinct() bool {
+	if m != nil {
+		return m.Distinct
+	}
+	return false
+}
+
+func (m *Aggregate) GetSeparator() string {
+	if m != nil {
+		return m.Separator
+	}
+	return ""
+}
+
+func (m *Aggregate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type OrderCondition struct {
+	Var                  string   `protobuf:"bytes,1,opt,name=var,proto3" json:"var,omitempty"`
+	Descending           bool     `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderCondition) Reset()         { *m = OrderCondition{} }
+func (m *OrderCondition) String() string { return proto.CompactTextString(m) }
+func (*OrderCondition) ProtoMessage()    {}
+func (*OrderCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{11}
+}
+
+func (m *OrderCondition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderCondition.Unmarshal(m, b)
+}
+func (m *OrderCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderCondition.Marshal(b, m, deterministic)
+}
+func (m *OrderCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderCondition.Merge(m, src)
+}
+func (m *OrderCondition) XXX_Size() int {
+	return xxx_messageInfo_OrderCondition.Size(m)
+}
+func (m *OrderCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderCondition proto.InternalMessageInfo
+
+func (m *OrderCondition) GetVar() string {
+	if m != nil {
+		return m.Var
+	}
+	return ""
+}
+
+func (m *OrderCondition) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+type TripleGroup struct {
+	Terms []*Triple `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	// OPTIONAL groups that apply to these terms
+	Optional []*TripleGroup `protobuf:"bytes,2,rep,name=optional,proto3" json:"optional,omitempty"`
+	// FILTER constraints on the rows of this group
+	Filters []*FilterExpr `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	// GRAPH patterns joined with these terms
+	GraphPatterns        []*GraphPattern `protobuf:"bytes,4,rep,name=graph_patterns,json=graphPatterns,proto3" json:"graph_patterns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TripleGroup) Reset()         { *m = TripleGroup{} }
+func (m *TripleGroup) String() string { return proto.CompactTextString(m) }
+func (*TripleGroup) ProtoMessage()    {}
+func (*TripleGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{12}
+}
+
+func (m *TripleGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TripleGroup.Unmarshal(m, b)
+}
+func (m *TripleGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TripleGroup.Marshal(b, m, deterministic)
+}
+func (m *TripleGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripleGroup.Merge(m, src)
+}
+func (m *TripleGroup) XXX_Size() int {
+	return xxx_messageInfo_TripleGroup.Size(m)
+}
+func (m *TripleGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripleGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripleGroup proto.InternalMessageInfo
+
+func (m *TripleGroup) GetTerms() []*Triple {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+func (m *TripleGroup) GetOptional() []*TripleGroup {
+	if m != nil {
+		return m.Optional
+	}
+	return nil
+}
+
+func (m *TripleGroup) GetFilters() []*FilterExpr {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *TripleGroup) GetGraphPatterns() []*GraphPattern {
+	if m != nil {
+		return m.GraphPatterns
+	}
+	return nil
+}
+
+// a group of terms (GRAPH) matched against a graph other than the one being
+// queried, so that one query can join the contents of several graphs
+type GraphPattern struct {
+	// name of the graph, or a variable. The terms of a variable are matched
+	// against each graph of the query, and the variable is bound to the name
+	// of the graph they matched in
+	Graph                string       `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	Group                *TripleGroup `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GraphPattern) Reset()         { *m = GraphPattern{} }
+func (m *GraphPattern) String() string { return proto.CompactTextString(m) }
+func (*GraphPattern) ProtoMessage()    {}
+func (*GraphPattern) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{13}
+}
+
+func (m *GraphPattern) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphPattern.Unmarshal(m, b)
+}
+func (m *GraphPattern) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphPattern.Marshal(b, m, deterministic)
+}
+func (m *GraphPattern) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphPattern.Merge(m, src)
+}
+func (m *GraphPattern) XXX_Size() int {
+	return xxx_messageInfo_GraphPattern.Size(m)
+}
+func (m *GraphPattern) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphPattern.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphPattern proto.InternalMessageInfo
+
+func (m *GraphPattern) GetGraph() string {
+	if m != nil {
+		return m.Graph
+	}
+	return ""
+}
+
+func (m *GraphPattern) GetGroup() *TripleGroup {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+// an expression in a FILTER constraint. Operators and functions have an op
+// and arguments; variables and constant terms only have a value
+type FilterExpr struct {
+	// one of ||, &&, !, =, !=, <, >, <=, >=, regex, strstarts, contains,
+	// isuri, isliteral, bound
+	Op                   string        `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Args                 []*FilterExpr `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Value                *URI          `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *FilterExpr) Reset()         { *m = FilterExpr{} }
+func (m *FilterExpr) String() string { return proto.CompactTextString(m) }
+func (*FilterExpr) ProtoMessage()    {}
+func (*FilterExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{14}
+}
+
+func (m *FilterExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilterExpr.Unmarshal(m, b)
+}
+func (m *FilterExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilterExpr.Marshal(b, m, deterministic)
+}
+func (m *FilterExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterExpr.Merge(m, src)
+}
+func (m *FilterExpr) XXX_Size() int {
+	return xxx_messageInfo_FilterExpr.Size(m)
+}
+func (m *FilterExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterExpr proto.InternalMessageInfo
+
+func (m *FilterExpr) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *FilterExpr) GetArgs() []*FilterExpr {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *FilterExpr) GetValue() *URI {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type InsertQuery struct {
+	// insert terms
+	Insert []*Triple `protobuf:"bytes,1,rep,name=insert,proto3" json:"insert,omitempty"`
+	// list of graphs to insert into, including '*'
+	Graphs []string `protobuf:"bytes,2,rep,name=graphs,proto3" json:"graphs,omitempty"`
+	//where clause
+	Where []*Triple `protobuf:"bytes,3,rep,name=where,proto3" json:"where,omitempty"`
+	// whether or not to wait for flush to disk
+	Blocking             bool     `protobuf:"varint,4,opt,name=blocking,proto3" json:"blocking,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsertQuery) Reset()         { *m = InsertQuery{} }
+func (m *InsertQuery) String() string { return proto.CompactTextString(m) }
+func (*InsertQuery) ProtoMessage()    {}
+func (*InsertQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{15}
+}
+
+func (m *InsertQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertQuery.Unmarshal(m, b)
+}
+func (m *InsertQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertQuery.Marshal(b, m, deterministic)
+}
+func (m *InsertQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertQuery.Merge(m, src)
+}
+func (m *InsertQuery) XXX_Size() int {
+	return xxx_messageInfo_InsertQuery.Size(m)
+}
+func (m *InsertQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertQuery proto.InternalMessageInfo
+
+func (m *InsertQuery) GetInsert() []*Triple {
+	if m != nil {
+		return m.Insert
+	}
+	return nil
+}
+
+func (m *InsertQuery) GetGraphs() []string {
+	if m != nil {
+		return m.Graphs
+	}
+	return nil
+}
+
+func (m *InsertQuery) GetWhere() []*Triple {
+	if m != nil {
+		return m.Where
+	}
+	return nil
+}
+
+func (m *InsertQuery) GetBlocking() bool {
+	if m != nil {
+		return m.Blocking
+	}
+	return false
+}
+
+type DeleteQuery struct {
+	// delete terms
+	Delete []*Triple `protobuf:"bytes,1,rep,name=delete,proto3" json:"delete,omitempty"`
+	// list of graphs to delete from, including '*'
+	Graphs []string `protobuf:"bytes,2,rep,name=graphs,proto3" json:"graphs,omitempty"`
+	//where clause
+	Where                []*Triple `protobuf:"bytes,3,rep,name=where,proto3" json:"where,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DeleteQuery) Reset()         { *m = DeleteQuery{} }
+func (m *DeleteQuery) String() string { return proto.CompactTextString(m) }
+func (*DeleteQuery) ProtoMessage()    {}
+func (*DeleteQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{16}
+}
+
+func (m *DeleteQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteQuery.Unmarshal(m, b)
+}
+func (m *DeleteQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteQuery.Marshal(b, m, deterministic)
+}
+func (m *DeleteQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteQuery.Merge(m, src)
+}
+func (m *DeleteQuery) XXX_Size() int {
+	return xxx_messageInfo_DeleteQuery.Size(m)
+}
+func (m *DeleteQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteQuery proto.InternalMessageInfo
+
+func (m *DeleteQuery) GetDelete() []*Triple {
+	if m != nil {
+		return m.Delete
+	}
+	return nil
+}
+
+func (m *DeleteQuery) GetGraphs() []string {
+	if m != nil {
+		return m.Graphs
+	}
+	return nil
+}
+
+func (m *DeleteQuery) GetWhere() []*Triple {
+	if m != nil {
+		return m.Where
+	}
+	return nil
+}
+
+// an inference rule: whenever triples are added to one of the graphs, the
+// template is added to it for each row of the where clause
+type ConstructQuery struct {
+	// template terms
+	Construct []*Triple `protobuf:"bytes,1,rep,name=construct,proto3" json:"construct,omitempty"`
+	// list of graphs the rule applies to, including '*'
+	Graphs []string `protobuf:"bytes,2,rep,name=graphs,proto3" json:"graphs,omitempty"`
+	//where clause
+	Where                []*Triple `protobuf:"bytes,3,rep,name=where,proto3" json:"where,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ConstructQuery) Reset()         { *m = ConstructQuery{} }
+func (m *ConstructQuery) String() string { return proto.CompactTextString(m) }
+func (*ConstructQuery) ProtoMessage()    {}
+func (*ConstructQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a153da538f858886, []int{17}
+}
+
+func (m *ConstructQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstructQuery.Unmarshal(m, b)
+}
+func (m *ConstructQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstructQuery.Marshal(b, m, deterministic)
+}
+func (m *ConstructQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstructQuery.Merge(m, src)
+}
+func (m *ConstructQuery) XXX_Size() int {
+	return xxx_messageInfo_ConstructQuery.Size(m)
+}
+func (m *ConstructQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstructQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstructQuery proto.InternalMessageInfo
+
+func (m *ConstructQuery) GetConstruct() []*Triple {
+	if m != nil {
+		return m.Construct
+	}
+	return nil
+}
+
+func (m *ConstructQuery) GetGraphs() []string {
+	if m != nil {
+		return m.Graphs
+	}
+	return nil
+}
+
+func (m *ConstructQuery) GetWhere() []*Triple {
+	if m != nil {
+		return m.Where
+	}
+	return nil
+}
+
+type VersionQuery struct {
+	Filter               TimeFilter `protobuf:"varint,1,opt,name=filter,proto3,enum=proto.TimeFilter" json:"filter,omitempty"`
 	Timestamp            int64      `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Graphs               []string   `protobuf:"bytes,2,rep,name=graphs,proto3" json:"graphs,omitempty"`
 	Limit                int64      `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -470,7 +1348,7 @@ func (m *VersionQuery) Reset()         { *m = VersionQuery{} }
 func (m *VersionQuery) String() string { return proto.CompactTextString(m) }
 func (*VersionQuery) ProtoMessage()    {}
 func (*VersionQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{6}
+	return fileDescriptor_a153da538f858886, []int{18}
 }
 
 func (m *VersionQuery) XXX_Unmarshal(b []byte) error {
@@ -534,7 +1412,7 @@ func (m *Entity) Reset()         { *m = Entity{} }
 func (m *Entity) String() string { return proto.CompactTextString(m) }
 func (*Entity) ProtoMessage()    {}
 func (*Entity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{7}
+	return fileDescriptor_a153da538f858886, []int{19}
 }
 
 func (m *Entity) XXX_Unmarshal(b []byte) error {
@@ -597,7 +1475,7 @@ func (m *Entity_Edge) Reset()         { *m = Entity_Edge{} }
 func (m *Entity_Edge) String() string { return proto.CompactTextString(m) }
 func (*Entity_Edge) ProtoMessage()    {}
 func (*Entity_Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{7, 0}
+	return fileDescriptor_a153da538f858886, []int{19, 0}
 }
 
 func (m *Entity_Edge) XXX_Unmarshal(b []byte) error {
@@ -652,7 +1530,7 @@ func (m *Entity_Endpoints) Reset()         { *m = Entity_Endpoints{} }
 func (m *Entity_Endpoints) String() string { return proto.CompactTextString(m) }
 func (*Entity_Endpoints) ProtoMessage()    {}
 func (*Entity_Endpoints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{7, 1}
+	return fileDescriptor_a153da538f858886, []int{19, 1}
 }
 
 func (m *Entity_Endpoints) XXX_Unmarshal(b []byte) error {
@@ -698,7 +1576,7 @@ func (m *Row) Reset()         { *m = Row{} }
 func (m *Row) String() string { return proto.CompactTextString(m) }
 func (*Row) ProtoMessage()    {}
 func (*Row) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{8}
+	return fileDescriptor_a153da538f858886, []int{20}
 }
 
 func (m *Row) XXX_Unmarshal(b []byte) error {
@@ -739,7 +1617,7 @@ func (m *P2PHeader) Reset()         { *m = P2PHeader{} }
 func (m *P2PHeader) String() string { return proto.CompactTextString(m) }
 func (*P2PHeader) ProtoMessage()    {}
 func (*P2PHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{9}
+	return fileDescriptor_a153da538f858886, []int{21}
 }
 
 func (m *P2PHeader) XXX_Unmarshal(b []byte) error {
@@ -787,7 +1665,7 @@ func (m *TupleRequest) Reset()         { *m = TupleRequest{} }
 func (m *TupleRequest) String() string { return proto.CompactTextString(m) }
 func (*TupleRequest) ProtoMessage()    {}
 func (*TupleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{10}
+	return fileDescriptor_a153da538f858886, []int{22}
 }
 
 func (m *TupleRequest) XXX_Unmarshal(b []byte) error {
@@ -836,7 +1714,7 @@ func (m *TupleUpdate) Reset()         { *m = TupleUpdate{} }
 func (m *TupleUpdate) String() string { return proto.CompactTextString(m) }
 func (*TupleUpdate) ProtoMessage()    {}
 func (*TupleUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a153da538f858886, []int{11}
+	return fileDescriptor_a153da538f858886, []int{23}
 }
 
 func (m *TupleUpdate) XXX_Unmarshal(b []byte) error {
@@ -857,155 +1735,417 @@ func (m *TupleUpdate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TupleUpdate proto.InternalMessageInfo
 
-func (m *TupleUpdate) GetHeader() *P2PHeader {
-	if m != nil {
-		return m.Header
+func (m *TupleUpdate) GetHeader() *P2PHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TupleUpdate) GetRows() []*Row {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+func (m *TupleUpdate) GetVars() []string {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+func (m *TupleUpdate) GetDefinition() *SelectQuery {
+	if m != nil {
+		return m.Definition
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("proto.GraphFormat", GraphFormat_name, GraphFormat_value)
+	proto.RegisterEnum("proto.TimeFilter", TimeFilter_name, TimeFilter_value)
+	proto.RegisterEnum("proto.Pattern", Pattern_name, Pattern_value)
+	proto.RegisterEnum("proto.AggregateFunction", AggregateFunction_name, AggregateFunction_value)
+	proto.RegisterType((*NewGraphRequest)(nil), "proto.NewGraphRequest")
+	proto.RegisterType((*LoadGraphRequest)(nil), "proto.LoadGraphRequest")
+	proto.RegisterType((*DropGraphRequest)(nil), "proto.DropGraphRequest")
+	proto.RegisterType((*RenameGraphRequest)(nil), "proto.RenameGraphRequest")
+	proto.RegisterType((*GraphProgress)(nil), "proto.GraphProgress")
+	proto.RegisterType((*ParseRequest)(nil), "proto.ParseRequest")
+	proto.RegisterType((*Response)(nil), "proto.Response")
+	proto.RegisterType((*URI)(nil), "proto.URI")
+	proto.RegisterType((*Triple)(nil), "proto.Triple")
+	proto.RegisterType((*SelectQuery)(nil), "proto.SelectQuery")
+	proto.RegisterType((*Aggregate)(nil), "proto.Aggregate")
+	proto.RegisterType((*OrderCondition)(nil), "proto.OrderCondition")
+	proto.RegisterType((*TripleGroup)(nil), "proto.TripleGroup")
+	proto.RegisterType((*GraphPattern)(nil), "proto.GraphPattern")
+	proto.RegisterType((*FilterExpr)(nil), "proto.FilterExpr")
+	proto.RegisterType((*InsertQuery)(nil), "proto.InsertQuery")
+	proto.RegisterType((*DeleteQuery)(nil), "proto.DeleteQuery")
+	proto.RegisterType((*ConstructQuery)(nil), "proto.ConstructQuery")
+	proto.RegisterType((*VersionQuery)(nil), "proto.VersionQuery")
+	proto.RegisterType((*Entity)(nil), "proto.Entity")
+	proto.RegisterType((*Entity_Edge)(nil), "proto.Entity.Edge")
+	proto.RegisterType((*Entity_Endpoints)(nil), "proto.Entity.Endpoints")
+	proto.RegisterType((*Row)(nil), "proto.Row")
+	proto.RegisterType((*P2PHeader)(nil), "proto.P2PHeader")
+	proto.RegisterType((*TupleRequest)(nil), "proto.TupleRequest")
+	proto.RegisterType((*TupleUpdate)(nil), "proto.TupleUpdate")
+}
+
+func init() { proto.RegisterFile("log.proto", fileDescriptor_a153da538f858886) }
+
+var fileDescriptor_a153da538f858886 = []byte{
+	// 1764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x92, 0xdb, 0xc6,
+	0x11, 0x16, 0x08, 0xfe, 0x36, 0xb9, 0x5c, 0x78, 0xb4, 0x96, 0x21, 0x5a, 0xa5, 0x6c, 0x10, 0xa9,
+	0x8a, 0x5e, 0xa5, 0xb4, 0x0a, 0xe5, 0x5c, 0x94, 0xca, 0x41, 0xd2, 0x6a, 0x6d, 0x39, 0xd2, 0x8a,
+	0x19, 0x49, 0xae, 0xc4, 0x55, 0x29, 0x15, 0x96, 0x18, 0x52, 0x48, 0xc0, 0x19, 0x68, 0x30, 0xd8,
+	0xf5, 0xa6, 0x72, 0x4a, 0x95, 0x8f, 0xc9, 0x25, 0x87, 0x1c, 0x53, 0x79, 0x82, 0xbc, 0x41, 0x0e,
+	0x39, 0x26, 0xc7, 0xbc, 0x42, 0x1e, 0x24, 0x35, 0x3d, 0x03, 0x10, 0xe4, 0x92, 0x36, 0x5d, 0x3a,
+	0x61, 0xfa, 0x07, 0x5f, 0xf7, 0xf4, 0x74, 0xf7, 0xf4, 0x40, 0x27, 0x11, 0xb3, 0xbb, 0xa9, 0x14,
+	0x4a, 0x90, 0x06, 0x7e, 0x06, 0x37, 0x66, 0x42, 0xcc, 0x12, 0x76, 0x18, 0xa6, 0xf1, 0x61, 0xc8,
+	0xb9, 0x50, 0xa1, 0x8a, 0x05, 0xcf, 0x8c, 0x52, 0x70, 0x02, 0xbb, 0x27, 0xec, 0xfc, 0x33, 0x19,
+	0xa6, 0x6f, 0x29, 0x7b, 0x97, 0xb3, 0x4c, 0x91, 0x3d, 0x68, 0xcc, 0x34, 0xed, 0x3b, 0xfb, 0xce,
+	0xb0, 0x43, 0x0d, 0x41, 0x6e, 0x43, 0x5f, 0x70, 0x25, 0x12, 0x31, 0xbb, 0x78, 0x33, 0x8d, 0x13,
+	0x96, 0xf9, 0xb5, 0x7d, 0x77, 0xd8, 0xa1, 0x3b, 0x05, 0xf7, 0x58, 0x33, 0x83, 0xbf, 0x3a, 0xe0,
+	0x3d, 0x13, 0x61, 0xb4, 0x05, 0xe2, 0x00, 0xda, 0x91, 0x98, 0xe4, 0x73, 0xc6, 0x95, 0x5f, 0xdb,
+	0x77, 0x86, 0x3d, 0x5a, 0xd2, 0xe4, 0x00, 0x9a, 0x53, 0x21, 0xe7, 0xa1, 0xf2, 0xdd, 0x7d, 0x67,
+	0xd8, 0x1f, 0x11, 0xe3, 0xee, 0x5d, 0x84, 0x3d, 0x46, 0x09, 0xb5, 0x1a, 0x6b, 0x3c, 0xab, 0xaf,
+	0xf3, 0x6c, 0x08, 0xde, 0x91, 0x14, 0xe9, 0x77, 0x3b, 0x16, 0x3c, 0x01, 0x42, 0x19, 0x0f, 0xe7,
+	0x6c, 0x8b, 0x4d, 0x5c, 0x87, 0x36, 0x67, 0xe7, 0x6f, 0xb4, 0x36, 0x6e, 0xa2, 0x43, 0x5b, 0x9c,
+	0x9d, 0x9f, 0x84, 0x73, 0x16, 0x7c, 0xe3, 0xc0, 0x0e, 0x22, 0x8c, 0xa5, 0x98, 0x49, 0x96, 0x65,
+	0x1b, 0x20, 0xf6, 0xa0, 0x91, 0xa9, 0x70, 0x56, 0xfc, 0x6f, 0x08, 0xe2, 0x43, 0x4b, 0xc9, 0x38,
+	0xd5, 0xdb, 0xd1, 0x21, 0x70, 0x69, 0x41, 0xea, 0xb8, 0x31, 0xae, 0x62, 0x15, 0xe3, 0x4e, 0xb5,
+	0xa8, 0xa4, 0x09, 0x81, 0x7a, 0x24, 0x38, 0xf3, 0x1b, 0xfb, 0xce, 0xb0, 0x4d, 0x71, 0x1d, 0xdc,
+	0x82, 0xde, 0x38, 0x94, 0x19, 0xab, 0x6c, 0xe4, 0x5d, 0xce, 0xe4, 0x45, 0xe1, 0x05, 0x12, 0xc1,
+	0x9f, 0x1d, 0x68, 0x53, 0x96, 0xa5, 0x82, 0x67, 0x4c, 0xab, 0x30, 0x29, 0x85, 0x2c, 0x54, 0x90,
+	0xd0, 0x2e, 0x9d, 0x31, 0x99, 0xc5, 0x82, 0xa3, 0xab, 0x2e, 0x2d, 0x48, 0xad, 0x3f, 0x11, 0x39,
+	0x57, 0xd6, 0x55, 0x43, 0x90, 0x1b, 0xd0, 0x39, 0x0b, 0x65, 0x1c, 0x9e, 0x2e, 0xce, 0x64, 0xc1,
+	0x20, 0x37, 0xa1, 0x2e, 0xc5, 0x79, 0xe6, 0x37, 0xf6, 0xdd, 0x61, 0x77, 0x04, 0xf6, 0x80, 0xa9,
+	0x38, 0xa7, 0xc8, 0x0f, 0xfe, 0xe1, 0x80, 0xfb, 0x9a, 0x3e, 0xd5, 0x28, 0x3a, 0xba, 0x59, 0x1a,
+	0x4e, 0x98, 0xf5, 0x67, 0xc1, 0xd0, 0x96, 0xcf, 0xc2, 0x24, 0x2f, 0x83, 0x87, 0x84, 0x0e, 0x51,
+	0x61, 0x08, 0x5d, 0xea, 0xd0, 0x92, 0x26, 0x43, 0x68, 0xa5, 0xa1, 0x52, 0x4c, 0x72, 0x8c, 0x5e,
+	0x7f, 0xd4, 0xb7, 0xa6, 0xc7, 0x86, 0x4b, 0x0b, 0x31, 0x26, 0x68, 0xa8, 0x42, 0x75, 0x91, 0x9a,
+	0x80, 0x76, 0x68, 0x49, 0xeb, 0x40, 0x27, 0x21, 0x9f, 0xf9, 0x4d, 0xe4, 0xe3, 0x3a, 0xf8, 0x03,
+	0x34, 0x5f, 0xe1, 0x19, 0x91, 0x5b, 0xd0, 0xca, 0xf2, 0xd3, 0xdf, 0xb2, 0x89, 0x42, 0x8f, 0x17,
+	0xdb, 0x7b, 0x4d, 0x9f, 0xd2, 0x42, 0x44, 0x86, 0xd0, 0x49, 0x25, 0x8b, 0xe2, 0x49, 0xa8, 0x18,
+	0x56, 0xd3, 0xb2, 0xde, 0x42, 0x48, 0x02, 0x68, 0x0a, 0x03, 0xe7, 0x5e, 0x82, 0xb3, 0x92, 0xe0,
+	0xdf, 0x75, 0xe8, 0xbe, 0x64, 0x09, 0x9b, 0xa8, 0x5f, 0xea, 0x03, 0xd5, 0x1e, 0x9e, 0x85, 0x32,
+	0xf3, 0x1d, 0x0c, 0x3c, 0xae, 0xc9, 0x35, 0x68, 0x62, 0xce, 0x15, 0xc5, 0x6b, 0x29, 0xf2, 0x09,
+	0x34, 0xa7, 0x71, 0xa2, 0x98, 0xb4, 0xe5, 0xf6, 0x81, 0xc5, 0x7f, 0x15, 0xcf, 0xd9, 0x31, 0x0a,
+	0xa8, 0x55, 0xd0, 0xc7, 0xa1, 0xe2, 0x39, 0xcb, 0x54, 0x38, 0x4f, 0x6d, 0xfa, 0x2d, 0x18, 0xe4,
+	0x47, 0xd0, 0x38, 0x7f, 0xcb, 0x24, 0xb3, 0xa7, 0xba, 0x53, 0xe0, 0x60, 0x58, 0xa8, 0x91, 0xe9,
+	0xe2, 0xce, 0xb9, 0xee, 0x41, 0x7e, 0x13, 0xb5, 0xc8, 0x92, 0xd6, 0x67, 0x52, 0xe4, 0x29, 0xb5,
+	0x1a, 0xe4, 0x2e, 0xb4, 0x45, 0xaa, 0x1b, 0x56, 0x98, 0xf8, 0xad, 0x8d, 0xda, 0xa5, 0x0e, 0xb9,
+	0x03, 0x2d, 0xe3, 0x68, 0xe6, 0xb7, 0x51, 0xbd, 0xd8, 0x8a, 0xd9, 0xc6, 0x93, 0xaf, 0x53, 0x49,
+	0x0b, 0x0d, 0x72, 0x07, 0x1a, 0x42, 0x46, 0x4c, 0xfa, 0x1d, 0x54, 0xfd, 0xd0, 0xaa, 0xbe, 0xd0,
+	0xbc, 0xc7, 0x82, 0x47, 0xb1, 0x06, 0xa5, 0x46, 0x47, 0x67, 0x5a, 0x12, 0xcf, 0x63, 0xe5, 0x83,
+	0xc9, 0x71, 0x24, 0x74, 0x44, 0xc5, 0x74, 0x9a, 0x31, 0xe5, 0x77, 0x91, 0x6d, 0x29, 0xdd, 0x17,
+	0x66, 0xda, 0xb5, 0x37, 0xa7, 0x17, 0x7e, 0x0f, 0x63, 0xdd, 0x42, 0xfa, 0xd1, 0x05, 0xb9, 0x07,
+	0x10, 0xce, 0x66, 0x92, 0xcd, 0x42, 0xc5, 0x32, 0x7f, 0x07, 0x4d, 0x7b, 0xd6, 0xf4, 0xc3, 0x42,
+	0x40, 0x2b, 0x3a, 0x98, 0x88, 0x71, 0xa6, 0x62, 0x3e, 0x51, 0x7e, 0x1f, 0x2b, 0xbb, 0xa4, 0x75,
+	0x51, 0x4a, 0x16, 0xe5, 0x13, 0x16, 0xf9, 0xbb, 0x28, 0x2a, 0x48, 0xf2, 0x00, 0xfa, 0x78, 0xbc,
+	0x6f, 0x6c, 0x3e, 0x67, 0xbe, 0x87, 0xb6, 0xae, 0x56, 0x7b, 0x69, 0x91, 0xf4, 0x3b, 0xb3, 0x0a,
+	0x95, 0x05, 0x7f, 0x77, 0xa0, 0x53, 0xfa, 0x42, 0x3e, 0x85, 0xf6, 0x34, 0xe7, 0x13, 0x1d, 0x0d,
+	0xcc, 0xe7, 0xfe, 0xc8, 0x5f, 0xf5, 0xf7, 0xd8, 0xca, 0x69, 0xa9, 0x49, 0x3c, 0x70, 0xcf, 0x42,
+	0x69, 0x0b, 0x53, 0x2f, 0x97, 0xf6, 0xe1, 0xae, 0xec, 0xe3, 0x06, 0x74, 0x32, 0x96, 0x86, 0x32,
+	0x54, 0x42, 0x62, 0x5e, 0x75, 0xe8, 0x82, 0xa1, 0x93, 0x19, 0x5b, 0xac, 0x29, 0x43, 0x5c, 0x07,
+	0x8f, 0xa0, 0xbf, 0x7c, 0x52, 0x85, 0x45, 0x67, 0x61, 0xf1, 0x26, 0x40, 0xc4, 0xb2, 0x09, 0xe3,
+	0x51, 0xcc, 0x67, 0xe8, 0x4a, 0x9b, 0x56, 0x38, 0xc1, 0x7f, 0x1c, 0xe8, 0x56, 0x12, 0x49, 0xe7,
+	0xaf, 0x62, 0x72, 0x6e, 0xaa, 0xe6, 0x72, 0xfe, 0xa2, 0x6c, 0x29, 0x27, 0x6b, 0xdf, 0x2f, 0x27,
+	0xdd, 0xef, 0xcc, 0xc9, 0xcb, 0xa7, 0x56, 0xdf, 0xfa, 0xd4, 0x4e, 0xa0, 0x57, 0x15, 0x6f, 0xb8,
+	0x6f, 0x86, 0x9a, 0x2b, 0xf2, 0x14, 0xc3, 0xb1, 0xde, 0x77, 0xa3, 0x10, 0x30, 0x80, 0x85, 0x8b,
+	0xa4, 0x0f, 0x35, 0x91, 0x5a, 0xa8, 0x9a, 0x48, 0xc9, 0x6d, 0xa8, 0x87, 0x72, 0x96, 0xf9, 0xb5,
+	0x4d, 0x7b, 0x42, 0x31, 0xd9, 0x2f, 0x3a, 0xf4, 0xe5, 0xd6, 0x65, 0x04, 0xc1, 0x9f, 0x1c, 0xe8,
+	0x3e, 0xe5, 0x19, 0x93, 0xb6, 0x73, 0xdd, 0x86, 0x66, 0x8c, 0xe4, 0xfa, 0x53, 0xb0, 0xc2, 0x8d,
+	0xcd, 0xac, 0xec, 0x41, 0xee, 0xb7, 0xf4, 0xa0, 0x01, 0xb4, 0x4f, 0x13, 0x31, 0xf9, 0x9d, 0x4e,
+	0x8b, 0xba, 0x49, 0xc5, 0x82, 0x0e, 0xde, 0x41, 0xf7, 0x88, 0x25, 0x4c, 0xb1, 0xd2, 0x9d, 0x08,
+	0xc9, 0x0d, 0xee, 0x18, 0xe1, 0x7b, 0xb9, 0x13, 0xfc, 0x1e, 0xfa, 0x8f, 0x05, 0xcf, 0x94, 0xcc,
+	0x8b, 0xf6, 0x7d, 0x07, 0x3a, 0x93, 0x82, 0xb3, 0xde, 0xf0, 0x42, 0xfe, 0x7e, 0xb6, 0xbf, 0x71,
+	0xa0, 0xf7, 0xa5, 0xb9, 0xc8, 0x8d, 0xe9, 0xc5, 0x6d, 0xe0, 0xbc, 0xdf, 0x6d, 0xb0, 0xc9, 0xad,
+	0xb2, 0x95, 0xba, 0x95, 0x56, 0x1a, 0xfc, 0xb3, 0x06, 0xcd, 0x27, 0x7a, 0x90, 0xb9, 0xd0, 0xb0,
+	0x66, 0xf5, 0x0b, 0x66, 0xc6, 0x94, 0x1e, 0x5d, 0x30, 0x48, 0x00, 0xb5, 0x98, 0xaf, 0x54, 0x9e,
+	0x91, 0xde, 0x7d, 0x12, 0xcd, 0x18, 0xad, 0xc5, 0x9c, 0xdc, 0x02, 0x57, 0xe4, 0xca, 0x77, 0x37,
+	0x2a, 0x69, 0x31, 0xf9, 0x29, 0x74, 0x18, 0x8f, 0x52, 0x11, 0x73, 0x55, 0xd4, 0xd9, 0x47, 0x2b,
+	0xba, 0x85, 0x98, 0x2e, 0x34, 0x07, 0x11, 0xd4, 0x35, 0x86, 0x76, 0x73, 0x5c, 0x5e, 0xe0, 0xd6,
+	0xcd, 0x92, 0xa1, 0x77, 0xf9, 0x65, 0x39, 0x9a, 0xf4, 0xa8, 0x21, 0xf4, 0xf8, 0x61, 0xcb, 0xd3,
+	0x77, 0xd7, 0x8f, 0x1f, 0x76, 0x31, 0x38, 0xd4, 0x41, 0xb0, 0x26, 0x75, 0x6b, 0x7b, 0x29, 0x27,
+	0xd6, 0x88, 0x5e, 0x6a, 0xce, 0x51, 0x56, 0x4c, 0xce, 0x7a, 0x19, 0x7c, 0x02, 0x2e, 0x15, 0xe7,
+	0x7a, 0x58, 0xc0, 0xba, 0x2a, 0x9a, 0xd8, 0xd2, 0xb0, 0x60, 0x24, 0xc1, 0x7d, 0xe8, 0x8c, 0x47,
+	0xe3, 0xcf, 0x59, 0xa8, 0x6f, 0x36, 0x02, 0x75, 0x7d, 0x66, 0x08, 0xee, 0x52, 0x5c, 0x6b, 0xde,
+	0x54, 0x8a, 0xb9, 0x85, 0xc7, 0x75, 0x90, 0x40, 0xef, 0x55, 0x9e, 0x26, 0xe5, 0x20, 0x39, 0x84,
+	0xe6, 0x5b, 0x44, 0xb0, 0x43, 0x4e, 0x71, 0x89, 0x95, 0xc8, 0xd4, 0xca, 0xc9, 0x48, 0xb7, 0xe1,
+	0x69, 0xcc, 0xb1, 0x4d, 0xaf, 0xf4, 0x9d, 0xca, 0xcc, 0x42, 0x2b, 0x5a, 0xc1, 0xdf, 0x74, 0x6b,
+	0xd6, 0xe6, 0x5e, 0xa7, 0x91, 0x0e, 0xe7, 0xf6, 0xd6, 0x8a, 0xc9, 0xb2, 0xb6, 0x7e, 0xb2, 0x2c,
+	0x27, 0x23, 0xb7, 0x32, 0x19, 0x2d, 0x7b, 0x58, 0xdf, 0xc6, 0xc3, 0x83, 0xfb, 0xd0, 0xad, 0xbc,
+	0x47, 0x08, 0x40, 0xf3, 0x55, 0x2e, 0x55, 0xc2, 0xbc, 0x2b, 0xa4, 0x07, 0xed, 0x13, 0x53, 0x65,
+	0x99, 0xe7, 0x68, 0x09, 0x3d, 0x3a, 0xfe, 0xd5, 0xf3, 0x67, 0x5e, 0xed, 0xe0, 0x0e, 0xc0, 0xa2,
+	0x8e, 0x48, 0x13, 0x6a, 0x0f, 0x95, 0x77, 0x45, 0x6b, 0x3c, 0x62, 0x53, 0x21, 0x99, 0xe7, 0x90,
+	0x0e, 0x34, 0x1e, 0x4e, 0x15, 0x93, 0x5e, 0xed, 0xe0, 0xe7, 0x65, 0xb2, 0x68, 0x8d, 0x97, 0x31,
+	0x9f, 0x21, 0x7a, 0x17, 0x5a, 0x5f, 0x31, 0x29, 0x5e, 0x70, 0xad, 0xde, 0x83, 0xb6, 0x26, 0xc6,
+	0x49, 0x9e, 0x79, 0x35, 0x2d, 0x7a, 0xc1, 0x19, 0x12, 0xee, 0xc1, 0x73, 0xf8, 0xe0, 0xd2, 0x05,
+	0xad, 0xe1, 0x1f, 0xeb, 0xf1, 0xdc, 0xbb, 0x42, 0x5a, 0xe0, 0x3e, 0x8f, 0xb9, 0xe7, 0xe0, 0x22,
+	0xfc, 0xda, 0xab, 0xa1, 0x95, 0x70, 0x9e, 0x26, 0xcc, 0x73, 0xc9, 0xae, 0xde, 0x9e, 0xc8, 0xd3,
+	0xc7, 0x82, 0x4f, 0x42, 0xe5, 0xd5, 0x47, 0xff, 0x6a, 0x41, 0xe3, 0x73, 0x11, 0x1d, 0x3d, 0x22,
+	0x5f, 0x40, 0xd3, 0x04, 0x85, 0xac, 0x89, 0xd1, 0x60, 0xb7, 0x88, 0xb8, 0x7d, 0x4e, 0x04, 0x1f,
+	0xff, 0xf1, 0xbf, 0xff, 0xfb, 0x4b, 0xed, 0xc3, 0xc0, 0x3b, 0x3c, 0xfb, 0xc9, 0xe1, 0x5b, 0x11,
+	0x45, 0xa7, 0x87, 0x19, 0xea, 0x3f, 0x70, 0x0e, 0xc8, 0xaf, 0xa1, 0x67, 0x7e, 0x7e, 0xa9, 0x24,
+	0x0b, 0xe7, 0xdb, 0x21, 0x06, 0x88, 0x78, 0x23, 0xf8, 0x68, 0x15, 0xf1, 0x30, 0x43, 0x94, 0x07,
+	0xce, 0xc1, 0x3d, 0x87, 0x3c, 0x83, 0x06, 0xbe, 0x7c, 0xc8, 0xd5, 0xb2, 0xc6, 0x16, 0xef, 0xa0,
+	0xc1, 0x1a, 0x43, 0xc1, 0x00, 0x71, 0xf7, 0x82, 0xdd, 0x05, 0x6e, 0xaa, 0xff, 0xd1, 0x8e, 0x7e,
+	0x01, 0x4d, 0x73, 0x4b, 0x95, 0x2e, 0x56, 0x2e, 0xad, 0xad, 0x36, 0x6d, 0x2e, 0x2e, 0x8b, 0x65,
+	0xae, 0x98, 0x12, 0xab, 0x72, 0xe3, 0x6c, 0x85, 0x65, 0x6e, 0x1d, 0x8d, 0xf5, 0x15, 0xb4, 0x8b,
+	0x27, 0x3c, 0xb9, 0x66, 0xff, 0x5c, 0x79, 0xd3, 0x0f, 0xf6, 0x96, 0xa6, 0x07, 0xfb, 0x1e, 0x0d,
+	0x6e, 0x22, 0xac, 0x1f, 0x5c, 0x5d, 0xc0, 0x62, 0x87, 0x3e, 0xe4, 0xec, 0xdc, 0x44, 0xf0, 0x37,
+	0xd0, 0x29, 0x5f, 0xf3, 0xa4, 0x68, 0x8d, 0xab, 0xef, 0xfb, 0x0d, 0xe8, 0x3f, 0x40, 0xf4, 0xeb,
+	0xc1, 0xde, 0x2a, 0x7a, 0x22, 0xc2, 0xa8, 0x84, 0x2f, 0xdf, 0xe4, 0x25, 0xfc, 0xea, 0x2b, 0xfd,
+	0xfb, 0xc2, 0x47, 0x52, 0xa4, 0x06, 0x7e, 0x02, 0xdd, 0xca, 0x43, 0x9e, 0x5c, 0x2f, 0xc3, 0xba,
+	0xfa, 0xb8, 0xdf, 0x60, 0xe2, 0x87, 0x68, 0xe2, 0xe3, 0xe0, 0xda, 0xaa, 0x09, 0x89, 0x08, 0xc6,
+	0xc8, 0x73, 0x68, 0x3d, 0x8c, 0x22, 0x9a, 0x27, 0x8c, 0x14, 0x0f, 0x88, 0xe5, 0xab, 0xfc, 0xf2,
+	0x71, 0xae, 0xc9, 0x32, 0x99, 0x27, 0x2c, 0xd3, 0xa7, 0xf9, 0x63, 0x5b, 0x9e, 0x5b, 0xd5, 0x01,
+	0xb9, 0x07, 0x6d, 0x7b, 0x75, 0x67, 0x65, 0x92, 0x57, 0xef, 0xf2, 0x4b, 0x7f, 0x8c, 0x7e, 0x06,
+	0xee, 0x78, 0x34, 0x26, 0x9f, 0x42, 0xab, 0x68, 0xe3, 0xc5, 0x7f, 0xd5, 0xde, 0x3e, 0x20, 0x55,
+	0xa6, 0xe9, 0xc0, 0xf7, 0x9c, 0xd3, 0x26, 0x32, 0xef, 0xff, 0x7f, 0x00, 0xa4, 0xaf, 0x12, 0x1f,
+	0x66, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// HodDBClient is the client API for HodDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HodDBClient interface {
+	Select(ctx context.Context, in *SelectQuery, opts ...grpc.CallOption) (*Response, error)
+	SelectStream(ctx context.Context, in *SelectQuery, opts ...grpc.CallOption) (HodDB_SelectStreamClient, error)
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*SelectQuery, error)
+	Insert(ctx context.Context, in *InsertQuery, opts ...grpc.CallOption) (*Response, error)
+	Delete(ctx context.Context, in *DeleteQuery, opts ...grpc.CallOption) (*Response, error)
+	NewGraph(ctx context.Context, in *NewGraphRequest, opts ...grpc.CallOption) (HodDB_NewGraphClient, error)
+	LoadGraph(ctx context.Context, in *LoadGraphRequest, opts ...grpc.CallOption) (HodDB_LoadGraphClient, error)
+	DropGraph(ctx context.Context, in *DropGraphRequest, opts ...grpc.CallOption) (HodDB_DropGraphClient, error)
+	RenameGraph(ctx context.Context, in *RenameGraphRequest, opts ...grpc.CallOption) (HodDB_RenameGraphClient, error)
+	AddRule(ctx context.Context, in *ConstructQuery, opts ...grpc.CallOption) (*Response, error)
+	Count(ctx context.Context, in *SelectQuery, opts ...grpc.CallOption) (*Response, error)
+	Versions(ctx context.Context, in *VersionQuery, opts ...grpc.CallOption) (*Response, error)
+}
+
+type hodDBClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHodDBClient(cc *grpc.ClientConn) HodDBClient {
+	return &hodDBClient{cc}
+}
+
+func (c *hodDBClient) Select(ctx context.Context, in *SelectQuery, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/proto.HodDB/Select", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hodDBClient) SelectStream(ctx context.Context, in *SelectQuery, opts ...grpc.CallOption) (HodDB_SelectStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HodDB_serviceDesc.Streams[0], "/proto.HodDB/SelectStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hodDBSelectStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HodDB_SelectStreamClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type hodDBSelectStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *hodDBSelectStreamClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *hodDBClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*SelectQuery, error) {
+	out := new(SelectQuery)
+	err := c.cc.Invoke(ctx, "/proto.HodDB/Parse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hodDBClient) Insert(ctx context.Context, in *InsertQuery, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/proto.HodDB/Insert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hodDBClient) Delete(ctx context.Context, in *DeleteQuery, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/proto.HodDB/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hodDBClient) NewGraph(ctx context.Context, in *NewGraphRequest, opts ...grpc.CallOption) (HodDB_NewGraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HodDB_serviceDesc.Streams[1], "/proto.HodDB/NewGraph", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hodDBNewGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HodDB_NewGraphClient interface {
+	Recv() (*GraphProgress, error)
+	grpc.ClientStream
+}
+
+type hodDBNewGraphClient struct {
+	grpc.ClientStream
+}
+
+func (x *hodDBNewGraphClient) Recv() (*GraphProgress, error) {
+	m := new(GraphProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return nil
+	return m, nil
 }
 
-func (m *TupleUpdate) GetRows() []*Row {
-	if m != nil {
-		return m.Rows
+func (c *hodDBClient) LoadGraph(ctx context.Context, in *LoadGraphRequest, opts ...grpc.CallOption) (HodDB_LoadGraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HodDB_serviceDesc.Streams[2], "/proto.HodDB/LoadGraph", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	x := &hodDBLoadGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *TupleUpdate) GetVars() []string {
-	if m != nil {
-		return m.Vars
-	}
-	return nil
+type HodDB_LoadGraphClient interface {
+	Recv() (*GraphProgress, error)
+	grpc.ClientStream
 }
 
-func (m *TupleUpdate) GetDefinition() *SelectQuery {
-	if m != nil {
-		return m.Definition
-	}
-	return nil
+type hodDBLoadGraphClient struct {
+	grpc.ClientStream
 }
 
-func init() {
-	proto.RegisterEnum("proto.TimeFilter", TimeFilter_name, TimeFilter_value)
-	proto.RegisterEnum("proto.Pattern", Pattern_name, Pattern_value)
-	proto.RegisterType((*ParseRequest)(nil), "proto.ParseRequest")
-	proto.RegisterType((*Response)(nil), "proto.Response")
-	proto.RegisterType((*URI)(nil), "proto.URI")
-	proto.RegisterType((*Triple)(nil), "proto.Triple")
-	proto.RegisterType((*SelectQuery)(nil), "proto.SelectQuery")
-	proto.RegisterType((*InsertQuery)(nil), "proto.InsertQuery")
-	proto.RegisterType((*VersionQuery)(nil), "proto.VersionQuery")
-	proto.RegisterType((*Entity)(nil), "proto.Entity")
-	proto.RegisterType((*Entity_Edge)(nil), "proto.Entity.Edge")
-	proto.RegisterType((*Entity_Endpoints)(nil), "proto.Entity.Endpoints")
-	proto.RegisterType((*Row)(nil), "proto.Row")
-	proto.RegisterType((*P2PHeader)(nil), "proto.P2PHeader")
-	proto.RegisterType((*TupleRequest)(nil), "proto.TupleRequest")
-	proto.RegisterType((*TupleUpdate)(nil), "proto.TupleUpdate")
+func (x *hodDBLoadGraphClient) Recv() (*GraphProgress, error) {
+	m := new(GraphProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func init() { proto.RegisterFile("log.proto", fileDescriptor_a153da538f858886) }
+func (c *hodDBClient) DropGraph(ctx context.Context, in *DropGraphRequest, opts ...grpc.CallOption) (HodDB_DropGraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HodDB_serviceDesc.Streams[3], "/proto.HodDB/DropGraph", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hodDBDropGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
 
-var fileDescriptor_a153da538f858886 = []byte{
-	// 919 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0xaf, 0xed, 0xc4, 0x89, 0x5f, 0xc2, 0x36, 0x0c, 0x0b, 0x44, 0xa1, 0x42, 0x91, 0x59, 0xa4,
-	0x74, 0x41, 0x9b, 0xe2, 0xc2, 0x05, 0xc4, 0xa1, 0xa5, 0x45, 0x5d, 0x40, 0x6a, 0x98, 0xdd, 0xed,
-	0x81, 0x9b, 0x13, 0xbf, 0x64, 0x07, 0x9c, 0x19, 0x77, 0x66, 0x92, 0x55, 0x25, 0x24, 0x04, 0x12,
-	0x47, 0xb8, 0xf0, 0x01, 0x38, 0xf2, 0x2d, 0xf8, 0x12, 0x7c, 0x05, 0x3e, 0x08, 0x9a, 0xf1, 0xd8,
-	0x4e, 0xbb, 0xbb, 0xd2, 0x4a, 0x9c, 0xfc, 0xfe, 0xcd, 0xef, 0xfd, 0xfc, 0xe6, 0xbd, 0x37, 0x10,
-	0xe5, 0x62, 0x75, 0x54, 0x48, 0xa1, 0x05, 0x69, 0xdb, 0xcf, 0xe8, 0xce, 0x4a, 0x88, 0x55, 0x8e,
-	0xd3, 0xb4, 0x60, 0xd3, 0x94, 0x73, 0xa1, 0x53, 0xcd, 0x04, 0x57, 0x65, 0x50, 0x7c, 0x00, 0xfd,
-	0x59, 0x2a, 0x15, 0x52, 0x7c, 0xbe, 0x41, 0xa5, 0xc9, 0x3e, 0xb4, 0x9f, 0x6f, 0x50, 0xbe, 0x18,
-	0x7a, 0x63, 0x6f, 0x12, 0xd1, 0x52, 0x89, 0x7f, 0xf7, 0xa0, 0x4b, 0x51, 0x15, 0x82, 0x2b, 0x34,
-	0x21, 0x28, 0xa5, 0x90, 0x55, 0x88, 0x55, 0xc8, 0x10, 0x3a, 0x5b, 0x94, 0x8a, 0x09, 0x3e, 0xf4,
-	0xc7, 0xde, 0x24, 0xa0, 0x95, 0x6a, 0xe2, 0x17, 0x62, 0xc3, 0xf5, 0x30, 0xb0, 0xf6, 0x52, 0x21,
-	0x77, 0x20, 0xda, 0xa6, 0x92, 0xa5, 0xf3, 0x1c, 0xd5, 0xb0, 0x35, 0x0e, 0x26, 0x11, 0x6d, 0x0c,
-	0xe4, 0x5d, 0x68, 0x49, 0x71, 0xa1, 0x86, 0xed, 0x71, 0x30, 0xe9, 0x25, 0x50, 0x92, 0x3d, 0xa2,
-	0xe2, 0x82, 0x5a, 0x7b, 0xfc, 0x13, 0x04, 0x67, 0xf4, 0xd8, 0x80, 0xf0, 0x74, 0x8d, 0xaa, 0x48,
-	0x17, 0xe8, 0xe8, 0x34, 0x06, 0x93, 0x78, 0x9b, 0xe6, 0x1b, 0xb4, 0x84, 0x22, 0x5a, 0x2a, 0x64,
-	0x04, 0xdd, 0x2a, 0x8f, 0x65, 0x14, 0xd1, 0x5a, 0x27, 0x13, 0xe8, 0x14, 0xa9, 0xd6, 0x28, 0xf9,
-	0xb0, 0x35, 0xf6, 0x26, 0x7b, 0xc9, 0x9e, 0xcb, 0x3c, 0x2b, 0xad, 0xb4, 0x72, 0xc7, 0x3f, 0x42,
-	0x78, 0x2a, 0x59, 0x91, 0x23, 0x39, 0x80, 0x8e, 0xda, 0xcc, 0xbf, 0xc7, 0x85, 0xb6, 0x0c, 0x1a,
-	0xb6, 0x67, 0xf4, 0x98, 0x56, 0x2e, 0x32, 0x81, 0xa8, 0x90, 0x98, 0xb1, 0x45, 0xaa, 0x0d, 0x9f,
-	0xe0, 0x95, 0xb8, 0xc6, 0x49, 0x62, 0x08, 0x45, 0x09, 0x17, 0x5c, 0x82, 0x73, 0x9e, 0xf8, 0x2f,
-	0x0f, 0x7a, 0x27, 0x98, 0xe3, 0x42, 0x7f, 0x6b, 0xee, 0x87, 0x10, 0x68, 0x6d, 0x53, 0xa9, 0x86,
-	0x9e, 0xad, 0xa3, 0x95, 0xc9, 0x5b, 0x10, 0xae, 0x64, 0x5a, 0x9c, 0x2b, 0x9b, 0x2e, 0xa2, 0x4e,
-	0x23, 0x77, 0x21, 0x5c, 0xb2, 0x5c, 0xa3, 0xb4, 0xf8, 0x7b, 0xc9, 0xeb, 0x0e, 0xff, 0x94, 0xad,
-	0xf1, 0x4b, 0xeb, 0xa0, 0x2e, 0xc0, 0x94, 0x57, 0xb3, 0x35, 0x2a, 0x9d, 0xae, 0x0b, 0x5b, 0x90,
-	0x80, 0x36, 0x06, 0xf2, 0x1e, 0xb4, 0x2f, 0xce, 0x51, 0xa2, 0xbb, 0xa4, 0xd7, 0x2a, 0x1c, 0x5b,
-	0x16, 0x5a, 0xfa, 0xe2, 0xdf, 0x3c, 0xe8, 0x1d, 0x73, 0x85, 0xd2, 0x31, 0x7d, 0x1f, 0x42, 0x66,
-	0x55, 0xcb, 0xf5, 0xd2, 0x29, 0xe7, 0xbc, 0x96, 0x7c, 0x9d, 0x33, 0xb8, 0x3e, 0xa7, 0xb9, 0xe1,
-	0x79, 0x2e, 0x16, 0x3f, 0x30, 0xbe, 0xb2, 0xac, 0xbb, 0xb4, 0xd6, 0xe3, 0x5f, 0x3d, 0xe8, 0x3f,
-	0x2b, 0x1b, 0xb3, 0x24, 0xd4, 0x94, 0xc3, 0xfb, 0x7f, 0xe5, 0xb8, 0x8e, 0xf2, 0x3e, 0xb4, 0x73,
-	0xb6, 0x66, 0x75, 0xfb, 0x5b, 0x25, 0xfe, 0xdb, 0x87, 0xf0, 0x31, 0xd7, 0x4c, 0xbf, 0x30, 0xb0,
-	0xa5, 0xf4, 0x35, 0x96, 0x63, 0xd7, 0xa7, 0x8d, 0x81, 0xc4, 0xe0, 0x33, 0xee, 0x3a, 0x86, 0x38,
-	0x6e, 0xa5, 0xf7, 0xe8, 0x71, 0xb6, 0x42, 0xea, 0x33, 0x4e, 0x0e, 0x20, 0x10, 0x1b, 0xed, 0x6a,
-	0x72, 0x55, 0x90, 0x71, 0x93, 0x4f, 0x20, 0x42, 0x9e, 0x15, 0x82, 0x71, 0x5d, 0x4e, 0x5c, 0x2f,
-	0x79, 0xfb, 0x95, 0xd8, 0xca, 0x4d, 0x9b, 0xc8, 0x51, 0x06, 0x2d, 0x83, 0x61, 0x68, 0xce, 0xea,
-	0x0e, 0x76, 0x34, 0x6b, 0x83, 0xf9, 0xcb, 0x67, 0xf5, 0xac, 0xf5, 0x69, 0xa9, 0x98, 0x79, 0x72,
-	0x93, 0xe3, 0x9a, 0xed, 0xd2, 0x3c, 0x39, 0x61, 0x34, 0x35, 0x45, 0x70, 0x29, 0xc9, 0x00, 0x82,
-	0x13, 0xb9, 0x70, 0x49, 0x8c, 0x68, 0x2c, 0x8f, 0x94, 0x76, 0xe0, 0x46, 0x8c, 0xef, 0x42, 0x40,
-	0xc5, 0x85, 0x99, 0x16, 0x3b, 0xd6, 0xca, 0xf5, 0xd3, 0x4b, 0xd3, 0x52, 0x7a, 0xe2, 0xfb, 0x10,
-	0xcd, 0x92, 0xd9, 0x13, 0x4c, 0x33, 0x94, 0x66, 0x54, 0xcc, 0x9d, 0x59, 0xf0, 0x80, 0x5a, 0xd9,
-	0xd8, 0x96, 0x52, 0xac, 0x1d, 0xbc, 0x95, 0xe3, 0x1c, 0xfa, 0xa7, 0x1b, 0xd3, 0x54, 0x6e, 0x31,
-	0x4e, 0x20, 0x3c, 0xb7, 0x08, 0x6e, 0xca, 0x07, 0xd5, 0x9f, 0x54, 0xc8, 0xd4, 0xf9, 0x49, 0x02,
-	0x90, 0xe1, 0x92, 0x71, 0xa6, 0xab, 0x65, 0xd8, 0x5c, 0xca, 0xce, 0xd0, 0xd2, 0x9d, 0xa8, 0xf8,
-	0x4f, 0x0f, 0x7a, 0x36, 0xdd, 0x59, 0x91, 0x99, 0x72, 0xde, 0x3c, 0x5b, 0xb5, 0x29, 0xfd, 0xab,
-	0x37, 0x65, 0xbd, 0x1a, 0x82, 0x9d, 0xd5, 0xf0, 0x32, 0xc3, 0xd6, 0x4d, 0x18, 0x1e, 0x7e, 0x00,
-	0xd0, 0x8c, 0x04, 0x09, 0xc1, 0x7f, 0xa0, 0x07, 0xb7, 0x08, 0x40, 0xf8, 0x10, 0x97, 0x42, 0xe2,
-	0xc0, 0x23, 0x11, 0xb4, 0x1f, 0x2c, 0x35, 0xca, 0x81, 0x7f, 0xf8, 0x79, 0x7d, 0xef, 0x26, 0xe2,
-	0x84, 0xf1, 0x55, 0x8e, 0x83, 0x5b, 0xa4, 0x07, 0x9d, 0xef, 0x50, 0x8a, 0xa7, 0xdc, 0x84, 0xf7,
-	0xa1, 0x6b, 0x94, 0x59, 0xbe, 0x51, 0x03, 0xdf, 0xb8, 0x9e, 0x72, 0xb4, 0x4a, 0x90, 0xfc, 0xec,
-	0x43, 0xfb, 0x89, 0xc8, 0x1e, 0x3d, 0x24, 0x5f, 0x41, 0x58, 0x12, 0x22, 0x57, 0xf0, 0x1b, 0xdd,
-	0xae, 0xfe, 0xd6, 0x3d, 0x4d, 0xf1, 0x3b, 0xbf, 0xfc, 0xf3, 0xef, 0x1f, 0xfe, 0x9b, 0xf1, 0x60,
-	0xba, 0xfd, 0x68, 0x7a, 0x2e, 0xb2, 0x6c, 0x3e, 0x55, 0x36, 0xfe, 0x53, 0xef, 0x90, 0x7c, 0x03,
-	0x6d, 0xfb, 0xd4, 0x91, 0x37, 0xea, 0x26, 0x6c, 0x1e, 0xbe, 0xd1, 0x15, 0xf8, 0xf1, 0xc8, 0xc2,
-	0xed, 0xc7, 0xb7, 0x1b, 0xb8, 0xc2, 0x9c, 0x31, 0x68, 0x1f, 0x42, 0xfb, 0x0b, 0xfb, 0x90, 0xdd,
-	0x84, 0x18, 0xb9, 0x07, 0x5d, 0xb7, 0x75, 0x54, 0x9d, 0x7e, 0x77, 0x0d, 0x5d, 0x3a, 0x91, 0x7c,
-	0x06, 0xc1, 0x2c, 0x99, 0x91, 0x8f, 0xa1, 0x53, 0x75, 0x60, 0x75, 0x6e, 0xb7, 0x2d, 0x6b, 0xda,
-	0x3b, 0xcd, 0x73, 0xcf, 0x9b, 0x87, 0xd6, 0x78, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94,
-	0x01, 0x8a, 0x46, 0x0e, 0x08, 0x00, 0x00,
+type HodDB_DropGraphClient interface {
+	Recv() (*GraphProgress, error)
+	grpc.ClientStream
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+type hodDBDropGraphClient struct {
+	grpc.ClientStream
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (x *hodDBDropGraphClient) Recv() (*GraphProgress, error) {
+	m := new(GraphProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
 
-// HodDBClient is the client API for HodDB service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type HodDBClient interface {
-	Select(ctx context.Context, in *SelectQuery, opts ...grpc.CallOption) (*Response, error)
-	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*SelectQuery, error)
-	Count(ctx context.Context, in *SelectQuery, opts ...grpc.CallOption) (*Response, error)
-	Versions(ctx context.Context, in *VersionQuery, opts ...grpc.CallOption) (*Response, error)
+func (c *hodDBClient) RenameGraph(ctx context.Context, in *RenameGraphRequest, opts ...grpc.CallOption) (HodDB_RenameGraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HodDB_serviceDesc.Streams[4], "/proto.HodDB/RenameGraph", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hodDBRenameGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type hodDBClient struct {
-	cc *grpc.ClientConn
+type HodDB_RenameGraphClient interface {
+	Recv() (*GraphProgress, error)
+	grpc.ClientStream
 }
 
-func NewHodDBClient(cc *grpc.ClientConn) HodDBClient {
-	return &hodDBClient{cc}
+type hodDBRenameGraphClient struct {
+	grpc.ClientStream
 }
 
-func (c *hodDBClient) Select(ctx context.Context, in *SelectQuery, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/proto.HodDB/Select", in, out, opts...)
-	if err != nil {
+func (x *hodDBRenameGraphClient) Recv() (*GraphProgress, error) {
+	m := new(GraphProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *hodDBClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*SelectQuery, error) {
-	out := new(SelectQuery)
-	err := c.cc.Invoke(ctx, "/proto.HodDB/Parse", in, out, opts...)
+func (c *hodDBClient) AddRule(ctx context.Context, in *ConstructQuery, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/proto.HodDB/AddRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1033,7 +2173,15 @@ func (c *hodDBClient) Versions(ctx context.Context, in *VersionQuery, opts ...gr
 // HodDBServer is the server API for HodDB service.
 type HodDBServer interface {
 	Select(context.Context, *SelectQuery) (*Response, error)
+	SelectStream(*SelectQuery, HodDB_SelectStreamServer) error
 	Parse(context.Context, *ParseRequest) (*SelectQuery, error)
+	Insert(context.Context, *InsertQuery) (*Response, error)
+	Delete(context.Context, *DeleteQuery) (*Response, error)
+	NewGraph(*NewGraphRequest, HodDB_NewGraphServer) error
+	LoadGraph(*LoadGraphRequest, HodDB_LoadGraphServer) error
+	DropGraph(*DropGraphRequest, HodDB_DropGraphServer) error
+	RenameGraph(*RenameGraphRequest, HodDB_RenameGraphServer) error
+	AddRule(context.Context, *ConstructQuery) (*Response, error)
 	Count(context.Context, *SelectQuery) (*Response, error)
 	Versions(context.Context, *VersionQuery) (*Response, error)
 }
@@ -1045,9 +2193,33 @@ type UnimplementedHodDBServer struct {
 func (*UnimplementedHodDBServer) Select(ctx context.Context, req *SelectQuery) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Select not implemented")
 }
+func (*UnimplementedHodDBServer) SelectStream(req *SelectQuery, srv HodDB_SelectStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SelectStream not implemented")
+}
 func (*UnimplementedHodDBServer) Parse(ctx context.Context, req *ParseRequest) (*SelectQuery, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
 }
+func (*UnimplementedHodDBServer) Insert(ctx context.Context, req *InsertQuery) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+}
+func (*UnimplementedHodDBServer) Delete(ctx context.Context, req *DeleteQuery) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedHodDBServer) NewGraph(req *NewGraphRequest, srv HodDB_NewGraphServer) error {
+	return status.Errorf(codes.Unimplemented, "method NewGraph not implemented")
+}
+func (*UnimplementedHodDBServer) LoadGraph(req *LoadGraphRequest, srv HodDB_LoadGraphServer) error {
+	return status.Errorf(codes.Unimplemented, "method LoadGraph not implemented")
+}
+func (*UnimplementedHodDBServer) DropGraph(req *DropGraphRequest, srv HodDB_DropGraphServer) error {
+	return status.Errorf(codes.Unimplemented, "method DropGraph not implemented")
+}
+func (*UnimplementedHodDBServer) RenameGraph(req *RenameGraphRequest, srv HodDB_RenameGraphServer) error {
+	return status.Errorf(codes.Unimplemented, "method RenameGraph not implemented")
+}
+func (*UnimplementedHodDBServer) AddRule(ctx context.Context, req *ConstructQuery) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRule not implemented")
+}
 func (*UnimplementedHodDBServer) Count(ctx context.Context, req *SelectQuery) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
 }
@@ -1077,6 +2249,27 @@ func _HodDB_Select_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HodDB_SelectStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SelectQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HodDBServer).SelectStream(m, &hodDBSelectStreamServer{stream})
+}
+
+type HodDB_SelectStreamServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type hodDBSelectStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *hodDBSelectStreamServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _HodDB_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParseRequest)
 	if err := dec(in); err != nil {
@@ -1095,6 +2288,144 @@ func _HodDB_Parse_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HodDB_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HodDBServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.HodDB/Insert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HodDBServer).Insert(ctx, req.(*InsertQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HodDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HodDBServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.HodDB/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HodDBServer).Delete(ctx, req.(*DeleteQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HodDB_NewGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NewGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HodDBServer).NewGraph(m, &hodDBNewGraphServer{stream})
+}
+
+type HodDB_NewGraphServer interface {
+	Send(*GraphProgress) error
+	grpc.ServerStream
+}
+
+type hodDBNewGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *hodDBNewGraphServer) Send(m *GraphProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HodDB_LoadGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LoadGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HodDBServer).LoadGraph(m, &hodDBLoadGraphServer{stream})
+}
+
+type HodDB_LoadGraphServer interface {
+	Send(*GraphProgress) error
+	grpc.ServerStream
+}
+
+type hodDBLoadGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *hodDBLoadGraphServer) Send(m *GraphProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HodDB_DropGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DropGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HodDBServer).DropGraph(m, &hodDBDropGraphServer{stream})
+}
+
+type HodDB_DropGraphServer interface {
+	Send(*GraphProgress) error
+	grpc.ServerStream
+}
+
+type hodDBDropGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *hodDBDropGraphServer) Send(m *GraphProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HodDB_RenameGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RenameGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HodDBServer).RenameGraph(m, &hodDBRenameGraphServer{stream})
+}
+
+type HodDB_RenameGraphServer interface {
+	Send(*GraphProgress) error
+	grpc.ServerStream
+}
+
+type hodDBRenameGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *hodDBRenameGraphServer) Send(m *GraphProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HodDB_AddRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HodDBServer).AddRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.HodDB/AddRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HodDBServer).AddRule(ctx, req.(*ConstructQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HodDB_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SelectQuery)
 	if err := dec(in); err != nil {
@@ -1143,6 +2474,18 @@ var _HodDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Parse",
 			Handler:    _HodDB_Parse_Handler,
 		},
+		{
+			MethodName: "Insert",
+			Handler:    _HodDB_Insert_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _HodDB_Delete_Handler,
+		},
+		{
+			MethodName: "AddRule",
+			Handler:    _HodDB_AddRule_Handler,
+		},
 		{
 			MethodName: "Count",
 			Handler:    _HodDB_Count_Handler,
@@ -1152,7 +2495,33 @@ var _HodDB_serviceDesc = grpc.ServiceDesc{
 			Handler:    _HodDB_Versions_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SelectStream",
+			Handler:       _HodDB_SelectStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "NewGraph",
+			Handler:       _HodDB_NewGraph_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LoadGraph",
+			Handler:       _HodDB_LoadGraph_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DropGraph",
+			Handler:       _HodDB_DropGraph_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RenameGraph",
+			Handler:       _HodDB_RenameGraph_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "log.proto",
 }
 
@@ -13,14 +13,19 @@ const (
 	OWL_NAMESPACE   = "http://www.w3.org/2002/07/owl"
 	RDF_NAMESPACE   = "http://www.w3.org/1999/02/22-rdf-syntax-ns"
 	RDFS_NAMESPACE  = "http://www.w3.org/2000/01/rdf-schema"
+	XSD_NAMESPACE   = "http://www.w3.org/2001/XMLSchema"
 	BF_NAMESPACE    = "https://brickschema.org/schema/1.1/BrickFrame#"
 	BRICK_NAMESPACE = "https://brickschema.org/schema/1.1/Brick#"
 )
 
+// namespace of the IRIs that replace blank nodes in a graph
+const SKOLEM_NAMESPACE = "http://hoddb/.well-known/genid"
+
 var defaultNamespaces = map[string]string{
 	"owl":   OWL_NAMESPACE,
 	"rdf":   RDF_NAMESPACE,
 	"rdfs":  RDFS_NAMESPACE,
+	"xsd":   XSD_NAMESPACE,
 	"bf":    BF_NAMESPACE,
 	"brick": BRICK_NAMESPACE,
 }
@@ -74,6 +79,10 @@ func (d *DataSet) Hash() []byte {
 	var s = make([]string, len(d.Triples))
 	for idx, triple := range d.Triples {
 		s[idx] = fmt.Sprintf("%s%s%s", triple.Subject, triple.Predicate, triple.Object)
+		// literals with the same value but a different type are different
+		if triple.Object.Datatype != "" || triple.Object.Lang != "" {
+			s[idx] += "^^" + triple.Object.Datatype + "@" + triple.Object.Lang
+		}
 	}
 	sort.Strings(s)
 	for _, t := range s {
@@ -86,10 +95,7 @@ func DataSetFromRows(rows []*pb.Row) DataSet {
 	d := NewDataSet()
 	// TODO: assuming triples
 	for _, row := range rows {
-		s := URI{Namespace: row.Values[0].Namespace, Value: row.Values[0].Value}
-		p := URI{Namespace: row.Values[1].Namespace, Value: row.Values[1].Value}
-		o := URI{Namespace: row.Values[2].Namespace, Value: row.Values[2].Value}
-		d.AddTripleURIs(s, p, o)
+		d.AddTripleURIs(URIFromProto(row.Values[0]), URIFromProto(row.Values[1]), URIFromProto(row.Values[2]))
 	}
 	return *d
 }
@@ -5,15 +5,38 @@ import (
 	rdf "github.com/gtfierro/hoddb/turtle/rdfparser"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 )
 
+// URI is an RDF term: an IRI, a blank node or a literal. IRIs have a namespace.
+// Blank nodes have the namespace "_" and their label as the value; once they are
+// in a graph, they are skolem IRIs in SKOLEM_NAMESPACE. Literals have no
+// namespace; they have the IRI of their datatype, which is empty for plain
+// strings, or a language tag.
 type URI struct {
 	Namespace string `msg:"n"`
 	Value     string `msg:"v"`
+	Datatype  string `msg:"d"`
+	Lang      string `msg:"l"`
+}
+
+// the namespace of blank nodes
+const BlankNamespace = "_"
+
+// returns a literal. Strings have no datatype, whether or not they have a
+// language tag
+func NewLiteral(value, datatype, lang string) URI {
+	if datatype == XSD_NAMESPACE+"#string" || datatype == RDF_NAMESPACE+"#langString" {
+		datatype = ""
+	}
+	return URI{Value: value, Datatype: datatype, Lang: lang}
 }
 
 func (u URI) String() string {
+	if u.Namespace == BlankNamespace {
+		return "_:" + u.Value
+	}
 	if u.Namespace != "" {
 		return u.Namespace + "#" + u.Value
 	}
@@ -21,10 +44,7 @@ func (u URI) String() string {
 }
 
 func (u URI) Bytes() []byte {
-	if u.Namespace != "" {
-		return []byte(u.Namespace + "#" + u.Value)
-	}
-	return []byte(u.Value)
+	return []byte(u.String())
 }
 
 func (u URI) IsVariable() bool {
@@ -32,32 +52,92 @@ func (u URI) IsVariable() bool {
 }
 
 func (u URI) IsEmpty() bool {
-	return len(u.Namespace) == 0 && len(u.Value) == 0
+	return len(u.Namespace) == 0 && len(u.Value) == 0 && len(u.Datatype) == 0 && len(u.Lang) == 0
+}
+
+func (u URI) IsIRI() bool {
+	return u.Namespace != "" && u.Namespace != BlankNamespace
+}
+
+// true for blank nodes, including the skolem IRIs that replace them
+func (u URI) IsBlank() bool {
+	return u.Namespace == BlankNamespace || u.Namespace == SKOLEM_NAMESPACE
+}
+
+func (u URI) IsLiteral() bool {
+	return u.Namespace == "" && !u.IsVariable()
+}
+
+// returns the value of a numeric literal. Plain strings that are numbers count
+// as numeric
+func (u URI) Number() (float64, bool) {
+	if !u.IsLiteral() || u.Lang != "" {
+		return 0, false
+	}
+	if u.Datatype != "" && !numericDatatypes[u.Datatype] {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(u.Value, 64)
+	return f, err == nil
 }
 
+var numericDatatypes = make(map[string]bool)
+
+func init() {
+	for _, name := range []string{"integer", "decimal", "double", "float", "int", "long", "short", "byte",
+		"nonNegativeInteger", "positiveInteger", "nonPositiveInteger", "negativeInteger",
+		"unsignedLong", "unsignedInt", "unsignedShort", "unsignedByte"} {
+		numericDatatypes[XSD_NAMESPACE+"#"+name] = true
+	}
+}
+
+// parses an IRI (<http://...#value> or prefix:value), or a literal in
+// N-Triples syntax ("value", "value"@lang or "value"^^datatype). The datatype of
+// a literal is parsed the same way as an IRI, but is kept as it is written
 func ParseURI(uri string) URI {
+	if strings.HasPrefix(uri, "\"") {
+		if end := strings.LastIndex(uri, "\""); end > 0 {
+			value, suffix := uri[1:end], uri[end+1:]
+			switch {
+			case strings.HasPrefix(suffix, "@"):
+				return NewLiteral(value, "", suffix[1:])
+			case strings.HasPrefix(suffix, "^^"):
+				return NewLiteral(value, strings.Trim(suffix[2:], "<>"), "")
+			}
+			return URI{Value: value}
+		}
+	}
 	uri = strings.TrimLeft(uri, "<")
 	uri = strings.TrimRight(uri, ">")
 	parts := strings.Split(uri, "#")
 	parts[0] = strings.TrimRight(parts[0], "#")
 	if len(parts) != 2 {
-		if strings.HasPrefix(uri, "\"") {
-			uri = strings.TrimSuffix(uri, "@en")
-			//uri = strings.Trim(uri, "\"")
-			return URI{Value: uri}
-		}
 		// try to parse ":"
 		parts = strings.SplitN(uri, ":", 2)
 		if len(parts) > 1 {
 			return URI{Namespace: parts[0], Value: parts[1]}
 		}
-		uri = strings.TrimSuffix(uri, "@en")
-		//uri = strings.Trim(uri, "\"")
 		return URI{Value: uri}
 	}
 	return URI{Namespace: parts[0], Value: parts[1]}
 }
 
+// returns the term with the contents of the protobuf URI
+func URIFromProto(u *pb.URI) URI {
+	return URI{Namespace: u.Namespace, Value: u.Value, Datatype: u.Datatype, Lang: u.Lang}
+}
+
+// converts a term from the parser
+func termToURI(term rdf.Term) URI {
+	switch term := term.(type) {
+	case rdf.Literal:
+		return NewLiteral(term.String(), term.DataType.String(), term.Lang())
+	case rdf.Blank:
+		return URI{Namespace: BlankNamespace, Value: term.String()}
+	}
+	return ParseURI(term.String())
+}
+
 type Triple struct {
 	Subject   URI `msg:"s"`
 	Predicate URI `msg:"p"`
@@ -77,23 +157,35 @@ func MakeTriple(sub, pred, obj string) Triple {
 
 func TripleFromRow(row pb.Row) Triple {
 	return Triple{
-		Subject:   URI{Namespace: row.Values[0].Namespace, Value: row.Values[0].Value},
-		Predicate: URI{Namespace: row.Values[1].Namespace, Value: row.Values[1].Value},
-		Object:    URI{Namespace: row.Values[2].Namespace, Value: row.Values[2].Value},
+		Subject:   URIFromProto(row.Values[0]),
+		Predicate: URIFromProto(row.Values[1]),
+		Object:    URIFromProto(row.Values[2]),
 	}
 }
 
 // Parses the given filename using the turtle format.
 // Returns the dataset, and the time elapsed in parsing
 func Parse(filename string) (DataSet, error) {
-	dataset := NewDataSet()
 	f, err := os.Open(filename)
 	if err != nil {
-		return *dataset, err
+		return *NewDataSet(), err
 	}
-	dec := rdf.NewTripleDecoder(f, rdf.Turtle)
-	for triple, err := dec.Decode(); err != io.EOF; triple, err = dec.Decode() {
-		dataset.AddTripleStrings(triple.Subj.String(), triple.Pred.String(), triple.Obj.String())
+	defer f.Close()
+	return ParseReader(f, rdf.Turtle)
+}
+
+// Parses the document in the given format (Turtle, N-Triples or RDF/XML)
+func ParseReader(r io.Reader, format rdf.Format) (DataSet, error) {
+	dataset := NewDataSet()
+	dec := rdf.NewTripleDecoder(r, format)
+	for {
+		triple, err := dec.Decode()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return *dataset, err
+		}
+		dataset.AddTripleURIs(termToURI(triple.Subj), termToURI(triple.Pred), termToURI(triple.Obj))
 	}
 	for ns, uri := range dec.Namespaces() {
 		dataset.AddNamespace(ns, uri)